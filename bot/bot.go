@@ -0,0 +1,256 @@
+package bot
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"google.golang.org/protobuf/proto"
+)
+
+// 步骤执行错误
+var (
+	errDisconnected     = errors.New("connection closed")
+	errTimeout          = errors.New("response timeout")
+	errUnexpectedResult = errors.New("unexpected result")
+)
+
+// Bot 虚拟客户端
+type Bot struct {
+	id       int
+	conn     net.Conn
+	protocol protolayer.Protocol
+	stats    *Stats
+	timeout  time.Duration
+	rnd      *rand.Rand
+	vars     map[string]string // 变量（账号、密码及保存的响应字段）
+
+	mu      sync.Mutex
+	seq     uint32
+	pending map[uint32]chan *protocol.Response // 请求序号 -> 等待响应的通道
+	closed  chan struct{}                      // 读取协程退出时关闭
+}
+
+// newBot 创建虚拟客户端并开始接收下行消息
+func newBot(id int, conn net.Conn, cfg *Config, stats *Stats) *Bot {
+	b := &Bot{
+		id:       id,
+		conn:     conn,
+		protocol: cfg.Protocol,
+		stats:    stats,
+		timeout:  cfg.Timeout,
+		rnd:      rand.New(rand.NewSource(time.Now().UnixNano() + int64(id))),
+		vars: map[string]string{
+			"bot":      strconv.Itoa(id),
+			"account":  cfg.AccountPrefix + strconv.Itoa(id),
+			"password": cfg.Password,
+		},
+		pending: make(map[uint32]chan *protocol.Response),
+		closed:  make(chan struct{}),
+	}
+	go b.readLoop()
+	return b
+}
+
+// run 执行场景：先执行setup，再循环执行steps直到达到循环次数、上下文结束或连接断开
+func (b *Bot) run(ctx context.Context, scenario *Scenario, loops int) error {
+	for _, step := range scenario.Setup {
+		if err := b.execute(ctx, step); err != nil {
+			return fmt.Errorf("setup %s: %w", step.Name, err)
+		}
+	}
+	if len(scenario.Steps) == 0 {
+		return nil
+	}
+	for loop := 0; loops == 0 || loop < loops; loop++ {
+		for _, step := range scenario.Steps {
+			// 结果不符与超时已计入统计，不中断循环
+			if err := b.execute(ctx, step); err != nil && !errors.Is(err, errUnexpectedResult) && !errors.Is(err, errTimeout) {
+				return fmt.Errorf("step %s: %w", step.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// execute 执行一个步骤（含重复与等待）
+func (b *Bot) execute(ctx context.Context, step *Step) error {
+	if step.Unless != "" && b.vars[step.Unless] != "" {
+		return nil
+	}
+
+	for i := 0; i < step.Repeat; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := b.call(step); err != nil {
+			return err
+		}
+		if think := step.thinkTime(b.rnd); think > 0 {
+			select {
+			case <-time.After(think):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// call 发送步骤请求、等待响应并记录统计
+func (b *Bot) call(step *Step) error {
+	req, err := step.buildRequest(b.resolve)
+	if err != nil {
+		b.stats.Record(step.Name, 0, ErrorKindBuild)
+		return err
+	}
+
+	start := time.Now()
+	resp, err := b.request(step.info.Id, req)
+	latency := time.Since(start)
+	switch {
+	case errors.Is(err, errDisconnected):
+		b.stats.Record(step.Name, 0, ErrorKindDisconnected)
+		return err
+	case errors.Is(err, errTimeout):
+		b.stats.Record(step.Name, 0, ErrorKindTimeout)
+		return err
+	case err != nil:
+		b.stats.Record(step.Name, 0, ErrorKindSend)
+		return err
+	}
+
+	code := protocol.ErrorCode(resp.Result)
+	if step.Expect != ExpectAny && code != step.expectCode {
+		b.stats.Record(step.Name, latency, "result:"+code.String())
+		return fmt.Errorf("%w %s", errUnexpectedResult, code)
+	}
+	b.stats.Record(step.Name, latency, "")
+
+	if len(step.Save) > 0 && step.info.Response != nil {
+		msg := step.info.Response()
+		if err := b.protocol.Unmarshal(resp.Data, msg); err == nil {
+			for name, path := range step.Save {
+				if value, ok := lookupPath(msg, path); ok {
+					b.vars[name] = value
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resolve 解析请求体中的${}表达式
+func (b *Bot) resolve(expr string) (string, error) {
+	if strings.HasPrefix(expr, "rand:") {
+		return randInt(strings.TrimPrefix(expr, "rand:"), b.rnd)
+	}
+	value, ok := b.vars[expr]
+	if !ok {
+		return "", fmt.Errorf("variable %q not set", expr)
+	}
+	return value, nil
+}
+
+// request 发送请求（消息体前加请求序号）并等待对应的响应
+func (b *Bot) request(msgId int32, req proto.Message) (*protocol.Response, error) {
+	body, err := b.protocol.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *protocol.Response, 1)
+	b.mu.Lock()
+	b.seq++
+	seq := b.seq
+	b.pending[seq] = ch
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		delete(b.pending, seq)
+		b.mu.Unlock()
+	}()
+
+	data := make([]byte, protolayer.RequestSeqSize, protolayer.RequestSeqSize+len(body))
+	binary.BigEndian.PutUint32(data, seq)
+	data = append(data, body...)
+	packet := &zNet.NetPacket{
+		ProtoId:  msgId,
+		Version:  protolayer.ProtocolVersionCurrent,
+		DataSize: int32(len(data)),
+		Data:     data,
+	}
+	if _, err := b.conn.Write(packet.Marshal()); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(b.timeout)
+	defer timer.Stop()
+	select {
+	case resp := <-ch:
+		return resp, nil
+	case <-b.closed:
+		return nil, errDisconnected
+	case <-timer.C:
+		return nil, errTimeout
+	}
+}
+
+// readLoop 接收下行消息：响应交给等待的请求，推送计入统计
+func (b *Bot) readLoop() {
+	defer close(b.closed)
+	for {
+		packets, err := protolayer.ReadMessages(b.conn)
+		if err != nil {
+			return
+		}
+		for _, p := range packets {
+			b.dispatch(p.ProtoId, p.Data)
+		}
+	}
+}
+
+// dispatch 分发一条下行消息
+func (b *Bot) dispatch(msgId int32, data []byte) {
+	info := msgreg.Get(msgId)
+	if info == nil || !info.IsRequest() {
+		name := fmt.Sprintf("msg(%d)", msgId)
+		if info != nil {
+			name = info.Name
+		}
+		b.stats.RecordPush(name)
+		return
+	}
+
+	resp := &protocol.Response{}
+	if err := b.protocol.Unmarshal(data, resp); err != nil {
+		return
+	}
+	b.mu.Lock()
+	ch := b.pending[resp.Seq]
+	b.mu.Unlock()
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- resp:
+	default:
+	}
+}
+
+// close 断开连接
+func (b *Bot) close() {
+	b.conn.Close()
+	<-b.closed
+}
@@ -0,0 +1,109 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pzqf/zGameServer/net/protolayer"
+)
+
+// Config 压测配置
+type Config struct {
+	Addr          string              // 服务器地址
+	Protocol      protolayer.Protocol // 与服务器一致的消息协议
+	Bots          int                 // 机器人数量
+	StartID       int                 // 首个机器人编号（多台压测机时错开账号）
+	SpawnRate     int                 // 每秒启动的机器人数量，0表示同时启动
+	Duration      time.Duration       // 压测时长，0表示每个机器人执行完场景循环后结束
+	Timeout       time.Duration       // 等待单个响应的最长时间
+	AccountPrefix string              // 机器人账号前缀，账号为 前缀+编号
+	Password      string              // 机器人账号密码
+}
+
+// Result 压测结果
+type Result struct {
+	Elapsed      time.Duration
+	Bots         int
+	ConnectFails int64            // 连接失败的机器人数
+	Aborted      int64            // 因错误提前退出的机器人数
+	FirstError   error            // 首个导致机器人退出的错误
+	Ops          []*OpReport      // 各步骤的延迟分位数与错误数
+	Pushes       map[string]int64 // 各推送消息的接收次数
+}
+
+// Run 启动机器人执行场景并汇总统计
+// 达到压测时长或ctx取消时，机器人完成当前请求后退出
+// 参数:
+//   - ctx: 上下文
+//   - cfg: 压测配置
+//   - scenario: 压测场景
+//
+// 返回:
+//   - *Result: 压测结果
+func Run(ctx context.Context, cfg Config, scenario *Scenario) *Result {
+	if cfg.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Duration)
+		defer cancel()
+	}
+	loops := scenario.Loops
+	if loops == 0 && cfg.Duration == 0 {
+		loops = 1
+	}
+
+	stats := NewStats()
+	result := &Result{Bots: cfg.Bots}
+	var connectFails, aborted atomic.Int64
+	var firstErr sync.Once
+	var wg sync.WaitGroup
+	start := time.Now()
+
+	spawnInterval := time.Duration(0)
+	if cfg.SpawnRate > 0 {
+		spawnInterval = time.Second / time.Duration(cfg.SpawnRate)
+	}
+
+spawn:
+	for i := 0; i < cfg.Bots; i++ {
+		if i > 0 && spawnInterval > 0 {
+			select {
+			case <-time.After(spawnInterval):
+			case <-ctx.Done():
+				break spawn
+			}
+		}
+
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+
+			dialer := net.Dialer{Timeout: cfg.Timeout}
+			conn, err := dialer.DialContext(ctx, "tcp", cfg.Addr)
+			if err != nil {
+				connectFails.Add(1)
+				firstErr.Do(func() { result.FirstError = err })
+				return
+			}
+			b := newBot(id, conn, &cfg, stats)
+			defer b.close()
+
+			err = b.run(ctx, scenario, loops)
+			if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+				aborted.Add(1)
+				firstErr.Do(func() { result.FirstError = err })
+			}
+		}(cfg.StartID + i)
+	}
+	wg.Wait()
+
+	result.Elapsed = time.Since(start)
+	result.ConnectFails = connectFails.Load()
+	result.Aborted = aborted.Load()
+	result.Ops = stats.Report()
+	result.Pushes = stats.Pushes()
+	return result
+}
@@ -0,0 +1,305 @@
+// Package bot 无界面压测机器人
+// 每个机器人是一个独立的TCP客户端，按场景文件（YAML/JSON）依次发送请求，统计各消息的延迟分位数与错误数
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// 期望的响应结果
+const (
+	ExpectOk  = "ok"  // 成功（默认）
+	ExpectAny = "any" // 不检查结果
+)
+
+// Scenario 压测场景
+type Scenario struct {
+	Name  string  `yaml:"name" json:"name"`
+	Setup []*Step `yaml:"setup" json:"setup"` // 每个机器人开始时执行一次（如建号、登录），失败时该机器人退出
+	Steps []*Step `yaml:"steps" json:"steps"` // 循环执行的步骤
+	Loops int     `yaml:"loops" json:"loops"` // 循环次数，0表示持续到压测时长结束
+}
+
+// Step 场景步骤：发送一条请求并等待响应
+//
+// 请求体中的字符串支持变量替换：
+//   - ${account}、${password}、${bot}: 机器人账号、密码与编号
+//   - ${变量名}: 之前步骤通过save保存的响应字段
+//   - ${rand:最小值-最大值}: 区间内的随机整数
+type Step struct {
+	Name   string                 `yaml:"name" json:"name"`     // 统计名称，默认为消息ID枚举取值名
+	Send   string                 `yaml:"send" json:"send"`     // 消息ID枚举取值名，如MSG_PLAYER_SKILL_USE
+	Body   map[string]interface{} `yaml:"body" json:"body"`     // 请求字段（按proto字段名）
+	Expect string                 `yaml:"expect" json:"expect"` // 期望结果：ok、any或错误码名（如ERR_ACCOUNT_EXISTS）
+	Save   map[string]string      `yaml:"save" json:"save"`     // 变量名 -> 响应字段路径（如players.0.player_id），字段不存在时不保存
+	Unless string                 `yaml:"unless" json:"unless"` // 该变量已保存时跳过此步骤
+	Repeat int                    `yaml:"repeat" json:"repeat"` // 重复次数，默认1
+	Think  string                 `yaml:"think" json:"think"`   // 执行后的等待时间，如200ms或100ms-500ms（区间内随机）
+
+	info       *msgreg.MsgInfo
+	expectCode protocol.ErrorCode
+	thinkMin   time.Duration
+	thinkMax   time.Duration
+}
+
+// LoadScenario 加载场景文件
+// 扩展名为.json时按JSON解析，其余按YAML解析
+// 参数:
+//   - path: 场景文件路径
+//
+// 返回:
+//   - *Scenario: 已校验的场景
+//   - error: 读取、解析或校验错误
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario := &Scenario{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, scenario)
+	} else {
+		err = yaml.Unmarshal(data, scenario)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse scenario %s: %w", path, err)
+	}
+	if err := scenario.compile(); err != nil {
+		return nil, fmt.Errorf("scenario %s: %w", path, err)
+	}
+	return scenario, nil
+}
+
+// compile 校验场景并解析步骤
+func (s *Scenario) compile() error {
+	if len(s.Setup) == 0 && len(s.Steps) == 0 {
+		return fmt.Errorf("no steps")
+	}
+	if s.Loops < 0 {
+		return fmt.Errorf("invalid loops %d", s.Loops)
+	}
+	for i, step := range s.Setup {
+		if err := step.compile(); err != nil {
+			return fmt.Errorf("setup[%d]: %w", i, err)
+		}
+	}
+	for i, step := range s.Steps {
+		if err := step.compile(); err != nil {
+			return fmt.Errorf("steps[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// compile 校验步骤：消息ID必须为请求消息，请求体字段必须存在
+func (st *Step) compile() error {
+	for _, info := range msgreg.All() {
+		if info.Name == st.Send {
+			st.info = info
+			break
+		}
+	}
+	if st.info == nil {
+		return fmt.Errorf("unknown message id %q", st.Send)
+	}
+	if !st.info.IsRequest() {
+		return fmt.Errorf("%s is not a request message", st.Send)
+	}
+	if st.Name == "" {
+		st.Name = st.Send
+	}
+	if st.Repeat == 0 {
+		st.Repeat = 1
+	}
+	if st.Repeat < 0 {
+		return fmt.Errorf("invalid repeat %d", st.Repeat)
+	}
+
+	switch st.Expect {
+	case "", ExpectOk:
+		st.Expect = ExpectOk
+	case ExpectAny:
+	default:
+		code, ok := protocol.ErrorCode_value[st.Expect]
+		if !ok {
+			return fmt.Errorf("unknown error code %q", st.Expect)
+		}
+		st.expectCode = protocol.ErrorCode(code)
+	}
+
+	if st.Think != "" {
+		var err error
+		if st.thinkMin, st.thinkMax, err = parseThink(st.Think); err != nil {
+			return err
+		}
+	}
+
+	// 以占位值渲染请求体，提前发现字段名错误
+	if _, err := st.buildRequest(func(string) (string, error) { return "0", nil }); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseThink 解析等待时间（固定值或区间）
+func parseThink(s string) (time.Duration, time.Duration, error) {
+	lo, hi, isRange := strings.Cut(s, "-")
+	min, err := time.ParseDuration(strings.TrimSpace(lo))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid think %q", s)
+	}
+	if !isRange {
+		return min, min, nil
+	}
+	max, err := time.ParseDuration(strings.TrimSpace(hi))
+	if err != nil || max < min {
+		return 0, 0, fmt.Errorf("invalid think %q", s)
+	}
+	return min, max, nil
+}
+
+// thinkTime 本次执行后的等待时间
+func (st *Step) thinkTime(rnd *rand.Rand) time.Duration {
+	if st.thinkMax <= st.thinkMin {
+		return st.thinkMin
+	}
+	return st.thinkMin + time.Duration(rnd.Int63n(int64(st.thinkMax-st.thinkMin)+1))
+}
+
+// buildRequest 渲染请求体并转换为请求消息
+// 参数:
+//   - resolve: 变量解析函数（参数为${}中的表达式）
+func (st *Step) buildRequest(resolve func(expr string) (string, error)) (proto.Message, error) {
+	body, err := render(st.Body, resolve)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req := st.info.Request()
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return nil, fmt.Errorf("%s body: %w", st.Send, err)
+	}
+	return req, nil
+}
+
+// render 递归替换请求体字符串中的${}表达式
+func render(v interface{}, resolve func(expr string) (string, error)) (interface{}, error) {
+	switch value := v.(type) {
+	case string:
+		return expand(value, resolve)
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			r, err := render(item, resolve)
+			if err != nil {
+				return nil, err
+			}
+			rendered[key] = r
+		}
+		return rendered, nil
+	case []interface{}:
+		rendered := make([]interface{}, len(value))
+		for i, item := range value {
+			r, err := render(item, resolve)
+			if err != nil {
+				return nil, err
+			}
+			rendered[i] = r
+		}
+		return rendered, nil
+	default:
+		return v, nil
+	}
+}
+
+// expand 替换字符串中的${}表达式
+func expand(s string, resolve func(expr string) (string, error)) (string, error) {
+	var b strings.Builder
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		end := strings.Index(s[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated expression in %q", s)
+		}
+		value, err := resolve(s[start+2 : start+end])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s[:start])
+		b.WriteString(value)
+		s = s[start+end+1:]
+	}
+}
+
+// randInt 解析rand:最小值-最大值表达式并生成随机数
+func randInt(expr string, rnd *rand.Rand) (string, error) {
+	lo, hi, ok := strings.Cut(expr, "-")
+	min, err1 := strconv.ParseInt(strings.TrimSpace(lo), 10, 64)
+	max, err2 := strconv.ParseInt(strings.TrimSpace(hi), 10, 64)
+	if !ok || err1 != nil || err2 != nil || max < min {
+		return "", fmt.Errorf("invalid rand expression %q", expr)
+	}
+	return strconv.FormatInt(min+rnd.Int63n(max-min+1), 10), nil
+}
+
+// lookupPath 按路径读取响应字段（对象字段名与数组下标以.分隔）
+// 返回: 字段值的字符串形式，字段不存在时返回false
+func lookupPath(resp proto.Message, path string) (string, bool) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+	if err != nil {
+		return "", false
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", false
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			if v = node[key]; v == nil {
+				return "", false
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			v = node[index]
+		default:
+			return "", false
+		}
+	}
+
+	switch value := v.(type) {
+	case string:
+		return value, true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(value), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,90 @@
+package bot
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// 测试示例场景可以加载
+func TestLoadExampleScenario(t *testing.T) {
+	scenario, err := LoadScenario("../resources/bot/scenario.yaml")
+	if err != nil {
+		t.Fatalf("load example scenario: %v", err)
+	}
+	if len(scenario.Setup) == 0 || len(scenario.Steps) == 0 {
+		t.Fatalf("unexpected example scenario: %+v", scenario)
+	}
+}
+
+// 测试JSON场景的变量替换、期望错误码与响应字段保存
+func TestScenarioBuildAndSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scenario.json")
+	content := `{"name": "json", "steps": [
+		{"send": "MSG_PLAYER_PLAYER_LOGIN", "body": {"player_id": "${player_id}"}, "expect": "ERR_PLAYER_NOT_FOUND", "think": "10ms-20ms"},
+		{"send": "MSG_MAP_MOVE", "body": {"map_id": 1, "x": "${rand:5-5}"}}
+	]}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	scenario, err := LoadScenario(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	login := scenario.Steps[0]
+	if login.expectCode != protocol.ErrorCode_ERR_PLAYER_NOT_FOUND || login.thinkMin <= 0 || login.thinkMax <= login.thinkMin {
+		t.Fatalf("unexpected compiled step: %+v", login)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	b := &Bot{rnd: rnd, vars: map[string]string{"player_id": "9007199254740993"}}
+	req, err := login.buildRequest(b.resolve)
+	if err != nil {
+		t.Fatalf("build login: %v", err)
+	}
+	if req.(*protocol.PlayerLoginRequest).PlayerId != 9007199254740993 {
+		t.Fatalf("unexpected player id: %v", req)
+	}
+	move, err := scenario.Steps[1].buildRequest(b.resolve)
+	if err != nil || move.(*protocol.MapMoveRequest).X != 5 {
+		t.Fatalf("unexpected move request: %v, %v", move, err)
+	}
+
+	delete(b.vars, "player_id")
+	if _, err := login.buildRequest(b.resolve); err == nil {
+		t.Fatalf("expected missing variable error")
+	}
+
+	resp := &protocol.AccountLoginResponse{Players: []*protocol.PlayerInfo{{PlayerId: 42}}}
+	if value, ok := lookupPath(resp, "players.0.player_id"); !ok || value != "42" {
+		t.Fatalf("lookup player id: %q %v", value, ok)
+	}
+	if _, ok := lookupPath(resp, "players.1.player_id"); ok {
+		t.Fatalf("expected missing index")
+	}
+}
+
+// 测试场景校验错误
+func TestScenarioValidation(t *testing.T) {
+	cases := map[string]string{
+		"unknown message": "steps:\n  - send: MSG_UNKNOWN\n",
+		"push message":    "steps:\n  - send: MSG_SYSTEM_KICK\n",
+		"unknown field":   "steps:\n  - send: MSG_MAP_MOVE\n    body: {speed: 1}\n",
+		"unknown code":    "steps:\n  - send: MSG_MAP_MOVE\n    expect: ERR_NOPE\n",
+		"bad think":       "steps:\n  - send: MSG_MAP_MOVE\n    think: 2s-1s\n",
+	}
+	for name, content := range cases {
+		path := filepath.Join(t.TempDir(), "scenario.yaml")
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadScenario(path); err == nil || !strings.Contains(err.Error(), "steps[0]") {
+			t.Errorf("%s: expected step error, got %v", name, err)
+		}
+	}
+}
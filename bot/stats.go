@@ -0,0 +1,176 @@
+package bot
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 请求失败类型
+const (
+	ErrorKindTimeout      = "timeout"      // 等待响应超时
+	ErrorKindSend         = "send"         // 发送失败
+	ErrorKindBuild        = "build"        // 请求体渲染失败
+	ErrorKindDisconnected = "disconnected" // 等待响应时连接断开
+)
+
+// Stats 压测统计（并发安全）
+type Stats struct {
+	mu     sync.Mutex
+	ops    map[string]*opStats
+	pushes map[string]int64
+}
+
+// opStats 单个步骤的统计
+type opStats struct {
+	latencies []time.Duration
+	errors    map[string]int64
+}
+
+// NewStats 创建压测统计
+func NewStats() *Stats {
+	return &Stats{
+		ops:    make(map[string]*opStats),
+		pushes: make(map[string]int64),
+	}
+}
+
+// Record 记录一次请求
+// 参数:
+//   - op: 步骤名称
+//   - latency: 请求到响应的耗时（失败且未收到响应时忽略）
+//   - errKind: 失败类型，成功时为空
+func (s *Stats) Record(op string, latency time.Duration, errKind string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.ops[op]
+	if !ok {
+		stats = &opStats{errors: make(map[string]int64)}
+		s.ops[op] = stats
+	}
+	if errKind == "" {
+		stats.latencies = append(stats.latencies, latency)
+		return
+	}
+	stats.errors[errKind]++
+}
+
+// RecordPush 记录一条服务器推送
+func (s *Stats) RecordPush(msgName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pushes[msgName]++
+}
+
+// OpReport 单个步骤的统计结果
+type OpReport struct {
+	Name   string
+	Count  int64            // 成功次数
+	Errors map[string]int64 // 失败类型 -> 次数
+	P50    time.Duration
+	P90    time.Duration
+	P99    time.Duration
+	Max    time.Duration
+}
+
+// ErrorCount 失败总次数
+func (r *OpReport) ErrorCount() int64 {
+	var total int64
+	for _, count := range r.Errors {
+		total += count
+	}
+	return total
+}
+
+// Report 汇总各步骤的延迟分位数与错误数（按步骤名称排序）
+func (s *Stats) Report() []*OpReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reports := make([]*OpReport, 0, len(s.ops))
+	for name, stats := range s.ops {
+		latencies := append([]time.Duration(nil), stats.latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		errors := make(map[string]int64, len(stats.errors))
+		for kind, count := range stats.errors {
+			errors[kind] = count
+		}
+		report := &OpReport{
+			Name:   name,
+			Count:  int64(len(latencies)),
+			Errors: errors,
+			P50:    percentile(latencies, 50),
+			P90:    percentile(latencies, 90),
+			P99:    percentile(latencies, 99),
+		}
+		if len(latencies) > 0 {
+			report.Max = latencies[len(latencies)-1]
+		}
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Name < reports[j].Name })
+	return reports
+}
+
+// Pushes 各推送消息的接收次数
+func (s *Stats) Pushes() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pushes := make(map[string]int64, len(s.pushes))
+	for name, count := range s.pushes {
+		pushes[name] = count
+	}
+	return pushes
+}
+
+// percentile 按最近秩法计算已排序延迟的分位数
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// PrintReport 以表格形式输出统计结果
+func PrintReport(w io.Writer, reports []*OpReport, pushes map[string]int64) {
+	fmt.Fprintf(w, "%-36s %8s %8s %10s %10s %10s %10s  %s\n", "STEP", "OK", "ERRORS", "P50", "P90", "P99", "MAX", "ERROR KINDS")
+	for _, r := range reports {
+		kinds := make([]string, 0, len(r.Errors))
+		for kind, count := range r.Errors {
+			kinds = append(kinds, fmt.Sprintf("%s=%d", kind, count))
+		}
+		sort.Strings(kinds)
+		fmt.Fprintf(w, "%-36s %8d %8d %10s %10s %10s %10s  %s\n",
+			r.Name, r.Count, r.ErrorCount(),
+			round(r.P50), round(r.P90), round(r.P99), round(r.Max),
+			strings.Join(kinds, " "))
+	}
+
+	if len(pushes) == 0 {
+		return
+	}
+	names := make([]string, 0, len(pushes))
+	for name := range pushes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "\nPUSHES")
+	for _, name := range names {
+		fmt.Fprintf(w, "%-36s %8d\n", name, pushes[name])
+	}
+}
+
+// round 延迟保留到微秒
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
@@ -0,0 +1,35 @@
+package bot
+
+import (
+	"testing"
+	"time"
+)
+
+// 测试延迟分位数与错误计数
+func TestStatsReport(t *testing.T) {
+	stats := NewStats()
+	for i := 100; i >= 1; i-- {
+		stats.Record("login", time.Duration(i)*time.Millisecond, "")
+	}
+	stats.Record("login", 0, ErrorKindTimeout)
+	stats.Record("login", time.Millisecond, "result:ERR_SERVER")
+	stats.RecordPush("MSG_SYSTEM_KICK")
+
+	reports := stats.Report()
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	r := reports[0]
+	if r.Count != 100 || r.ErrorCount() != 2 {
+		t.Fatalf("unexpected counts: ok=%d errors=%d", r.Count, r.ErrorCount())
+	}
+	if r.P50 != 50*time.Millisecond || r.P90 != 90*time.Millisecond || r.P99 != 99*time.Millisecond || r.Max != 100*time.Millisecond {
+		t.Fatalf("unexpected percentiles: %+v", r)
+	}
+	if stats.Pushes()["MSG_SYSTEM_KICK"] != 1 {
+		t.Fatalf("expected push to be counted")
+	}
+	if percentile(nil, 50) != 0 {
+		t.Fatalf("expected zero percentile for empty samples")
+	}
+}
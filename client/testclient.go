@@ -1,13 +1,14 @@
 package main
 
 import (
+	"encoding/binary"
 	"fmt"
-	"io"
 	"net"
 	"time"
 
 	"github.com/pzqf/zEngine/zNet"
 	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -30,9 +31,10 @@ func main() {
 		DeviceId:   "testdevice",
 		DeviceType: 1,
 		Version:    "1.0.0",
+		Batch:      true,
 	}
 
-	loginData, err := proto.Marshal(&loginReq)
+	loginData, err := marshalRequest(&loginReq)
 	if err != nil {
 		fmt.Printf("Failed to marshal login request: %v\n", err)
 		return
@@ -42,7 +44,7 @@ func main() {
 	loginPacket := &zNet.NetPacket{
 		ProtoId:  int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN),
 		DataSize: int32(len(loginData)),
-		Version:  0,
+		Version:  protolayer.ProtocolVersionCurrent,
 		Data:     loginData,
 	}
 
@@ -63,7 +65,7 @@ func main() {
 
 	// 解析登录响应
 	var loginResp protocol.AccountLoginResponse
-	if err := proto.Unmarshal(loginRespPacket.Data, &loginResp); err != nil {
+	if err := unmarshalResponse(loginRespPacket, &loginResp); err != nil {
 		fmt.Printf("Failed to unmarshal login response: %v\n", err)
 		return
 	}
@@ -82,7 +84,7 @@ func main() {
 				Age:  20,
 			}
 
-			playerCreateData, err := proto.Marshal(&playerCreateReq)
+			playerCreateData, err := marshalRequest(&playerCreateReq)
 			if err != nil {
 				fmt.Printf("Failed to marshal player create request: %v\n", err)
 				return
@@ -92,7 +94,7 @@ func main() {
 			playerCreatePacket := &zNet.NetPacket{
 				ProtoId:  int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE),
 				DataSize: int32(len(playerCreateData)),
-				Version:  0,
+				Version:  protolayer.ProtocolVersionCurrent,
 				Data:     playerCreateData,
 			}
 
@@ -113,7 +115,7 @@ func main() {
 
 			// 解析玩家创建响应
 			var playerCreateResp protocol.PlayerCreateResponse
-			if err := proto.Unmarshal(playerCreateRespPacket.Data, &playerCreateResp); err != nil {
+			if err := unmarshalResponse(playerCreateRespPacket, &playerCreateResp); err != nil {
 				fmt.Printf("Failed to unmarshal player create response: %v\n", err)
 				return
 			}
@@ -133,7 +135,7 @@ func main() {
 			PlayerId: playerId,
 		}
 
-		playerLoginData, err := proto.Marshal(&playerLoginReq)
+		playerLoginData, err := marshalRequest(&playerLoginReq)
 		if err != nil {
 			fmt.Printf("Failed to marshal player login request: %v\n", err)
 			return
@@ -143,7 +145,7 @@ func main() {
 		playerLoginPacket := &zNet.NetPacket{
 			ProtoId:  int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN),
 			DataSize: int32(len(playerLoginData)),
-			Version:  0,
+			Version:  protolayer.ProtocolVersionCurrent,
 			Data:     playerLoginData,
 		}
 
@@ -164,7 +166,7 @@ func main() {
 
 		// 解析玩家登录响应
 		var playerLoginResp protocol.PlayerLoginResponse
-		if err := proto.Unmarshal(playerLoginRespPacket.Data, &playerLoginResp); err != nil {
+		if err := unmarshalResponse(playerLoginRespPacket, &playerLoginResp); err != nil {
 			fmt.Printf("Failed to unmarshal player login response: %v\n", err)
 			return
 		}
@@ -179,7 +181,7 @@ func main() {
 			PlayerId: playerId,
 		}
 
-		playerLogoutData, err := proto.Marshal(&playerLogoutReq)
+		playerLogoutData, err := marshalRequest(&playerLogoutReq)
 		if err != nil {
 			fmt.Printf("Failed to marshal player logout request: %v\n", err)
 			return
@@ -189,7 +191,7 @@ func main() {
 		playerLogoutPacket := &zNet.NetPacket{
 			ProtoId:  int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGOUT),
 			DataSize: int32(len(playerLogoutData)),
-			Version:  0,
+			Version:  protolayer.ProtocolVersionCurrent,
 			Data:     playerLogoutData,
 		}
 
@@ -210,7 +212,7 @@ func main() {
 
 		// 解析玩家登出响应
 		var playerLogoutResp protocol.PlayerLogoutResponse
-		if err := proto.Unmarshal(playerLogoutRespPacket.Data, &playerLogoutResp); err != nil {
+		if err := unmarshalResponse(playerLogoutRespPacket, &playerLogoutResp); err != nil {
 			fmt.Printf("Failed to unmarshal player logout response: %v\n", err)
 			return
 		}
@@ -221,27 +223,50 @@ func main() {
 	fmt.Println("Test completed!")
 }
 
-// readPacket 从连接中读取一个完整的数据包
-func readPacket(conn net.Conn) (*zNet.NetPacket, error) {
-	// 读取包头部
-	headBuf := make([]byte, zNet.NetPacketHeadSize)
-	if _, err := io.ReadFull(conn, headBuf); err != nil {
+// requestSeq 最近一次请求的序号
+var requestSeq uint32
+
+// marshalRequest 序列化请求消息，并在消息体前加上4字节请求序号
+func marshalRequest(req proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
 		return nil, err
 	}
+	requestSeq++
+	seq := make([]byte, 4, 4+len(data))
+	binary.BigEndian.PutUint32(seq, requestSeq)
+	return append(seq, data...), nil
+}
 
-	// 解析包头部
-	packet := &zNet.NetPacket{}
-	if err := packet.UnmarshalHead(headBuf); err != nil {
-		return nil, err
+// unmarshalResponse 解析Response封装并反序列化其中的业务响应
+// 错误码不为0时返回错误
+func unmarshalResponse(packet *zNet.NetPacket, resp proto.Message) error {
+	var envelope protocol.Response
+	if err := proto.Unmarshal(packet.Data, &envelope); err != nil {
+		return err
+	}
+	if envelope.Result != int32(protocol.ErrorCode_ERR_OK) {
+		return fmt.Errorf("request %d failed: code=%d, message=%s", envelope.Seq, envelope.Result, envelope.ErrorMsg)
 	}
+	return proto.Unmarshal(envelope.Data, resp)
+}
+
+// pendingPackets 已从合并消息中拆出、尚未读取的下行消息
+var pendingPackets []*zNet.NetPacket
 
-	// 读取数据部分
-	if packet.DataSize > 0 {
-		packet.Data = make([]byte, packet.DataSize)
-		if _, err := io.ReadFull(conn, packet.Data); err != nil {
+// readPacket 读取下一条下行消息，合并消息（MSG_SYSTEM_BATCH）拆分后依次返回
+func readPacket(conn net.Conn) (*zNet.NetPacket, error) {
+	for len(pendingPackets) == 0 {
+		batched, err := protolayer.ReadMessages(conn)
+		if err != nil {
 			return nil, err
 		}
+		for _, p := range batched {
+			pendingPackets = append(pendingPackets, &zNet.NetPacket{ProtoId: p.ProtoId, DataSize: int32(len(p.Data)), Data: p.Data})
+		}
 	}
 
+	packet := pendingPackets[0]
+	pendingPackets = pendingPackets[1:]
 	return packet, nil
 }
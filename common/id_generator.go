@@ -156,3 +156,12 @@ func GenerateBidID() (BidIdType, error) {
 	}
 	return BidIdType(id), nil
 }
+
+// GenerateSanctionID 生成处罚记录ID
+func GenerateSanctionID() (SanctionIdType, error) {
+	id, err := generateID()
+	if err != nil {
+		return 0, err
+	}
+	return SanctionIdType(id), nil
+}
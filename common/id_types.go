@@ -41,3 +41,6 @@ type AuctionIdType int64
 
 // BidIdType 竞拍记录唯一标识ID类型
 type BidIdType int64
+
+// SanctionIdType 处罚记录唯一标识ID类型
+type SanctionIdType int64
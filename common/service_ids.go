@@ -2,13 +2,16 @@ package common
 
 const (
 	// 服务ID定义：各微服务唯一标识
-	ServiceIdTcpServer  = "tcp_server"  // TCP服务器服务ID
-	ServiceIdHttpServer = "http_server" // HTTP服务器服务ID
+	ServiceIdTcpServer       = "tcp_server"       // TCP服务器服务ID
+	ServiceIdHttpServer      = "http_server"      // HTTP服务器服务ID
+	ServiceIdWebSocketServer = "websocket_server" // WebSocket网关服务ID
+	ServiceIdUdpServer       = "udp_server"       // UDP通道服务ID
 
-	ServiceIdPlayer  = "player_service"  // 玩家服务ID
-	ServiceIdGuild   = "guild_service"   // 公会服务ID
-	ServiceIdAuction = "auction_service" // 拍卖行服务ID
-	ServiceIdMap     = "map_service"     // 地图服务ID
+	ServiceIdPlayer     = "player_service"      // 玩家服务ID
+	ServiceIdGuild      = "guild_service"       // 公会服务ID
+	ServiceIdAuction    = "auction_service"     // 拍卖行服务ID
+	ServiceIdMap        = "map_service"         // 地图服务ID
+	ServiceIdCharDelete = "char_delete_service" // 角色删除服务ID
 
 	ServiceIdDBManager = "db_manager"     // 数据库管理服务ID
 	ServiceIdConfig    = "config_service" // 配置服务ID
@@ -22,6 +22,19 @@ type Config struct {
 	DDoS        zNet.DDoSConfig     // 防DDoS攻击配置
 	Databases   map[string]DBConfig // 多数据库配置，key为数据库名称
 	Pprof       PprofConfig         // pprof性能分析配置
+	Login       LoginConfig         // 登录配置
+	LoginQueue  LoginQueueConfig    // 登录排队配置
+	Reconnect   ReconnectConfig     // 断线重连配置
+	WebSocket   WebSocketConfig     // WebSocket网关配置
+	Udp         UdpConfig           // UDP通道配置
+	NetSecurity NetSecurityConfig   // 传输加密配置
+	SendQueue   SendQueueConfig     // 下行发送队列配置
+	Capture     CaptureConfig       // 流量录制配置
+	RateLimit   RateLimitConfig     // 会话请求限流配置
+	Sanction    SanctionConfig      // 账号处罚配置
+	Naming      NamingConfig        // 命名规则配置
+	CharDelete  CharDeleteConfig    // 角色删除配置
+	LoginLog    LoginLogConfig      // 登录日志配置
 }
 
 // PprofConfig pprof性能分析配置
@@ -30,6 +43,146 @@ type PprofConfig struct {
 	ListenAddress string // pprof监听地址，格式为IP:端口
 }
 
+// 重复登录处理策略
+const (
+	DuplicateLoginKickOld   = "kick_old"   // 踢掉旧连接，由新连接接管
+	DuplicateLoginRejectNew = "reject_new" // 拒绝新连接的登录
+)
+
+// LoginConfig 登录配置
+type LoginConfig struct {
+	DuplicatePolicy    string // 同一账号重复登录时的处理策略: kick_old, reject_new
+	MinProtocolVersion int    // 允许登录的最低客户端协议版本，低于该版本的客户端需强制更新
+}
+
+// LoginQueueConfig 登录排队配置
+// 在线人数达到上限后角色登录进入排队，按通道优先级先进先出放行：GM通道、VIP通道（等级由高到低）、普通通道
+type LoginQueueConfig struct {
+	Enabled         bool   // 是否启用登录排队，关闭时服务器满员直接拒绝角色登录
+	MaxLength       int    // 排队人数上限，队列已满时拒绝角色登录
+	MaxWait         int    // 最长排队时长（秒），超时移出队列
+	NotifyInterval  int    // 排队位置不变时推送排队进度的间隔（秒）
+	GMReservedSlots int    // 为GM保留的在线名额，其他玩家在线人数达到 最大连接数-保留名额 时开始排队
+	GMAccounts      string // GM账号（逗号分隔），可使用保留名额且优先放行
+	VIPLanes        string // VIP通道的最低VIP等级（逗号分隔），如 5,1 表示VIP5以上、VIP1-4两个通道
+}
+
+// ReconnectConfig 断线重连配置
+type ReconnectConfig struct {
+	Enabled          bool // 是否启用断线重连
+	GracePeriod      int  // 断线后保留玩家Actor的时长（秒）
+	ReplayBufferSize int  // 重连时可补发的下行消息数量
+}
+
+// WebSocketConfig WebSocket网关配置
+// 心跳时长与发送通道大小沿用服务器配置
+type WebSocketConfig struct {
+	Enabled           bool   // 是否启用WebSocket网关
+	ListenAddress     string // 监听地址
+	Path              string // WebSocket升级路径
+	MaxClientCount    int    // 最大客户端数量
+	MaxPacketDataSize int    // 单个数据包消息体最大字节数
+	AllowedOrigins    string // 允许的Origin列表（逗号分隔），为空时只允许同源页面，*表示不限制
+}
+
+// UdpConfig UDP通道配置
+// 客户端登录游戏后经TCP获取绑定令牌，再通过UDP绑定到已认证的会话
+type UdpConfig struct {
+	Enabled        bool   // 是否启用UDP通道
+	ListenAddress  string // 监听地址
+	PublicAddress  string // 下发给客户端的地址，为空时客户端使用TCP服务器地址
+	Mtu            int    // 单个UDP数据报最大字节数
+	Interval       int    // 重传与确认的刷新间隔（毫秒）
+	SendWindow     int    // 可靠消息发送窗口（未确认的分片数）
+	MinRto         int    // 最小重传超时（毫秒）
+	MaxRetransmits int    // 单个分片最大重传次数，超过则断开UDP通道
+	IdleTimeout    int    // 通道空闲超时（秒）
+	TokenTTL       int    // 绑定令牌有效期（秒）
+}
+
+// 传输加密算法
+const (
+	CipherAES256GCM        = "aes-256-gcm"
+	CipherChaCha20Poly1305 = "chacha20-poly1305"
+)
+
+// NetSecurityConfig 传输加密配置
+// 连接建立后客户端发起ECDH(X25519)握手协商密钥，此后消息体以AEAD加密
+type NetSecurityConfig struct {
+	Enabled        bool   // 是否允许加密握手
+	Required       bool   // 是否强制加密，开启后未完成握手的会话只能发送握手消息
+	Cipher         string // AEAD算法：aes-256-gcm, chacha20-poly1305
+	SigningKeyFile string // 服务器静态签名私钥文件（PEM格式的Ed25519私钥），握手时对服务器临时公钥签名
+}
+
+// SendQueueConfig 下行发送队列配置
+// 同一刷新周期内产生的下行消息合并为一次写出，队列超出字节预算时优先丢弃同步类消息；
+// 只对登录时声明能拆分合并消息的客户端生效
+type SendQueueConfig struct {
+	Enabled             bool // 是否启用发送队列
+	FlushInterval       int  // 刷新间隔（毫秒）
+	MaxQueuedBytes      int  // 单个会话排队的最大字节数
+	SlowConsumerTimeout int  // 持续无法写出超过该时长（秒）的会话视为慢消费者并断开
+}
+
+// CaptureConfig 流量录制配置
+// 录制默认关闭，由管理接口按账号或会话开启
+type CaptureConfig struct {
+	Dir        string // 录制文件目录
+	AdminToken string // 管理接口令牌，为空时不开放录制管理接口
+}
+
+// SanctionConfig 账号处罚配置
+type SanctionConfig struct {
+	AdminToken string // 处罚管理接口令牌，为空时不开放处罚管理接口
+}
+
+// LoginLogConfig 登录日志配置
+// 记录账号登录、角色登录、登出、断开与踢下线，供客服查询与多开检测
+type LoginLogConfig struct {
+	Enabled    bool   // 是否记录登录日志
+	AdminToken string // 登录日志查询接口令牌，为空时不开放查询接口
+}
+
+// NamingConfig 命名规则配置（角色、公会与宠物名称）
+// 名称长度按显示宽度计算：中日韩文字计2，其他字符计1
+type NamingConfig struct {
+	Locale          string // 名称字符集规则所属语言区域（zh、en、ja、ko）
+	PlayerMinLength int    // 角色名最小宽度
+	PlayerMaxLength int    // 角色名最大宽度
+	GuildMinLength  int    // 公会名最小宽度
+	GuildMaxLength  int    // 公会名最大宽度
+	PetMinLength    int    // 宠物名最小宽度
+	PetMaxLength    int    // 宠物名最大宽度
+	ReservedFile    string // 保留名称列表文件（每行一个，不区分大小写完全匹配）
+	ProfanityFile   string // 敏感词列表文件（每行一个，名称中包含即拒绝）
+	RenameCardItem  int    // 改名卡物品ID，0表示不开放改名
+}
+
+// CharDeleteConfig 角色删除配置
+// 申请删除的角色在冷却期内可恢复，冷却期满后由清除任务删除角色及其数据并释放名称
+type CharDeleteConfig struct {
+	Cooldown      int // 删除冷却期（秒）
+	PurgeInterval int // 清除任务执行间隔（秒）
+	PurgeBatch    int // 清除任务每次最多删除的角色数
+}
+
+// RateLimitConfig 会话请求限流配置
+// 每个会话按消息ID（或消息组）各有一个令牌桶，超限的请求被丢弃；
+// 统计窗口内超限次数增多时依次回复限流错误码、断开连接，账号多次被断开后临时封禁
+type RateLimitConfig struct {
+	Enabled         bool   // 是否启用限流
+	DefaultRate     int    // 未配置规则的消息每秒允许的请求数，0表示不限制
+	DefaultBurst    int    // 未配置规则的消息允许的突发请求数
+	Rules           string // 限流规则（逗号分隔），格式为 消息选择器=每秒请求数/突发请求数
+	ViolationWindow int    // 超限次数统计窗口（秒）
+	WarnAfter       int    // 窗口内超限次数达到该值起回复限流错误码（此前静默丢弃），0表示始终静默丢弃
+	KickAfter       int    // 窗口内超限次数达到该值时断开连接，0表示不断开
+	BanAfter        int    // 账号在封禁统计窗口内因限流被断开的次数达到该值时临时封禁，0表示不封禁
+	BanWindow       int    // 封禁统计窗口（秒）
+	BanDuration     int    // 临时封禁时长（秒）
+}
+
 // 配置监控器
 type ConfigMonitor struct {
 	configPath     string
@@ -187,6 +340,167 @@ func GetPprofConfig() *PprofConfig {
 	return &GlobalConfig.Pprof
 }
 
+// GetLoginConfig 获取登录配置
+func GetLoginConfig() *LoginConfig {
+	if GlobalConfig == nil {
+		return &LoginConfig{
+			DuplicatePolicy:    DuplicateLoginKickOld,
+			MinProtocolVersion: 1,
+		}
+	}
+	return &GlobalConfig.Login
+}
+
+// GetLoginQueueConfig 获取登录排队配置
+func GetLoginQueueConfig() *LoginQueueConfig {
+	if GlobalConfig == nil {
+		return &LoginQueueConfig{
+			Enabled:        true,
+			MaxLength:      5000,
+			MaxWait:        1800,
+			NotifyInterval: 10,
+		}
+	}
+	return &GlobalConfig.LoginQueue
+}
+
+// GetReconnectConfig 获取断线重连配置
+func GetReconnectConfig() *ReconnectConfig {
+	if GlobalConfig == nil {
+		return &ReconnectConfig{
+			Enabled:          true,
+			GracePeriod:      60,
+			ReplayBufferSize: 256,
+		}
+	}
+	return &GlobalConfig.Reconnect
+}
+
+// GetWebSocketConfig 获取WebSocket网关配置
+func GetWebSocketConfig() *WebSocketConfig {
+	if GlobalConfig == nil {
+		return &WebSocketConfig{
+			Enabled:           false,
+			ListenAddress:     "0.0.0.0:8889",
+			Path:              "/ws",
+			MaxClientCount:    10000,
+			MaxPacketDataSize: 1024 * 1024,
+		}
+	}
+	return &GlobalConfig.WebSocket
+}
+
+// GetUdpConfig 获取UDP通道配置
+func GetUdpConfig() *UdpConfig {
+	if GlobalConfig == nil {
+		return &UdpConfig{
+			Enabled:        false,
+			ListenAddress:  "0.0.0.0:8890",
+			Mtu:            1400,
+			Interval:       10,
+			SendWindow:     128,
+			MinRto:         30,
+			MaxRetransmits: 10,
+			IdleTimeout:    30,
+			TokenTTL:       30,
+		}
+	}
+	return &GlobalConfig.Udp
+}
+
+// GetNetSecurityConfig 获取传输加密配置
+func GetNetSecurityConfig() *NetSecurityConfig {
+	if GlobalConfig == nil {
+		return &NetSecurityConfig{
+			Enabled:  false,
+			Required: false,
+			Cipher:   CipherAES256GCM,
+		}
+	}
+	return &GlobalConfig.NetSecurity
+}
+
+// GetSendQueueConfig 获取下行发送队列配置
+func GetSendQueueConfig() *SendQueueConfig {
+	if GlobalConfig == nil {
+		return &SendQueueConfig{
+			Enabled:             false,
+			FlushInterval:       20,
+			MaxQueuedBytes:      1024 * 1024,
+			SlowConsumerTimeout: 10,
+		}
+	}
+	return &GlobalConfig.SendQueue
+}
+
+// GetCaptureConfig 获取流量录制配置
+func GetCaptureConfig() *CaptureConfig {
+	if GlobalConfig == nil {
+		return &CaptureConfig{
+			Dir: "captures",
+		}
+	}
+	return &GlobalConfig.Capture
+}
+
+// GetSanctionConfig 获取账号处罚配置
+func GetSanctionConfig() *SanctionConfig {
+	if GlobalConfig == nil {
+		return &SanctionConfig{}
+	}
+	return &GlobalConfig.Sanction
+}
+
+// GetLoginLogConfig 获取登录日志配置
+func GetLoginLogConfig() *LoginLogConfig {
+	if GlobalConfig == nil {
+		return &LoginLogConfig{Enabled: true}
+	}
+	return &GlobalConfig.LoginLog
+}
+
+// GetNamingConfig 获取命名规则配置
+func GetNamingConfig() *NamingConfig {
+	if GlobalConfig == nil {
+		return &NamingConfig{
+			Locale:          "zh",
+			PlayerMinLength: 4,
+			PlayerMaxLength: 14,
+			GuildMinLength:  4,
+			GuildMaxLength:  16,
+			PetMinLength:    2,
+			PetMaxLength:    12,
+		}
+	}
+	return &GlobalConfig.Naming
+}
+
+// GetCharDeleteConfig 获取角色删除配置
+func GetCharDeleteConfig() *CharDeleteConfig {
+	if GlobalConfig == nil {
+		return &CharDeleteConfig{
+			Cooldown:      604800,
+			PurgeInterval: 600,
+			PurgeBatch:    100,
+		}
+	}
+	return &GlobalConfig.CharDelete
+}
+
+// GetRateLimitConfig 获取会话请求限流配置
+func GetRateLimitConfig() *RateLimitConfig {
+	if GlobalConfig == nil {
+		return &RateLimitConfig{
+			Enabled:         false,
+			DefaultBurst:    1,
+			ViolationWindow: 10,
+			BanWindow:       600,
+			BanDuration:     1800,
+		}
+	}
+	return &GlobalConfig.RateLimit
+}
+
 // LoadConfig 从INI文件加载配置
 func LoadConfig(filePath string) (*Config, error) {
 	// 使用zConfig加载配置文件
@@ -318,6 +632,122 @@ func LoadConfig(filePath string) (*Config, error) {
 		ListenAddress: getConfigString(zcfg, "pprof.listen_address", "localhost:6060"),
 	}
 
+	// 解析登录配置
+	config.Login = LoginConfig{
+		DuplicatePolicy:    getConfigString(zcfg, "login.duplicate_policy", DuplicateLoginKickOld),
+		MinProtocolVersion: getConfigInt(zcfg, "login.min_protocol_version", 1),
+	}
+
+	// 解析登录排队配置
+	config.LoginQueue = LoginQueueConfig{
+		Enabled:         getConfigBool(zcfg, "login_queue.enabled", true),
+		MaxLength:       getConfigInt(zcfg, "login_queue.max_length", 5000),
+		MaxWait:         getConfigInt(zcfg, "login_queue.max_wait", 1800),
+		NotifyInterval:  getConfigInt(zcfg, "login_queue.notify_interval", 10),
+		GMReservedSlots: getConfigInt(zcfg, "login_queue.gm_reserved_slots", 0),
+		GMAccounts:      getConfigString(zcfg, "login_queue.gm_accounts", ""),
+		VIPLanes:        getConfigString(zcfg, "login_queue.vip_lanes", ""),
+	}
+
+	// 解析断线重连配置
+	config.Reconnect = ReconnectConfig{
+		Enabled:          getConfigBool(zcfg, "reconnect.enabled", true),
+		GracePeriod:      getConfigInt(zcfg, "reconnect.grace_period", 60),
+		ReplayBufferSize: getConfigInt(zcfg, "reconnect.replay_buffer_size", 256),
+	}
+
+	// 解析WebSocket网关配置
+	config.WebSocket = WebSocketConfig{
+		Enabled:           getConfigBool(zcfg, "websocket.enabled", false),
+		ListenAddress:     getConfigString(zcfg, "websocket.listen_address", "0.0.0.0:8889"),
+		Path:              getConfigString(zcfg, "websocket.path", "/ws"),
+		MaxClientCount:    getConfigInt(zcfg, "websocket.max_client_count", 10000),
+		MaxPacketDataSize: getConfigInt(zcfg, "websocket.max_packet_data_size", 1024*1024),
+		AllowedOrigins:    getConfigString(zcfg, "websocket.allowed_origins", ""),
+	}
+
+	// 解析UDP通道配置
+	config.Udp = UdpConfig{
+		Enabled:        getConfigBool(zcfg, "udp.enabled", false),
+		ListenAddress:  getConfigString(zcfg, "udp.listen_address", "0.0.0.0:8890"),
+		PublicAddress:  getConfigString(zcfg, "udp.public_address", ""),
+		Mtu:            getConfigInt(zcfg, "udp.mtu", 1400),
+		Interval:       getConfigInt(zcfg, "udp.interval", 10),
+		SendWindow:     getConfigInt(zcfg, "udp.send_window", 128),
+		MinRto:         getConfigInt(zcfg, "udp.min_rto", 30),
+		MaxRetransmits: getConfigInt(zcfg, "udp.max_retransmits", 10),
+		IdleTimeout:    getConfigInt(zcfg, "udp.idle_timeout", 30),
+		TokenTTL:       getConfigInt(zcfg, "udp.token_ttl", 30),
+	}
+
+	// 解析传输加密配置
+	config.NetSecurity = NetSecurityConfig{
+		Enabled:        getConfigBool(zcfg, "net_security.enabled", false),
+		Required:       getConfigBool(zcfg, "net_security.required", false),
+		Cipher:         getConfigString(zcfg, "net_security.cipher", CipherAES256GCM),
+		SigningKeyFile: getConfigString(zcfg, "net_security.signing_key_file", ""),
+	}
+
+	// 解析下行发送队列配置
+	config.SendQueue = SendQueueConfig{
+		Enabled:             getConfigBool(zcfg, "net_send_queue.enabled", false),
+		FlushInterval:       getConfigInt(zcfg, "net_send_queue.flush_interval", 20),
+		MaxQueuedBytes:      getConfigInt(zcfg, "net_send_queue.max_queued_bytes", 1024*1024),
+		SlowConsumerTimeout: getConfigInt(zcfg, "net_send_queue.slow_consumer_timeout", 10),
+	}
+
+	// 解析流量录制配置
+	config.Capture = CaptureConfig{
+		Dir:        getConfigString(zcfg, "net_capture.dir", "captures"),
+		AdminToken: getConfigString(zcfg, "net_capture.admin_token", ""),
+	}
+
+	// 解析会话请求限流配置
+	config.RateLimit = RateLimitConfig{
+		Enabled:         getConfigBool(zcfg, "net_rate_limit.enabled", false),
+		DefaultRate:     getConfigInt(zcfg, "net_rate_limit.default_rate", 0),
+		DefaultBurst:    getConfigInt(zcfg, "net_rate_limit.default_burst", 0),
+		Rules:           getConfigString(zcfg, "net_rate_limit.rules", ""),
+		ViolationWindow: getConfigInt(zcfg, "net_rate_limit.violation_window", 10),
+		WarnAfter:       getConfigInt(zcfg, "net_rate_limit.warn_after", 5),
+		KickAfter:       getConfigInt(zcfg, "net_rate_limit.kick_after", 50),
+		BanAfter:        getConfigInt(zcfg, "net_rate_limit.ban_after", 3),
+		BanWindow:       getConfigInt(zcfg, "net_rate_limit.ban_window", 600),
+		BanDuration:     getConfigInt(zcfg, "net_rate_limit.ban_duration", 1800),
+	}
+
+	// 解析账号处罚配置
+	config.Sanction = SanctionConfig{
+		AdminToken: getConfigString(zcfg, "sanction.admin_token", ""),
+	}
+
+	// 解析登录日志配置
+	config.LoginLog = LoginLogConfig{
+		Enabled:    getConfigBool(zcfg, "login_log.enabled", true),
+		AdminToken: getConfigString(zcfg, "login_log.admin_token", ""),
+	}
+
+	// 解析命名规则配置
+	config.Naming = NamingConfig{
+		Locale:          getConfigString(zcfg, "naming.locale", "zh"),
+		PlayerMinLength: getConfigInt(zcfg, "naming.player_min_length", 4),
+		PlayerMaxLength: getConfigInt(zcfg, "naming.player_max_length", 14),
+		GuildMinLength:  getConfigInt(zcfg, "naming.guild_min_length", 4),
+		GuildMaxLength:  getConfigInt(zcfg, "naming.guild_max_length", 16),
+		PetMinLength:    getConfigInt(zcfg, "naming.pet_min_length", 2),
+		PetMaxLength:    getConfigInt(zcfg, "naming.pet_max_length", 12),
+		ReservedFile:    getConfigString(zcfg, "naming.reserved_file", "resources/naming/reserved.txt"),
+		ProfanityFile:   getConfigString(zcfg, "naming.profanity_file", "resources/naming/profanity.txt"),
+		RenameCardItem:  getConfigInt(zcfg, "naming.rename_card_item", 0),
+	}
+
+	// 解析角色删除配置
+	config.CharDelete = CharDeleteConfig{
+		Cooldown:      getConfigInt(zcfg, "char_delete.cooldown", 604800),
+		PurgeInterval: getConfigInt(zcfg, "char_delete.purge_interval", 600),
+		PurgeBatch:    getConfigInt(zcfg, "char_delete.purge_batch", 100),
+	}
+
 	// 设置全局配置实例
 	GlobalConfig = config
 	return config, nil
@@ -460,6 +890,174 @@ func (c *Config) Validate() error {
 		c.Pprof.ListenAddress = "localhost:6060"
 	}
 
+	// 验证登录配置
+	switch c.Login.DuplicatePolicy {
+	case "":
+		c.Login.DuplicatePolicy = DuplicateLoginKickOld
+	case DuplicateLoginKickOld, DuplicateLoginRejectNew:
+	default:
+		return fmt.Errorf("login duplicate_policy must be %s or %s", DuplicateLoginKickOld, DuplicateLoginRejectNew)
+	}
+	if c.Login.MinProtocolVersion <= 0 {
+		c.Login.MinProtocolVersion = 1
+	}
+
+	// 验证登录排队配置
+	if c.LoginQueue.MaxLength < 0 {
+		c.LoginQueue.MaxLength = 0
+	}
+	if c.LoginQueue.MaxWait <= 0 {
+		c.LoginQueue.MaxWait = 1800
+	}
+	if c.LoginQueue.NotifyInterval <= 0 {
+		c.LoginQueue.NotifyInterval = 10
+	}
+	if c.LoginQueue.GMReservedSlots < 0 {
+		c.LoginQueue.GMReservedSlots = 0
+	}
+	if c.LoginQueue.GMReservedSlots > c.Server.MaxClientCount {
+		c.LoginQueue.GMReservedSlots = c.Server.MaxClientCount
+	}
+
+	// 验证断线重连配置
+	if c.Reconnect.GracePeriod <= 0 {
+		c.Reconnect.GracePeriod = 60
+	}
+	if c.Reconnect.ReplayBufferSize <= 0 {
+		c.Reconnect.ReplayBufferSize = 256
+	}
+
+	// 验证WebSocket网关配置
+	if c.WebSocket.Enabled && c.WebSocket.ListenAddress == "" {
+		return fmt.Errorf("websocket listen_address is required")
+	}
+	if c.WebSocket.Path == "" {
+		c.WebSocket.Path = "/ws"
+	}
+	if c.WebSocket.MaxClientCount <= 0 {
+		c.WebSocket.MaxClientCount = 10000
+	}
+	if c.WebSocket.MaxPacketDataSize <= 0 {
+		c.WebSocket.MaxPacketDataSize = 1024 * 1024
+	}
+
+	// 验证UDP通道配置
+	if c.Udp.Enabled && c.Udp.ListenAddress == "" {
+		return fmt.Errorf("udp listen_address is required")
+	}
+	if c.Udp.Mtu < 576 || c.Udp.Mtu > 65507 {
+		c.Udp.Mtu = 1400
+	}
+	if c.Udp.Interval < 1 || c.Udp.Interval > 100 {
+		c.Udp.Interval = 10
+	}
+	if c.Udp.SendWindow <= 0 {
+		c.Udp.SendWindow = 128
+	}
+	if c.Udp.MinRto <= 0 {
+		c.Udp.MinRto = 30
+	}
+	if c.Udp.MaxRetransmits <= 0 {
+		c.Udp.MaxRetransmits = 10
+	}
+	if c.Udp.IdleTimeout <= 0 {
+		c.Udp.IdleTimeout = 30
+	}
+	if c.Udp.TokenTTL <= 0 {
+		c.Udp.TokenTTL = 30
+	}
+
+	// 验证传输加密配置
+	switch c.NetSecurity.Cipher {
+	case "":
+		c.NetSecurity.Cipher = CipherAES256GCM
+	case CipherAES256GCM, CipherChaCha20Poly1305:
+	default:
+		return fmt.Errorf("invalid net_security cipher: %s", c.NetSecurity.Cipher)
+	}
+	if c.NetSecurity.Required && !c.NetSecurity.Enabled {
+		return fmt.Errorf("net_security required needs net_security enabled")
+	}
+	if c.NetSecurity.Enabled && c.NetSecurity.SigningKeyFile == "" {
+		return fmt.Errorf("net_security enabled needs net_security signing_key_file")
+	}
+
+	// 验证下行发送队列配置
+	if c.SendQueue.FlushInterval < 1 || c.SendQueue.FlushInterval > 1000 {
+		c.SendQueue.FlushInterval = 20
+	}
+	if c.SendQueue.MaxQueuedBytes <= 0 {
+		c.SendQueue.MaxQueuedBytes = 1024 * 1024
+	}
+	if c.SendQueue.SlowConsumerTimeout <= 0 {
+		c.SendQueue.SlowConsumerTimeout = 10
+	}
+
+	// 验证流量录制配置
+	if c.Capture.Dir == "" {
+		c.Capture.Dir = "captures"
+	}
+
+	// 验证会话请求限流配置
+	if c.RateLimit.DefaultRate < 0 {
+		c.RateLimit.DefaultRate = 0
+	}
+	if c.RateLimit.DefaultBurst < c.RateLimit.DefaultRate {
+		c.RateLimit.DefaultBurst = c.RateLimit.DefaultRate
+	}
+	if c.RateLimit.DefaultBurst < 1 {
+		c.RateLimit.DefaultBurst = 1
+	}
+	if c.RateLimit.ViolationWindow <= 0 {
+		c.RateLimit.ViolationWindow = 10
+	}
+	if c.RateLimit.WarnAfter < 0 {
+		c.RateLimit.WarnAfter = 0
+	}
+	if c.RateLimit.KickAfter < 0 {
+		c.RateLimit.KickAfter = 0
+	}
+	if c.RateLimit.BanAfter < 0 {
+		c.RateLimit.BanAfter = 0
+	}
+	if c.RateLimit.BanWindow <= 0 {
+		c.RateLimit.BanWindow = 600
+	}
+	if c.RateLimit.BanDuration <= 0 {
+		c.RateLimit.BanDuration = 1800
+	}
+
+	// 验证命名规则配置
+	if c.Naming.Locale == "" {
+		c.Naming.Locale = "zh"
+	}
+	for _, lengths := range [][2]*int{
+		{&c.Naming.PlayerMinLength, &c.Naming.PlayerMaxLength},
+		{&c.Naming.GuildMinLength, &c.Naming.GuildMaxLength},
+		{&c.Naming.PetMinLength, &c.Naming.PetMaxLength},
+	} {
+		if *lengths[0] < 1 {
+			*lengths[0] = 1
+		}
+		if *lengths[1] < *lengths[0] {
+			*lengths[1] = *lengths[0]
+		}
+	}
+	if c.Naming.RenameCardItem < 0 {
+		c.Naming.RenameCardItem = 0
+	}
+
+	// 验证角色删除配置
+	if c.CharDelete.Cooldown < 0 {
+		c.CharDelete.Cooldown = 0
+	}
+	if c.CharDelete.PurgeInterval <= 0 {
+		c.CharDelete.PurgeInterval = 600
+	}
+	if c.CharDelete.PurgeBatch <= 0 {
+		c.CharDelete.PurgeBatch = 100
+	}
+
 	return nil
 }
 
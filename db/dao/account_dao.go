@@ -3,12 +3,17 @@ package dao
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pzqf/zGameServer/db/connector"
 	"github.com/pzqf/zGameServer/db/models"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// sanctionColumns 处罚表查询与写入的列，顺序与scanSanction一致
+const sanctionColumns = "sanction_id, account_id, player_id, type, reason, issuer, created_at, expire_at, revoked_at, revoked_by, revoke_reason"
+
 // AccountDAO 账号数据访问对象
 type AccountDAO struct {
 	connector connector.DBConnector
@@ -359,3 +364,207 @@ func (dao *AccountDAO) UpdateLastLoginAt(accountID int64, lastLoginAt string, ca
 		})
 	}
 }
+
+// GetSanctionsByAccountID 获取账号的全部处罚记录（含已撤销与已到期，按创建时间升序）
+func (dao *AccountDAO) GetSanctionsByAccountID(accountID int64, callback func([]*models.AccountSanction, error)) {
+	// 根据数据库驱动类型执行不同的查询操作
+	if dao.connector.GetDriver() == "mongo" {
+		// MongoDB查询
+		collection := dao.connector.GetMongoDB().Collection(models.AccountSanction{}.TableName())
+
+		opts := &options.FindOptions{Sort: bson.M{"created_at": 1}}
+		cursor, err := collection.Find(nil, bson.M{"account_id": accountID}, opts)
+		if err != nil {
+			if callback != nil {
+				callback(nil, err)
+			}
+			return
+		}
+		defer cursor.Close(nil)
+
+		var sanctions []*models.AccountSanction
+		for cursor.Next(nil) {
+			var sanction models.AccountSanction
+			if err := cursor.Decode(&sanction); err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			sanctions = append(sanctions, &sanction)
+		}
+
+		if callback != nil {
+			callback(sanctions, nil)
+		}
+	} else {
+		// MySQL查询
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE account_id = ? ORDER BY created_at ASC", sanctionColumns, models.AccountSanction{}.TableName())
+
+		dao.connector.Query(query, []interface{}{accountID}, func(rows *sql.Rows, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			defer rows.Close()
+
+			var sanctions []*models.AccountSanction
+			for rows.Next() {
+				sanction, err := scanSanction(rows)
+				if err != nil {
+					if callback != nil {
+						callback(nil, err)
+					}
+					return
+				}
+				sanctions = append(sanctions, sanction)
+			}
+
+			if callback != nil {
+				callback(sanctions, nil)
+			}
+		})
+	}
+}
+
+// CreateSanction 创建处罚记录
+func (dao *AccountDAO) CreateSanction(sanction *models.AccountSanction, callback func(int64, error)) {
+	// 根据数据库驱动类型执行不同的插入操作
+	if dao.connector.GetDriver() == "mongo" {
+		// MongoDB插入
+		collection := dao.connector.GetMongoDB().Collection(models.AccountSanction{}.TableName())
+
+		if _, err := collection.InsertOne(nil, sanction); err != nil {
+			if callback != nil {
+				callback(0, err)
+			}
+			return
+		}
+
+		if callback != nil {
+			callback(sanction.SanctionID, nil)
+		}
+	} else {
+		// MySQL插入
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", models.AccountSanction{}.TableName(), sanctionColumns)
+
+		args := []interface{}{
+			sanction.SanctionID,
+			sanction.AccountID,
+			sanction.PlayerID,
+			sanction.Type,
+			sanction.Reason,
+			sanction.Issuer,
+			sanction.CreatedAt,
+			sanctionTime(sanction.ExpireAt),
+			sanctionTime(sanction.RevokedAt),
+			sanction.RevokedBy,
+			sanction.RevokeReason,
+		}
+
+		dao.connector.Execute(query, args, func(result sql.Result, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(0, err)
+				}
+				return
+			}
+
+			if callback != nil {
+				callback(sanction.SanctionID, nil)
+			}
+		})
+	}
+}
+
+// RevokeSanction 撤销处罚记录（只更新撤销信息）
+func (dao *AccountDAO) RevokeSanction(sanction *models.AccountSanction, callback func(bool, error)) {
+	// 根据数据库驱动类型执行不同的更新操作
+	if dao.connector.GetDriver() == "mongo" {
+		// MongoDB更新
+		collection := dao.connector.GetMongoDB().Collection(models.AccountSanction{}.TableName())
+
+		update := bson.M{
+			"$set": bson.M{
+				"revoked_at":    sanction.RevokedAt,
+				"revoked_by":    sanction.RevokedBy,
+				"revoke_reason": sanction.RevokeReason,
+			},
+		}
+
+		result, err := collection.UpdateOne(nil, bson.M{"sanction_id": sanction.SanctionID}, update)
+		if err != nil {
+			if callback != nil {
+				callback(false, err)
+			}
+			return
+		}
+
+		if callback != nil {
+			callback(result.ModifiedCount > 0, nil)
+		}
+	} else {
+		// MySQL更新
+		query := fmt.Sprintf("UPDATE %s SET revoked_at = ?, revoked_by = ?, revoke_reason = ? WHERE sanction_id = ?", models.AccountSanction{}.TableName())
+
+		args := []interface{}{
+			sanctionTime(sanction.RevokedAt),
+			sanction.RevokedBy,
+			sanction.RevokeReason,
+			sanction.SanctionID,
+		}
+
+		dao.connector.Execute(query, args, func(result sql.Result, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(false, err)
+				}
+				return
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if callback != nil {
+				callback(rowsAffected > 0, err)
+			}
+		})
+	}
+}
+
+// scanSanction 按sanctionColumns的顺序扫描一行处罚记录
+// expire_at为NULL表示永久，revoked_at为NULL表示未撤销
+func scanSanction(rows *sql.Rows) (*models.AccountSanction, error) {
+	var sanction models.AccountSanction
+	var expireAt, revokedAt sql.NullTime
+	if err := rows.Scan(
+		&sanction.SanctionID,
+		&sanction.AccountID,
+		&sanction.PlayerID,
+		&sanction.Type,
+		&sanction.Reason,
+		&sanction.Issuer,
+		&sanction.CreatedAt,
+		&expireAt,
+		&revokedAt,
+		&sanction.RevokedBy,
+		&sanction.RevokeReason,
+	); err != nil {
+		return nil, err
+	}
+	if expireAt.Valid {
+		sanction.ExpireAt = expireAt.Time
+	}
+	if revokedAt.Valid {
+		sanction.RevokedAt = revokedAt.Time
+	}
+	return &sanction, nil
+}
+
+// sanctionTime 处罚到期与撤销时间的列值，未设置（永久或未撤销）时写入NULL
+func sanctionTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
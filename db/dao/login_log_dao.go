@@ -3,6 +3,7 @@ package dao
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/pzqf/zGameServer/common"
 	"github.com/pzqf/zGameServer/db/connector"
@@ -11,6 +12,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// loginLogColumns 登录日志表查询与写入的列，顺序与scanLoginLog一致
+// 显式列出而不用SELECT *，避免表结构变更（如迁移脚本追加列）后按位置扫描错位
+const loginLogColumns = "log_id, player_id, player_name, op_type, ip, device, created_at, account_id, device_type, duration, reason"
+
 type LoginLogDAO struct {
 	connector connector.DBConnector
 }
@@ -47,7 +52,7 @@ func (dao *LoginLogDAO) GetLoginLogByPlayerID(playerID int64, callback func(*mod
 			callback(&loginLog, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE player_id = ?", models.LoginLog{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE player_id = ?", loginLogColumns, models.LoginLog{}.TableName())
 
 		dao.connector.Query(query, []interface{}{playerID}, func(rows *sql.Rows, err error) {
 			if err != nil {
@@ -58,17 +63,9 @@ func (dao *LoginLogDAO) GetLoginLogByPlayerID(playerID int64, callback func(*mod
 			}
 			defer rows.Close()
 
-			var loginLog models.LoginLog
 			if rows.Next() {
-				if err := rows.Scan(
-					&loginLog.LogID,
-					&loginLog.PlayerID,
-					&loginLog.PlayerName,
-					&loginLog.OpType,
-					&loginLog.IP,
-					&loginLog.Device,
-					&loginLog.CreatedAt,
-				); err != nil {
+				loginLog, err := scanLoginLog(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
@@ -76,7 +73,7 @@ func (dao *LoginLogDAO) GetLoginLogByPlayerID(playerID int64, callback func(*mod
 				}
 
 				if callback != nil {
-					callback(&loginLog, nil)
+					callback(loginLog, nil)
 				}
 			} else {
 				if callback != nil {
@@ -113,7 +110,7 @@ func (dao *LoginLogDAO) CreateLoginLog(loginLog *models.LoginLog, callback func(
 			callback(loginLog.LogID, nil)
 		}
 	} else {
-		query := fmt.Sprintf("INSERT INTO %s (log_id, player_id, player_name, op_type, ip, device, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)", models.LoginLog{}.TableName())
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", models.LoginLog{}.TableName(), loginLogColumns)
 
 		args := []interface{}{
 			loginLog.LogID,
@@ -123,6 +120,10 @@ func (dao *LoginLogDAO) CreateLoginLog(loginLog *models.LoginLog, callback func(
 			loginLog.IP,
 			loginLog.Device,
 			loginLog.CreatedAt,
+			loginLog.AccountID,
+			loginLog.DeviceType,
+			loginLog.Duration,
+			loginLog.Reason,
 		}
 
 		dao.connector.Execute(query, args, func(result sql.Result, err error) {
@@ -178,7 +179,7 @@ func (dao *LoginLogDAO) GetLoginLogsByPlayerID(playerID int64, limit int, callba
 			callback(loginLogs, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE player_id = ? ORDER BY created_at DESC", models.LoginLog{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE player_id = ? ORDER BY created_at DESC", loginLogColumns, models.LoginLog{}.TableName())
 		if limit > 0 {
 			query = fmt.Sprintf("%s LIMIT %d", query, limit)
 		}
@@ -194,23 +195,15 @@ func (dao *LoginLogDAO) GetLoginLogsByPlayerID(playerID int64, limit int, callba
 
 			var loginLogs []*models.LoginLog
 			for rows.Next() {
-				var loginLog models.LoginLog
-				if err := rows.Scan(
-					&loginLog.LogID,
-					&loginLog.PlayerID,
-					&loginLog.PlayerName,
-					&loginLog.OpType,
-					&loginLog.IP,
-					&loginLog.Device,
-					&loginLog.CreatedAt,
-				); err != nil {
+				loginLog, err := scanLoginLog(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
 					return
 				}
 
-				loginLogs = append(loginLogs, &loginLog)
+				loginLogs = append(loginLogs, loginLog)
 			}
 
 			if callback != nil {
@@ -257,7 +250,7 @@ func (dao *LoginLogDAO) GetLoginLogsByOpType(opType int32, limit int, callback f
 			callback(loginLogs, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE op_type = ? ORDER BY created_at DESC", models.LoginLog{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE op_type = ? ORDER BY created_at DESC", loginLogColumns, models.LoginLog{}.TableName())
 		if limit > 0 {
 			query = fmt.Sprintf("%s LIMIT %d", query, limit)
 		}
@@ -273,23 +266,143 @@ func (dao *LoginLogDAO) GetLoginLogsByOpType(opType int32, limit int, callback f
 
 			var loginLogs []*models.LoginLog
 			for rows.Next() {
-				var loginLog models.LoginLog
-				if err := rows.Scan(
-					&loginLog.LogID,
-					&loginLog.PlayerID,
-					&loginLog.PlayerName,
-					&loginLog.OpType,
-					&loginLog.IP,
-					&loginLog.Device,
-					&loginLog.CreatedAt,
-				); err != nil {
+				loginLog, err := scanLoginLog(rows)
+				if err != nil {
+					if callback != nil {
+						callback(nil, err)
+					}
+					return
+				}
+
+				loginLogs = append(loginLogs, loginLog)
+			}
+
+			if callback != nil {
+				callback(loginLogs, nil)
+			}
+		})
+	}
+}
+
+// QueryLoginLogs 按条件查询登录日志
+// 参数:
+//   - filter: 查询条件，零值字段不参与过滤
+//   - callback: 回调函数，返回登录日志列表（按时间倒序）
+func (dao *LoginLogDAO) QueryLoginLogs(filter *models.LoginLogFilter, callback func([]*models.LoginLog, error)) {
+	if dao.connector.GetDriver() == "mongo" {
+		collection := dao.connector.GetMongoDB().Collection(models.LoginLog{}.TableName())
+
+		query := bson.M{}
+		if filter.AccountID > 0 {
+			query["account_id"] = filter.AccountID
+		}
+		if filter.PlayerID > 0 {
+			query["player_id"] = filter.PlayerID
+		}
+		if filter.IP != "" {
+			query["ip"] = filter.IP
+		}
+		if filter.Device != "" {
+			query["device"] = filter.Device
+		}
+		createdAt := bson.M{}
+		if !filter.Start.IsZero() {
+			createdAt["$gte"] = filter.Start
+		}
+		if !filter.End.IsZero() {
+			createdAt["$lt"] = filter.End
+		}
+		if len(createdAt) > 0 {
+			query["created_at"] = createdAt
+		}
+
+		opts := &options.FindOptions{Sort: bson.M{"created_at": -1}}
+		if filter.Limit > 0 {
+			opts.Limit = func(i int64) *int64 { return &i }(int64(filter.Limit))
+		}
+
+		cursor, err := collection.Find(nil, query, opts)
+
+		if err != nil {
+			if callback != nil {
+				callback(nil, err)
+			}
+			return
+		}
+		defer cursor.Close(nil)
+
+		var loginLogs []*models.LoginLog
+		for cursor.Next(nil) {
+			var loginLog models.LoginLog
+			if err := cursor.Decode(&loginLog); err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			loginLogs = append(loginLogs, &loginLog)
+		}
+
+		if callback != nil {
+			callback(loginLogs, nil)
+		}
+	} else {
+		var conditions []string
+		var args []interface{}
+		if filter.AccountID > 0 {
+			conditions = append(conditions, "account_id = ?")
+			args = append(args, filter.AccountID)
+		}
+		if filter.PlayerID > 0 {
+			conditions = append(conditions, "player_id = ?")
+			args = append(args, filter.PlayerID)
+		}
+		if filter.IP != "" {
+			conditions = append(conditions, "ip = ?")
+			args = append(args, filter.IP)
+		}
+		if filter.Device != "" {
+			conditions = append(conditions, "device = ?")
+			args = append(args, filter.Device)
+		}
+		if !filter.Start.IsZero() {
+			conditions = append(conditions, "created_at >= ?")
+			args = append(args, filter.Start)
+		}
+		if !filter.End.IsZero() {
+			conditions = append(conditions, "created_at < ?")
+			args = append(args, filter.End)
+		}
+
+		query := fmt.Sprintf("SELECT %s FROM %s", loginLogColumns, models.LoginLog{}.TableName())
+		if len(conditions) > 0 {
+			query = fmt.Sprintf("%s WHERE %s", query, strings.Join(conditions, " AND "))
+		}
+		query += " ORDER BY created_at DESC"
+		if filter.Limit > 0 {
+			query = fmt.Sprintf("%s LIMIT %d", query, filter.Limit)
+		}
+
+		dao.connector.Query(query, args, func(rows *sql.Rows, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			defer rows.Close()
+
+			var loginLogs []*models.LoginLog
+			for rows.Next() {
+				loginLog, err := scanLoginLog(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
 					return
 				}
 
-				loginLogs = append(loginLogs, &loginLog)
+				loginLogs = append(loginLogs, loginLog)
 			}
 
 			if callback != nil {
@@ -298,3 +411,24 @@ func (dao *LoginLogDAO) GetLoginLogsByOpType(opType int32, limit int, callback f
 		})
 	}
 }
+
+// scanLoginLog 按loginLogColumns的顺序扫描一行登录日志
+func scanLoginLog(rows *sql.Rows) (*models.LoginLog, error) {
+	var loginLog models.LoginLog
+	if err := rows.Scan(
+		&loginLog.LogID,
+		&loginLog.PlayerID,
+		&loginLog.PlayerName,
+		&loginLog.OpType,
+		&loginLog.IP,
+		&loginLog.Device,
+		&loginLog.CreatedAt,
+		&loginLog.AccountID,
+		&loginLog.DeviceType,
+		&loginLog.Duration,
+		&loginLog.Reason,
+	); err != nil {
+		return nil, err
+	}
+	return &loginLog, nil
+}
@@ -0,0 +1,178 @@
+package dao
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pzqf/zGameServer/db/connector"
+	"github.com/pzqf/zGameServer/db/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// NameDAO 名称占用数据访问对象
+// 依赖 kind + name_key 唯一索引保证同类名称全服唯一
+type NameDAO struct {
+	connector connector.DBConnector
+}
+
+// NewNameDAO 创建名称占用DAO
+func NewNameDAO(dbConnector connector.DBConnector) *NameDAO {
+	return &NameDAO{
+		connector: dbConnector,
+	}
+}
+
+// GetName 获取名称占用记录
+// 参数:
+//   - kind: 名称类型
+//   - nameKey: 大小写折叠后的名称
+//   - callback: 回调函数，记录不存在时返回nil
+func (dao *NameDAO) GetName(kind int32, nameKey string, callback func(*models.NameRecord, error)) {
+	if dao.connector.GetDriver() == "mongo" {
+		collection := dao.connector.GetMongoDB().Collection(models.NameRecord{}.TableName())
+		var record models.NameRecord
+
+		err := collection.FindOne(nil, bson.M{"kind": kind, "name_key": nameKey}).Decode(&record)
+		if err == mongo.ErrNoDocuments {
+			if callback != nil {
+				callback(nil, nil)
+			}
+			return
+		}
+		if err != nil {
+			if callback != nil {
+				callback(nil, err)
+			}
+			return
+		}
+
+		if callback != nil {
+			callback(&record, nil)
+		}
+	} else {
+		query := fmt.Sprintf("SELECT kind, name_key, name, owner_id, created_at FROM %s WHERE kind = ? AND name_key = ?", models.NameRecord{}.TableName())
+
+		dao.connector.Query(query, []interface{}{kind, nameKey}, func(rows *sql.Rows, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			defer rows.Close()
+
+			if !rows.Next() {
+				if callback != nil {
+					callback(nil, nil)
+				}
+				return
+			}
+
+			var record models.NameRecord
+			if err := rows.Scan(
+				&record.Kind,
+				&record.NameKey,
+				&record.Name,
+				&record.OwnerID,
+				&record.CreatedAt,
+			); err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+
+			if callback != nil {
+				callback(&record, nil)
+			}
+		})
+	}
+}
+
+// CreateName 占用名称
+// 参数:
+//   - record: 名称占用记录
+//   - callback: 回调函数，名称已被占用时返回false
+func (dao *NameDAO) CreateName(record *models.NameRecord, callback func(bool, error)) {
+	if dao.connector.GetDriver() == "mongo" {
+		collection := dao.connector.GetMongoDB().Collection(models.NameRecord{}.TableName())
+
+		_, err := collection.InsertOne(nil, record)
+		if mongo.IsDuplicateKeyError(err) {
+			if callback != nil {
+				callback(false, nil)
+			}
+			return
+		}
+
+		if callback != nil {
+			callback(err == nil, err)
+		}
+	} else {
+		// 唯一索引冲突时不插入，以影响行数判断名称是否已被占用
+		query := fmt.Sprintf("INSERT IGNORE INTO %s (kind, name_key, name, owner_id, created_at) VALUES (?, ?, ?, ?, ?)", models.NameRecord{}.TableName())
+
+		args := []interface{}{
+			record.Kind,
+			record.NameKey,
+			record.Name,
+			record.OwnerID,
+			record.CreatedAt,
+		}
+
+		dao.connector.Execute(query, args, func(result sql.Result, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(false, err)
+				}
+				return
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if callback != nil {
+				callback(rowsAffected > 0, err)
+			}
+		})
+	}
+}
+
+// DeleteName 释放名称
+// 参数:
+//   - kind: 名称类型
+//   - nameKey: 大小写折叠后的名称
+//   - ownerID: 所有者ID（只删除属于该所有者的记录）
+//   - callback: 回调函数，返回是否删除
+func (dao *NameDAO) DeleteName(kind int32, nameKey string, ownerID int64, callback func(bool, error)) {
+	if dao.connector.GetDriver() == "mongo" {
+		collection := dao.connector.GetMongoDB().Collection(models.NameRecord{}.TableName())
+
+		result, err := collection.DeleteOne(nil, bson.M{"kind": kind, "name_key": nameKey, "owner_id": ownerID})
+		if err != nil {
+			if callback != nil {
+				callback(false, err)
+			}
+			return
+		}
+
+		if callback != nil {
+			callback(result.DeletedCount > 0, nil)
+		}
+	} else {
+		query := fmt.Sprintf("DELETE FROM %s WHERE kind = ? AND name_key = ? AND owner_id = ?", models.NameRecord{}.TableName())
+
+		dao.connector.Execute(query, []interface{}{kind, nameKey, ownerID}, func(result sql.Result, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(false, err)
+				}
+				return
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if callback != nil {
+				callback(rowsAffected > 0, err)
+			}
+		})
+	}
+}
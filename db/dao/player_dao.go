@@ -3,12 +3,18 @@ package dao
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pzqf/zGameServer/db/connector"
 	"github.com/pzqf/zGameServer/db/models"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// playerColumns 玩家表查询的列，顺序与scanPlayer一致
+// 显式列出而不用SELECT *，避免表结构变更（如迁移脚本追加列）后按位置扫描错位
+const playerColumns = "player_id, player_name, account_id, sex, age, level, created_at, updated_at, vip_level, delete_at"
+
 // PlayerDAO 玩家数据访问对象
 // 提供玩家数据的CRUD操作，支持MongoDB和MySQL双数据库
 type PlayerDAO struct {
@@ -56,7 +62,7 @@ func (dao *PlayerDAO) GetPlayerByID(playerID int64, callback func(*models.Player
 			callback(&player, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE player_id = ?", models.Player{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE player_id = ?", playerColumns, models.Player{}.TableName())
 
 		dao.connector.Query(query, []interface{}{playerID}, func(rows *sql.Rows, err error) {
 			if err != nil {
@@ -67,18 +73,9 @@ func (dao *PlayerDAO) GetPlayerByID(playerID int64, callback func(*models.Player
 			}
 			defer rows.Close()
 
-			var player models.Player
 			if rows.Next() {
-				if err := rows.Scan(
-					&player.PlayerID,
-					&player.PlayerName,
-					&player.AccountID,
-					&player.Sex,
-					&player.Age,
-					&player.Level,
-					&player.CreatedAt,
-					&player.UpdatedAt,
-				); err != nil {
+				player, err := scanPlayer(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
@@ -86,7 +83,7 @@ func (dao *PlayerDAO) GetPlayerByID(playerID int64, callback func(*models.Player
 				}
 
 				if callback != nil {
-					callback(&player, nil)
+					callback(player, nil)
 				}
 			} else {
 				if callback != nil {
@@ -118,7 +115,7 @@ func (dao *PlayerDAO) CreatePlayer(player *models.Player, callback func(int64, e
 			callback(player.PlayerID, nil)
 		}
 	} else {
-		query := fmt.Sprintf("INSERT INTO %s (player_id, account_id, player_name, sex, age, level, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)", models.Player{}.TableName())
+		query := fmt.Sprintf("INSERT INTO %s (player_id, account_id, player_name, sex, age, level, created_at, updated_at, vip_level, delete_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", models.Player{}.TableName())
 
 		args := []interface{}{
 			player.PlayerID,
@@ -129,6 +126,8 @@ func (dao *PlayerDAO) CreatePlayer(player *models.Player, callback func(int64, e
 			player.Level,
 			player.CreatedAt,
 			player.UpdatedAt,
+			player.VipLevel,
+			playerDeleteAt(player.DeleteAt),
 		}
 
 		dao.connector.Execute(query, args, func(result sql.Result, err error) {
@@ -161,6 +160,8 @@ func (dao *PlayerDAO) UpdatePlayer(player *models.Player, callback func(bool, er
 				"sex":         player.Sex,
 				"age":         player.Age,
 				"level":       player.Level,
+				"vip_level":   player.VipLevel,
+				"delete_at":   player.DeleteAt,
 				"updated_at":  player.UpdatedAt,
 			},
 		}
@@ -178,13 +179,15 @@ func (dao *PlayerDAO) UpdatePlayer(player *models.Player, callback func(bool, er
 			callback(result.ModifiedCount > 0, nil)
 		}
 	} else {
-		query := fmt.Sprintf("UPDATE %s SET player_name = ?, sex = ?, age = ?, level = ?, updated_at = ? WHERE player_id = ?", models.Player{}.TableName())
+		query := fmt.Sprintf("UPDATE %s SET player_name = ?, sex = ?, age = ?, level = ?, vip_level = ?, delete_at = ?, updated_at = ? WHERE player_id = ?", models.Player{}.TableName())
 
 		args := []interface{}{
 			player.PlayerName,
 			player.Sex,
 			player.Age,
 			player.Level,
+			player.VipLevel,
+			playerDeleteAt(player.DeleteAt),
 			player.UpdatedAt,
 			player.PlayerID,
 		}
@@ -277,7 +280,7 @@ func (dao *PlayerDAO) GetAllPlayers(callback func([]*models.Player, error)) {
 			callback(players, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s", models.Player{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s", playerColumns, models.Player{}.TableName())
 
 		dao.connector.Query(query, nil, func(rows *sql.Rows, err error) {
 			if err != nil {
@@ -290,24 +293,15 @@ func (dao *PlayerDAO) GetAllPlayers(callback func([]*models.Player, error)) {
 
 			var players []*models.Player
 			for rows.Next() {
-				var player models.Player
-				if err := rows.Scan(
-					&player.PlayerID,
-					&player.PlayerName,
-					&player.AccountID,
-					&player.Sex,
-					&player.Age,
-					&player.Level,
-					&player.CreatedAt,
-					&player.UpdatedAt,
-				); err != nil {
+				player, err := scanPlayer(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
 					return
 				}
 
-				players = append(players, &player)
+				players = append(players, player)
 			}
 
 			if callback != nil {
@@ -351,7 +345,7 @@ func (dao *PlayerDAO) GetPlayersByAccountID(accountID int64, callback func([]*mo
 			callback(players, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE account_id = ?", models.Player{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE account_id = ?", playerColumns, models.Player{}.TableName())
 
 		dao.connector.Query(query, []interface{}{accountID}, func(rows *sql.Rows, err error) {
 			if err != nil {
@@ -364,24 +358,15 @@ func (dao *PlayerDAO) GetPlayersByAccountID(accountID int64, callback func([]*mo
 
 			var players []*models.Player
 			for rows.Next() {
-				var player models.Player
-				if err := rows.Scan(
-					&player.PlayerID,
-					&player.PlayerName,
-					&player.AccountID,
-					&player.Sex,
-					&player.Age,
-					&player.Level,
-					&player.CreatedAt,
-					&player.UpdatedAt,
-				); err != nil {
+				player, err := scanPlayer(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
 					return
 				}
 
-				players = append(players, &player)
+				players = append(players, player)
 			}
 
 			if callback != nil {
@@ -421,7 +406,7 @@ func (dao *PlayerDAO) GetPlayerByName(name string, callback func(*models.Player,
 			callback(&player, nil)
 		}
 	} else {
-		query := fmt.Sprintf("SELECT * FROM %s WHERE player_name = ?", models.Player{}.TableName())
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE player_name = ?", playerColumns, models.Player{}.TableName())
 
 		dao.connector.Query(query, []interface{}{name}, func(rows *sql.Rows, err error) {
 			if err != nil {
@@ -432,18 +417,9 @@ func (dao *PlayerDAO) GetPlayerByName(name string, callback func(*models.Player,
 			}
 			defer rows.Close()
 
-			var player models.Player
 			if rows.Next() {
-				if err := rows.Scan(
-					&player.PlayerID,
-					&player.PlayerName,
-					&player.AccountID,
-					&player.Sex,
-					&player.Age,
-					&player.Level,
-					&player.CreatedAt,
-					&player.UpdatedAt,
-				); err != nil {
+				player, err := scanPlayer(rows)
+				if err != nil {
 					if callback != nil {
 						callback(nil, err)
 					}
@@ -451,7 +427,7 @@ func (dao *PlayerDAO) GetPlayerByName(name string, callback func(*models.Player,
 				}
 
 				if callback != nil {
-					callback(&player, nil)
+					callback(player, nil)
 				}
 			} else {
 				if callback != nil {
@@ -461,3 +437,111 @@ func (dao *PlayerDAO) GetPlayerByName(name string, callback func(*models.Player,
 		})
 	}
 }
+
+// GetPlayersPendingDeletion 获取删除冷却期已满、待清除的玩家
+// 参数:
+//   - before: 截止时间，计划删除时间不晚于该时间的玩家
+//   - limit: 最大数量，0表示不限制
+//   - callback: 回调函数，返回玩家列表（按计划删除时间升序）
+func (dao *PlayerDAO) GetPlayersPendingDeletion(before time.Time, limit int, callback func([]*models.Player, error)) {
+	if dao.connector.GetDriver() == "mongo" {
+		collection := dao.connector.GetMongoDB().Collection(models.Player{}.TableName())
+
+		filter := bson.M{"delete_at": bson.M{"$gt": time.Time{}, "$lte": before}}
+		opts := &options.FindOptions{Sort: bson.M{"delete_at": 1}}
+		if limit > 0 {
+			opts.Limit = func(i int64) *int64 { return &i }(int64(limit))
+		}
+
+		cursor, err := collection.Find(nil, filter, opts)
+
+		if err != nil {
+			if callback != nil {
+				callback(nil, err)
+			}
+			return
+		}
+		defer cursor.Close(nil)
+
+		var players []*models.Player
+		for cursor.Next(nil) {
+			var player models.Player
+			if err := cursor.Decode(&player); err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			players = append(players, &player)
+		}
+
+		if callback != nil {
+			callback(players, nil)
+		}
+	} else {
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE delete_at IS NOT NULL AND delete_at <= ? ORDER BY delete_at ASC", playerColumns, models.Player{}.TableName())
+		if limit > 0 {
+			query = fmt.Sprintf("%s LIMIT %d", query, limit)
+		}
+
+		dao.connector.Query(query, []interface{}{before}, func(rows *sql.Rows, err error) {
+			if err != nil {
+				if callback != nil {
+					callback(nil, err)
+				}
+				return
+			}
+			defer rows.Close()
+
+			var players []*models.Player
+			for rows.Next() {
+				player, err := scanPlayer(rows)
+				if err != nil {
+					if callback != nil {
+						callback(nil, err)
+					}
+					return
+				}
+
+				players = append(players, player)
+			}
+
+			if callback != nil {
+				callback(players, nil)
+			}
+		})
+	}
+}
+
+// scanPlayer 按playerColumns的顺序扫描一行玩家数据
+// delete_at为NULL表示未申请删除
+func scanPlayer(rows *sql.Rows) (*models.Player, error) {
+	var player models.Player
+	var deleteAt sql.NullTime
+	if err := rows.Scan(
+		&player.PlayerID,
+		&player.PlayerName,
+		&player.AccountID,
+		&player.Sex,
+		&player.Age,
+		&player.Level,
+		&player.CreatedAt,
+		&player.UpdatedAt,
+		&player.VipLevel,
+		&deleteAt,
+	); err != nil {
+		return nil, err
+	}
+	if deleteAt.Valid {
+		player.DeleteAt = deleteAt.Time
+	}
+	return &player, nil
+}
+
+// playerDeleteAt 计划删除时间的列值，未申请删除时写入NULL
+func playerDeleteAt(deleteAt time.Time) interface{} {
+	if deleteAt.IsZero() {
+		return nil
+	}
+	return deleteAt
+}
@@ -25,6 +25,7 @@ type DBManager struct {
 	GuildRepository       repository.GuildRepository
 	GuildMemberRepository repository.GuildMemberRepository
 	AuctionRepository     repository.AuctionRepository
+	NameRepository        repository.NameRepository
 	LoginLogRepository    repository.LoginLogRepository
 	MailLogRepository     repository.MailLogRepository
 	QuestLogRepository    repository.QuestLogRepository
@@ -52,6 +53,21 @@ func InitDBManager() error {
 	return err
 }
 
+// InitMemoryDBManager 以内存数据仓库初始化数据库管理器，不连接任何数据库
+// 仅提供账号、玩家、名称占用与登录日志数据仓库，用于流量重放等本地场景
+func InitMemoryDBManager() {
+	dbOnce.Do(func() {
+		dbManager = &DBManager{
+			container:          zInject.NewContainer(),
+			connectors:         make(map[string]connector.DBConnector),
+			AccountRepository:  repository.NewMemoryAccountRepository(),
+			PlayerRepository:   repository.NewMemoryPlayerRepository(),
+			NameRepository:     repository.NewMemoryNameRepository(),
+			LoginLogRepository: repository.NewMemoryLoginLogRepository(),
+		}
+	})
+}
+
 func (manager *DBManager) Init() error {
 	dbConfigs := config.GetAllDBConfigs()
 
@@ -84,6 +100,7 @@ func (manager *DBManager) initRepositories() {
 	manager.GuildRepository = di.ResolveRepo[repository.GuildRepository](manager.container, di.RepoGuild)
 	manager.GuildMemberRepository = di.ResolveRepo[repository.GuildMemberRepository](manager.container, di.RepoGuildMember)
 	manager.AuctionRepository = di.ResolveRepo[repository.AuctionRepository](manager.container, di.RepoAuction)
+	manager.NameRepository = di.ResolveRepo[repository.NameRepository](manager.container, di.RepoName)
 	manager.LoginLogRepository = di.ResolveRepo[repository.LoginLogRepository](manager.container, di.RepoLoginLog)
 	manager.MailLogRepository = di.ResolveRepo[repository.MailLogRepository](manager.container, di.RepoMailLog)
 	manager.QuestLogRepository = di.ResolveRepo[repository.QuestLogRepository](manager.container, di.RepoQuestLog)
@@ -23,6 +23,7 @@ const (
 	DAOGuild       = "dao:guild"
 	DAOGuildMember = "dao:guild_member"
 	DAOAuction     = "dao:auction"
+	DAOName        = "dao:name"
 	DAOLoginLog    = "dao:login_log"
 	DAOMailLog     = "dao:mail_log"
 	DAOQuestLog    = "dao:quest_log"
@@ -39,6 +40,7 @@ const (
 	RepoGuild       = "repo:guild"
 	RepoGuildMember = "repo:guild_member"
 	RepoAuction     = "repo:auction"
+	RepoName        = "repo:name"
 	RepoLoginLog    = "repo:login_log"
 	RepoMailLog     = "repo:mail_log"
 	RepoQuestLog    = "repo:quest_log"
@@ -109,6 +111,11 @@ func RegisterDAOs(container zInject.Container) {
 			conn, _ := container.Resolve(ConnectorGame)
 			return dao.NewAuctionDAO(conn.(connector.DBConnector))
 		})
+
+		container.Register(DAOName, func() interface{} {
+			conn, _ := container.Resolve(ConnectorGame)
+			return dao.NewNameDAO(conn.(connector.DBConnector))
+		})
 	}
 
 	if container.Has(ConnectorLog) {
@@ -223,6 +230,14 @@ func RegisterRepositories(container zInject.Container) {
 		return repository.NewAuctionRepository(d.(*dao.AuctionDAO))
 	})
 
+	container.Register(RepoName, func() interface{} {
+		if !container.Has(DAOName) {
+			return nil
+		}
+		d, _ := container.Resolve(DAOName)
+		return repository.NewNameRepository(d.(*dao.NameDAO))
+	})
+
 	container.Register(RepoLoginLog, func() interface{} {
 		if !container.Has(DAOLoginLog) {
 			return nil
@@ -0,0 +1,8 @@
+-- 玩家表追加VIP等级（登录排队VIP通道）与计划删除时间（角色删除冷却期）
+-- delete_at为NULL表示未申请删除；待清除角色按delete_at查询，需建立索引
+-- 仅适用于MySQL，MongoDB文档缺少的字段按零值读取，无需迁移
+
+ALTER TABLE players
+    ADD COLUMN vip_level INT NOT NULL DEFAULT 0 AFTER updated_at,
+    ADD COLUMN delete_at DATETIME NULL DEFAULT NULL AFTER vip_level,
+    ADD INDEX idx_players_delete_at (delete_at);
@@ -0,0 +1,13 @@
+-- 登录日志表追加账号ID、设备类型、会话时长与补充说明（登录历史）
+-- 客服查询按账号、IP、设备与时间范围过滤并按时间倒序，需建立对应索引
+-- 仅适用于MySQL，MongoDB文档缺少的字段按零值读取，无需迁移
+
+ALTER TABLE login_logs
+    ADD COLUMN account_id BIGINT NOT NULL DEFAULT 0 AFTER created_at,
+    ADD COLUMN device_type INT NOT NULL DEFAULT 0 AFTER account_id,
+    ADD COLUMN duration BIGINT NOT NULL DEFAULT 0 AFTER device_type,
+    ADD COLUMN reason VARCHAR(255) NOT NULL DEFAULT '' AFTER duration,
+    ADD INDEX idx_login_logs_account_id (account_id, created_at),
+    ADD INDEX idx_login_logs_ip (ip, created_at),
+    ADD INDEX idx_login_logs_device (device, created_at),
+    ADD INDEX idx_login_logs_created_at (created_at);
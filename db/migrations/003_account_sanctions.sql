@@ -0,0 +1,19 @@
+-- 账号与角色处罚记录（封禁、禁言等）
+-- expire_at为NULL表示永久，revoked_at为NULL表示未撤销；登录时按账号查询全部处罚，需建立索引
+-- 仅适用于MySQL，MongoDB集合按需创建，无需迁移
+
+CREATE TABLE IF NOT EXISTS account_sanctions (
+    sanction_id   BIGINT       NOT NULL,
+    account_id    BIGINT       NOT NULL,
+    player_id     BIGINT       NOT NULL DEFAULT 0,
+    type          INT          NOT NULL,
+    reason        VARCHAR(255) NOT NULL DEFAULT '',
+    issuer        VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at    DATETIME     NOT NULL,
+    expire_at     DATETIME     NULL DEFAULT NULL,
+    revoked_at    DATETIME     NULL DEFAULT NULL,
+    revoked_by    VARCHAR(64)  NOT NULL DEFAULT '',
+    revoke_reason VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (sanction_id),
+    INDEX idx_account_sanctions_account_id (account_id, created_at)
+);
@@ -0,0 +1,20 @@
+-- 名称占用表：角色名与公会名不区分大小写的全服唯一
+-- 占用依赖 kind + name_key 唯一索引，INSERT IGNORE按影响行数判断名称是否已被占用，缺少索引时同名可被重复占用
+-- MongoDB需在name_registry集合上建立同样的唯一索引：db.name_registry.createIndex({kind: 1, name_key: 1}, {unique: true})
+
+CREATE TABLE IF NOT EXISTS name_registry (
+    kind       INT          NOT NULL,
+    name_key   VARCHAR(64)  NOT NULL,
+    name       VARCHAR(64)  NOT NULL,
+    owner_id   BIGINT       NOT NULL,
+    created_at DATETIME     NOT NULL,
+    UNIQUE KEY uk_name_registry_kind_name_key (kind, name_key)
+) DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+-- 回填已有角色（kind=1）与公会（kind=2）的名称
+-- LOWER只折叠大小写，全角字母数字未折叠的旧名称由命名服务按原名称查询角色表与公会表补充检查
+INSERT IGNORE INTO name_registry (kind, name_key, name, owner_id, created_at)
+SELECT 1, LOWER(player_name), player_name, player_id, NOW() FROM players;
+
+INSERT IGNORE INTO name_registry (kind, name_key, name, owner_id, created_at)
+SELECT 2, LOWER(guild_name), guild_name, guild_id, NOW() FROM guilds;
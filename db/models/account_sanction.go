@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+)
+
+// 账号状态
+const (
+	AccountStatusNormal = 1 // 正常
+	AccountStatusFrozen = 2 // 冻结（运维直接修改数据库，不可登录，不经过处罚记录）
+)
+
+// 处罚类型
+const (
+	SanctionTypeLoginBan  int32 = 1 // 封禁登录
+	SanctionTypeChatMute  int32 = 2 // 禁言（不能发送邮件等玩家间消息）
+	SanctionTypeTradeLock int32 = 3 // 冻结交易（不能上架、竞拍与购买拍卖物品）
+)
+
+// AccountSanction 处罚记录模型，映射account_sanctions表
+// 记录只追加不删除，撤销时填写撤销人、时间与原因，完整保留处罚的操作记录
+type AccountSanction struct {
+	SanctionID   int64     `db:"sanction_id" bson:"sanction_id"`
+	AccountID    int64     `db:"account_id" bson:"account_id"`
+	PlayerID     int64     `db:"player_id" bson:"player_id"` // 角色ID，0表示处罚整个账号
+	Type         int32     `db:"type" bson:"type"`
+	Reason       string    `db:"reason" bson:"reason"`
+	Issuer       string    `db:"issuer" bson:"issuer"`
+	CreatedAt    time.Time `db:"created_at" bson:"created_at"`
+	ExpireAt     time.Time `db:"expire_at" bson:"expire_at"`   // 零值表示永久
+	RevokedAt    time.Time `db:"revoked_at" bson:"revoked_at"` // 零值表示未撤销
+	RevokedBy    string    `db:"revoked_by" bson:"revoked_by"`
+	RevokeReason string    `db:"revoke_reason" bson:"revoke_reason"`
+}
+
+// TableName 返回表名
+func (AccountSanction) TableName() string {
+	return "account_sanctions"
+}
+
+// IsActive 处罚在指定时间是否生效
+func (s *AccountSanction) IsActive(now time.Time) bool {
+	if !s.RevokedAt.IsZero() {
+		return false
+	}
+	return s.ExpireAt.IsZero() || now.Before(s.ExpireAt)
+}
+
+// Covers 处罚是否作用于指定角色（账号级处罚作用于账号下所有角色）
+// 参数:
+//   - playerID: 角色ID，0表示只匹配账号级处罚
+func (s *AccountSanction) Covers(playerID int64) bool {
+	return s.PlayerID == 0 || s.PlayerID == playerID
+}
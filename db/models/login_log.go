@@ -4,6 +4,15 @@ import (
 	"time"
 )
 
+// 登录日志操作类型
+const (
+	LoginOpAccountLogin int32 = 1 // 账号登录
+	LoginOpPlayerLogin  int32 = 2 // 角色登录（含断线重连）
+	LoginOpLogout       int32 = 3 // 角色登出
+	LoginOpDisconnect   int32 = 4 // 连接断开
+	LoginOpKick         int32 = 5 // 被踢下线
+)
+
 type LoginLog struct {
 	LogID      int64     `db:"log_id" bson:"log_id"`
 	PlayerID   int64     `db:"player_id" bson:"player_id"`
@@ -12,8 +21,23 @@ type LoginLog struct {
 	IP         string    `db:"ip" bson:"ip"`
 	Device     string    `db:"device" bson:"device"`
 	CreatedAt  time.Time `db:"created_at" bson:"created_at"`
+	AccountID  int64     `db:"account_id" bson:"account_id"`
+	DeviceType int32     `db:"device_type" bson:"device_type"`
+	Duration   int64     `db:"duration" bson:"duration"` // 登出、断开与踢下线时的会话时长（秒）
+	Reason     string    `db:"reason" bson:"reason"`     // 踢下线原因、重连等补充说明
 }
 
 func (LoginLog) TableName() string {
 	return "`login_logs`"
 }
+
+// LoginLogFilter 登录日志查询条件，零值字段不参与过滤
+type LoginLogFilter struct {
+	AccountID int64     // 账号ID
+	PlayerID  int64     // 角色ID
+	IP        string    // 客户端IP
+	Device    string    // 设备ID
+	Start     time.Time // 起始时间（含）
+	End       time.Time // 结束时间（不含）
+	Limit     int       // 最大条数，0表示不限制
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// 名称类型
+const (
+	NameKindPlayer int32 = 1 // 角色名
+	NameKindGuild  int32 = 2 // 公会名
+	NameKindPet    int32 = 3 // 宠物名
+)
+
+// NameRecord 名称占用记录模型，映射name_registry表
+// 同一类型下name_key唯一（kind + name_key 唯一索引，见db/migrations/004_name_registry.sql），用于不区分大小写的全服名称唯一性检查
+type NameRecord struct {
+	Kind      int32     `db:"kind" bson:"kind"`
+	NameKey   string    `db:"name_key" bson:"name_key"` // 大小写折叠后的名称
+	Name      string    `db:"name" bson:"name"`         // 原始名称
+	OwnerID   int64     `db:"owner_id" bson:"owner_id"` // 角色ID、公会ID等
+	CreatedAt time.Time `db:"created_at" bson:"created_at"`
+}
+
+// TableName 返回表名
+func (NameRecord) TableName() string {
+	return "name_registry"
+}
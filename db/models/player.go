@@ -13,6 +13,8 @@ type Player struct {
 	Level      int       `db:"level" bson:"level"`
 	CreatedAt  time.Time `db:"created_at" bson:"created_at"`
 	UpdatedAt  time.Time `db:"updated_at" bson:"updated_at"`
+	VipLevel   int       `db:"vip_level" bson:"vip_level"`
+	DeleteAt   time.Time `db:"delete_at" bson:"delete_at"` // 计划删除时间，零值表示未申请删除
 }
 
 func (Player) TableName() string {
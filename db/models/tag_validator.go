@@ -49,6 +49,7 @@ func (v *TagValidator) checkStructTags(model interface{}) {
 
 func (v *TagValidator) ValidateAllModels() error {
 	v.checkStructTags(Account{})
+	v.checkStructTags(AccountSanction{})
 	v.checkStructTags(Player{})
 	v.checkStructTags(Auction{})
 	v.checkStructTags(AuctionLog{})
@@ -56,6 +57,7 @@ func (v *TagValidator) ValidateAllModels() error {
 	v.checkStructTags(GuildMember{})
 	v.checkStructTags(LoginLog{})
 	v.checkStructTags(MailLog{})
+	v.checkStructTags(NameRecord{})
 	v.checkStructTags(PlayerBuff{})
 	v.checkStructTags(PlayerItem{})
 	v.checkStructTags(PlayerMail{})
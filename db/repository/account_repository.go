@@ -222,3 +222,61 @@ func (r *AccountRepositoryImpl) UpdateLastLoginAt(accountID int64, lastLoginAt s
 	<-ch
 	return result, resultErr
 }
+
+// GetSanctionsAsync 异步获取账号的全部处罚记录
+// 处罚需即时生效，不经过缓存
+func (r *AccountRepositoryImpl) GetSanctionsAsync(accountID int64, callback func([]*models.AccountSanction, error)) {
+	r.accountDAO.GetSanctionsByAccountID(accountID, callback)
+}
+
+// CreateSanctionAsync 异步创建处罚记录
+func (r *AccountRepositoryImpl) CreateSanctionAsync(sanction *models.AccountSanction, callback func(int64, error)) {
+	r.accountDAO.CreateSanction(sanction, callback)
+}
+
+// RevokeSanctionAsync 异步撤销处罚记录
+func (r *AccountRepositoryImpl) RevokeSanctionAsync(sanction *models.AccountSanction, callback func(bool, error)) {
+	r.accountDAO.RevokeSanction(sanction, callback)
+}
+
+// GetSanctions 获取账号的全部处罚记录（同步兼容方法）
+func (r *AccountRepositoryImpl) GetSanctions(accountID int64) ([]*models.AccountSanction, error) {
+	var result []*models.AccountSanction
+	var resultErr error
+	ch := make(chan struct{})
+	r.GetSanctionsAsync(accountID, func(sanctions []*models.AccountSanction, err error) {
+		result = sanctions
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
+
+// CreateSanction 创建处罚记录（同步兼容方法）
+func (r *AccountRepositoryImpl) CreateSanction(sanction *models.AccountSanction) (int64, error) {
+	var result int64
+	var resultErr error
+	ch := make(chan struct{})
+	r.CreateSanctionAsync(sanction, func(id int64, err error) {
+		result = id
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
+
+// RevokeSanction 撤销处罚记录（同步兼容方法）
+func (r *AccountRepositoryImpl) RevokeSanction(sanction *models.AccountSanction) (bool, error) {
+	var result bool
+	var resultErr error
+	ch := make(chan struct{})
+	r.RevokeSanctionAsync(sanction, func(revoked bool, err error) {
+		result = revoked
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
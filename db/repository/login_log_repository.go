@@ -25,6 +25,10 @@ func (r *LoginLogRepositoryImpl) GetByOpTypeAsync(opType int32, limit int, callb
 	r.logDAO.GetLoginLogsByOpType(opType, limit, callback)
 }
 
+func (r *LoginLogRepositoryImpl) QueryAsync(filter *models.LoginLogFilter, callback func([]*models.LoginLog, error)) {
+	r.logDAO.QueryLoginLogs(filter, callback)
+}
+
 func (r *LoginLogRepositoryImpl) Create(loginLog *models.LoginLog) (int64, error) {
 	var result int64
 	var resultErr error
@@ -63,3 +67,16 @@ func (r *LoginLogRepositoryImpl) GetByOpType(opType int32, limit int) ([]*models
 	<-ch
 	return result, resultErr
 }
+
+func (r *LoginLogRepositoryImpl) Query(filter *models.LoginLogFilter) ([]*models.LoginLog, error) {
+	var result []*models.LoginLog
+	var resultErr error
+	ch := make(chan struct{})
+	r.QueryAsync(filter, func(logs []*models.LoginLog, err error) {
+		result = logs
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
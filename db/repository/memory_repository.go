@@ -0,0 +1,534 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zGameServer/db/models"
+)
+
+// MemoryAccountRepository 内存账号数据仓库
+// 数据仅保存在进程内，用于重放与测试等不连接数据库的场景
+type MemoryAccountRepository struct {
+	mu        sync.RWMutex
+	accounts  map[int64]*models.Account
+	sanctions map[int64][]*models.AccountSanction // 账号ID -> 处罚记录（按创建顺序）
+}
+
+// NewMemoryAccountRepository 创建内存账号数据仓库
+func NewMemoryAccountRepository() *MemoryAccountRepository {
+	return &MemoryAccountRepository{
+		accounts:  make(map[int64]*models.Account),
+		sanctions: make(map[int64][]*models.AccountSanction),
+	}
+}
+
+// GetByIDAsync 根据ID异步获取账号
+func (r *MemoryAccountRepository) GetByIDAsync(accountID int64, callback func(*models.Account, error)) {
+	account, err := r.GetByID(accountID)
+	if callback != nil {
+		callback(account, err)
+	}
+}
+
+// GetByNameAsync 根据名称异步获取账号
+func (r *MemoryAccountRepository) GetByNameAsync(accountName string, callback func(*models.Account, error)) {
+	account, err := r.GetByName(accountName)
+	if callback != nil {
+		callback(account, err)
+	}
+}
+
+// CreateAsync 异步创建账号
+func (r *MemoryAccountRepository) CreateAsync(account *models.Account, callback func(int64, error)) {
+	id, err := r.Create(account)
+	if callback != nil {
+		callback(id, err)
+	}
+}
+
+// UpdateAsync 异步更新账号
+func (r *MemoryAccountRepository) UpdateAsync(account *models.Account, callback func(bool, error)) {
+	ok, err := r.Update(account)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// DeleteAsync 异步删除账号
+func (r *MemoryAccountRepository) DeleteAsync(accountID int64, callback func(bool, error)) {
+	ok, err := r.Delete(accountID)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// UpdateLastLoginAtAsync 异步更新最后登录时间
+func (r *MemoryAccountRepository) UpdateLastLoginAtAsync(accountID int64, lastLoginAt string, callback func(bool, error)) {
+	ok, err := r.UpdateLastLoginAt(accountID, lastLoginAt)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// GetByID 根据ID获取账号（不存在时返回nil）
+func (r *MemoryAccountRepository) GetByID(accountID int64) (*models.Account, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if account, ok := r.accounts[accountID]; ok {
+		copied := *account
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// GetByName 根据名称获取账号（不存在时返回nil）
+func (r *MemoryAccountRepository) GetByName(accountName string) (*models.Account, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, account := range r.accounts {
+		if account.AccountName == accountName {
+			copied := *account
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create 创建账号
+func (r *MemoryAccountRepository) Create(account *models.Account) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *account
+	r.accounts[account.AccountID] = &copied
+	return account.AccountID, nil
+}
+
+// Update 更新账号
+func (r *MemoryAccountRepository) Update(account *models.Account) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.accounts[account.AccountID]; !ok {
+		return false, nil
+	}
+	copied := *account
+	r.accounts[account.AccountID] = &copied
+	return true, nil
+}
+
+// Delete 删除账号
+func (r *MemoryAccountRepository) Delete(accountID int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.accounts[accountID]; !ok {
+		return false, nil
+	}
+	delete(r.accounts, accountID)
+	return true, nil
+}
+
+// UpdateLastLoginAt 更新最后登录时间
+func (r *MemoryAccountRepository) UpdateLastLoginAt(accountID int64, lastLoginAt string) (bool, error) {
+	loginAt, err := time.ParseInLocation("2006-01-02 15:04:05", lastLoginAt, time.Local)
+	if err != nil {
+		return false, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	account, ok := r.accounts[accountID]
+	if !ok {
+		return false, nil
+	}
+	account.LastLoginAt = loginAt
+	return true, nil
+}
+
+// GetSanctionsAsync 异步获取账号的全部处罚记录
+func (r *MemoryAccountRepository) GetSanctionsAsync(accountID int64, callback func([]*models.AccountSanction, error)) {
+	sanctions, err := r.GetSanctions(accountID)
+	if callback != nil {
+		callback(sanctions, err)
+	}
+}
+
+// CreateSanctionAsync 异步创建处罚记录
+func (r *MemoryAccountRepository) CreateSanctionAsync(sanction *models.AccountSanction, callback func(int64, error)) {
+	id, err := r.CreateSanction(sanction)
+	if callback != nil {
+		callback(id, err)
+	}
+}
+
+// RevokeSanctionAsync 异步撤销处罚记录
+func (r *MemoryAccountRepository) RevokeSanctionAsync(sanction *models.AccountSanction, callback func(bool, error)) {
+	ok, err := r.RevokeSanction(sanction)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// GetSanctions 获取账号的全部处罚记录（按创建顺序）
+func (r *MemoryAccountRepository) GetSanctions(accountID int64) ([]*models.AccountSanction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sanctions := make([]*models.AccountSanction, 0, len(r.sanctions[accountID]))
+	for _, sanction := range r.sanctions[accountID] {
+		copied := *sanction
+		sanctions = append(sanctions, &copied)
+	}
+	return sanctions, nil
+}
+
+// CreateSanction 创建处罚记录
+func (r *MemoryAccountRepository) CreateSanction(sanction *models.AccountSanction) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *sanction
+	r.sanctions[sanction.AccountID] = append(r.sanctions[sanction.AccountID], &copied)
+	return sanction.SanctionID, nil
+}
+
+// RevokeSanction 撤销处罚记录
+func (r *MemoryAccountRepository) RevokeSanction(sanction *models.AccountSanction) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.sanctions[sanction.AccountID] {
+		if stored.SanctionID == sanction.SanctionID {
+			stored.RevokedAt = sanction.RevokedAt
+			stored.RevokedBy = sanction.RevokedBy
+			stored.RevokeReason = sanction.RevokeReason
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MemoryPlayerRepository 内存玩家数据仓库
+type MemoryPlayerRepository struct {
+	mu      sync.RWMutex
+	players map[int64]*models.Player
+}
+
+// NewMemoryPlayerRepository 创建内存玩家数据仓库
+func NewMemoryPlayerRepository() *MemoryPlayerRepository {
+	return &MemoryPlayerRepository{
+		players: make(map[int64]*models.Player),
+	}
+}
+
+// GetByIDAsync 根据ID异步获取玩家
+func (r *MemoryPlayerRepository) GetByIDAsync(playerID int64, callback func(*models.Player, error)) {
+	player, err := r.GetByID(playerID)
+	if callback != nil {
+		callback(player, err)
+	}
+}
+
+// GetByAccountIDAsync 根据账号ID异步获取玩家列表
+func (r *MemoryPlayerRepository) GetByAccountIDAsync(accountID int64, callback func([]*models.Player, error)) {
+	players, err := r.GetByAccountID(accountID)
+	if callback != nil {
+		callback(players, err)
+	}
+}
+
+// GetByNameAsync 根据名称异步获取玩家
+func (r *MemoryPlayerRepository) GetByNameAsync(name string, callback func(*models.Player, error)) {
+	player, err := r.GetByName(name)
+	if callback != nil {
+		callback(player, err)
+	}
+}
+
+// CreateAsync 异步创建玩家
+func (r *MemoryPlayerRepository) CreateAsync(player *models.Player, callback func(int64, error)) {
+	id, err := r.Create(player)
+	if callback != nil {
+		callback(id, err)
+	}
+}
+
+// UpdateAsync 异步更新玩家
+func (r *MemoryPlayerRepository) UpdateAsync(player *models.Player, callback func(bool, error)) {
+	ok, err := r.Update(player)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// DeleteAsync 异步删除玩家
+func (r *MemoryPlayerRepository) DeleteAsync(playerID int64, callback func(bool, error)) {
+	ok, err := r.Delete(playerID)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// GetPendingDeletionAsync 异步获取删除冷却期已满的玩家
+func (r *MemoryPlayerRepository) GetPendingDeletionAsync(before time.Time, limit int, callback func([]*models.Player, error)) {
+	players, err := r.GetPendingDeletion(before, limit)
+	if callback != nil {
+		callback(players, err)
+	}
+}
+
+// GetByID 根据ID获取玩家（不存在时返回nil）
+func (r *MemoryPlayerRepository) GetByID(playerID int64) (*models.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if player, ok := r.players[playerID]; ok {
+		copied := *player
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// GetByAccountID 根据账号ID获取玩家列表（按玩家ID升序）
+func (r *MemoryPlayerRepository) GetByAccountID(accountID int64) ([]*models.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var players []*models.Player
+	for _, player := range r.players {
+		if player.AccountID == accountID {
+			copied := *player
+			players = append(players, &copied)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].PlayerID < players[j].PlayerID })
+	return players, nil
+}
+
+// GetByName 根据名称获取玩家（名称完全匹配，不存在时返回nil）
+func (r *MemoryPlayerRepository) GetByName(name string) (*models.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, player := range r.players {
+		if player.PlayerName == name {
+			copied := *player
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create 创建玩家
+func (r *MemoryPlayerRepository) Create(player *models.Player) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *player
+	r.players[player.PlayerID] = &copied
+	return player.PlayerID, nil
+}
+
+// Update 更新玩家
+func (r *MemoryPlayerRepository) Update(player *models.Player) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.players[player.PlayerID]; !ok {
+		return false, nil
+	}
+	copied := *player
+	r.players[player.PlayerID] = &copied
+	return true, nil
+}
+
+// Delete 删除玩家
+func (r *MemoryPlayerRepository) Delete(playerID int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.players[playerID]; !ok {
+		return false, nil
+	}
+	delete(r.players, playerID)
+	return true, nil
+}
+
+// GetPendingDeletion 获取删除冷却期已满的玩家（按计划删除时间升序）
+func (r *MemoryPlayerRepository) GetPendingDeletion(before time.Time, limit int) ([]*models.Player, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var players []*models.Player
+	for _, player := range r.players {
+		if !player.DeleteAt.IsZero() && !player.DeleteAt.After(before) {
+			copied := *player
+			players = append(players, &copied)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].DeleteAt.Before(players[j].DeleteAt) })
+	if limit > 0 && len(players) > limit {
+		players = players[:limit]
+	}
+	return players, nil
+}
+
+// MemoryNameRepository 内存名称占用数据仓库
+type MemoryNameRepository struct {
+	mu      sync.Mutex
+	records map[int32]map[string]*models.NameRecord // 名称类型 -> 折叠后的名称 -> 占用记录
+}
+
+// NewMemoryNameRepository 创建内存名称占用数据仓库
+func NewMemoryNameRepository() *MemoryNameRepository {
+	return &MemoryNameRepository{
+		records: make(map[int32]map[string]*models.NameRecord),
+	}
+}
+
+// GetAsync 异步获取名称占用记录
+func (r *MemoryNameRepository) GetAsync(kind int32, nameKey string, callback func(*models.NameRecord, error)) {
+	record, err := r.Get(kind, nameKey)
+	if callback != nil {
+		callback(record, err)
+	}
+}
+
+// ReserveAsync 异步占用名称
+func (r *MemoryNameRepository) ReserveAsync(record *models.NameRecord, callback func(bool, error)) {
+	ok, err := r.Reserve(record)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// ReleaseAsync 异步释放名称
+func (r *MemoryNameRepository) ReleaseAsync(kind int32, nameKey string, ownerID int64, callback func(bool, error)) {
+	ok, err := r.Release(kind, nameKey, ownerID)
+	if callback != nil {
+		callback(ok, err)
+	}
+}
+
+// Get 获取名称占用记录（不存在时返回nil）
+func (r *MemoryNameRepository) Get(kind int32, nameKey string) (*models.NameRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if record, ok := r.records[kind][nameKey]; ok {
+		copied := *record
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+// Reserve 占用名称，名称已被占用时返回false
+func (r *MemoryNameRepository) Reserve(record *models.NameRecord) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names, ok := r.records[record.Kind]
+	if !ok {
+		names = make(map[string]*models.NameRecord)
+		r.records[record.Kind] = names
+	}
+	if _, exists := names[record.NameKey]; exists {
+		return false, nil
+	}
+	copied := *record
+	names[record.NameKey] = &copied
+	return true, nil
+}
+
+// Release 释放名称（只释放属于指定所有者的记录）
+func (r *MemoryNameRepository) Release(kind int32, nameKey string, ownerID int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if record, ok := r.records[kind][nameKey]; ok && record.OwnerID == ownerID {
+		delete(r.records[kind], nameKey)
+		return true, nil
+	}
+	return false, nil
+}
+
+// MemoryLoginLogRepository 内存登录日志数据仓库
+type MemoryLoginLogRepository struct {
+	mu     sync.RWMutex
+	logs   []*models.LoginLog
+	nextID int64
+}
+
+// NewMemoryLoginLogRepository 创建内存登录日志数据仓库
+func NewMemoryLoginLogRepository() *MemoryLoginLogRepository {
+	return &MemoryLoginLogRepository{}
+}
+
+// CreateAsync 异步写入登录日志
+func (r *MemoryLoginLogRepository) CreateAsync(loginLog *models.LoginLog, callback func(int64, error)) {
+	id, err := r.Create(loginLog)
+	if callback != nil {
+		callback(id, err)
+	}
+}
+
+// GetByPlayerIDAsync 异步获取角色的登录日志
+func (r *MemoryLoginLogRepository) GetByPlayerIDAsync(playerID int64, limit int, callback func([]*models.LoginLog, error)) {
+	logs, err := r.GetByPlayerID(playerID, limit)
+	if callback != nil {
+		callback(logs, err)
+	}
+}
+
+// GetByOpTypeAsync 异步按操作类型获取登录日志
+func (r *MemoryLoginLogRepository) GetByOpTypeAsync(opType int32, limit int, callback func([]*models.LoginLog, error)) {
+	logs, err := r.GetByOpType(opType, limit)
+	if callback != nil {
+		callback(logs, err)
+	}
+}
+
+// QueryAsync 异步按条件查询登录日志
+func (r *MemoryLoginLogRepository) QueryAsync(filter *models.LoginLogFilter, callback func([]*models.LoginLog, error)) {
+	logs, err := r.Query(filter)
+	if callback != nil {
+		callback(logs, err)
+	}
+}
+
+// Create 写入登录日志（日志ID按写入顺序递增）
+func (r *MemoryLoginLogRepository) Create(loginLog *models.LoginLog) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	loginLog.LogID = r.nextID
+	copied := *loginLog
+	r.logs = append(r.logs, &copied)
+	return loginLog.LogID, nil
+}
+
+// GetByPlayerID 获取角色的登录日志（按时间倒序）
+func (r *MemoryLoginLogRepository) GetByPlayerID(playerID int64, limit int) ([]*models.LoginLog, error) {
+	return r.find(func(log *models.LoginLog) bool { return log.PlayerID == playerID }, limit), nil
+}
+
+// GetByOpType 按操作类型获取登录日志（按时间倒序）
+func (r *MemoryLoginLogRepository) GetByOpType(opType int32, limit int) ([]*models.LoginLog, error) {
+	return r.find(func(log *models.LoginLog) bool { return log.OpType == opType }, limit), nil
+}
+
+// Query 按条件查询登录日志（按时间倒序）
+func (r *MemoryLoginLogRepository) Query(filter *models.LoginLogFilter) ([]*models.LoginLog, error) {
+	return r.find(func(log *models.LoginLog) bool {
+		return (filter.AccountID <= 0 || log.AccountID == filter.AccountID) &&
+			(filter.PlayerID <= 0 || log.PlayerID == filter.PlayerID) &&
+			(filter.IP == "" || log.IP == filter.IP) &&
+			(filter.Device == "" || log.Device == filter.Device) &&
+			(filter.Start.IsZero() || !log.CreatedAt.Before(filter.Start)) &&
+			(filter.End.IsZero() || log.CreatedAt.Before(filter.End))
+	}, filter.Limit), nil
+}
+
+// find 按时间倒序返回匹配的登录日志（时间相同时后写入的在前）
+func (r *MemoryLoginLogRepository) find(match func(*models.LoginLog) bool, limit int) []*models.LoginLog {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var logs []*models.LoginLog
+	for i := len(r.logs) - 1; i >= 0; i-- {
+		if match(r.logs[i]) {
+			copied := *r.logs[i]
+			logs = append(logs, &copied)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].CreatedAt.After(logs[j].CreatedAt) })
+	if limit > 0 && len(logs) > limit {
+		logs = logs[:limit]
+	}
+	return logs
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"github.com/pzqf/zGameServer/db/dao"
+	"github.com/pzqf/zGameServer/db/models"
+)
+
+// NameRepositoryImpl 名称占用数据仓库实现
+// 名称检查需要以数据库为准，不做缓存
+type NameRepositoryImpl struct {
+	nameDAO *dao.NameDAO
+}
+
+// NewNameRepository 创建名称占用数据仓库
+func NewNameRepository(nameDAO *dao.NameDAO) *NameRepositoryImpl {
+	return &NameRepositoryImpl{nameDAO: nameDAO}
+}
+
+// GetAsync 异步获取名称占用记录
+func (r *NameRepositoryImpl) GetAsync(kind int32, nameKey string, callback func(*models.NameRecord, error)) {
+	r.nameDAO.GetName(kind, nameKey, callback)
+}
+
+// ReserveAsync 异步占用名称
+func (r *NameRepositoryImpl) ReserveAsync(record *models.NameRecord, callback func(bool, error)) {
+	r.nameDAO.CreateName(record, callback)
+}
+
+// ReleaseAsync 异步释放名称
+func (r *NameRepositoryImpl) ReleaseAsync(kind int32, nameKey string, ownerID int64, callback func(bool, error)) {
+	r.nameDAO.DeleteName(kind, nameKey, ownerID, callback)
+}
+
+// Get 获取名称占用记录
+func (r *NameRepositoryImpl) Get(kind int32, nameKey string) (*models.NameRecord, error) {
+	var result *models.NameRecord
+	var resultErr error
+	ch := make(chan struct{})
+	r.GetAsync(kind, nameKey, func(record *models.NameRecord, err error) {
+		result = record
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
+
+// Reserve 占用名称
+func (r *NameRepositoryImpl) Reserve(record *models.NameRecord) (bool, error) {
+	var result bool
+	var resultErr error
+	ch := make(chan struct{})
+	r.ReserveAsync(record, func(ok bool, err error) {
+		result = ok
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
+
+// Release 释放名称
+func (r *NameRepositoryImpl) Release(kind int32, nameKey string, ownerID int64) (bool, error) {
+	var result bool
+	var resultErr error
+	ch := make(chan struct{})
+	r.ReleaseAsync(kind, nameKey, ownerID, func(ok bool, err error) {
+		result = ok
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
@@ -67,6 +67,18 @@ func (r *PlayerRepositoryImpl) GetByAccountIDAsync(accountID int64, callback fun
 	})
 }
 
+// GetByNameAsync 异步按名称获取玩家（名称完全匹配）
+// 参数:
+//   - name: 玩家名称
+//   - callback: 回调函数
+func (r *PlayerRepositoryImpl) GetByNameAsync(name string, callback func(*models.Player, error)) {
+	r.playerDAO.GetPlayerByName(name, func(p *models.Player, err error) {
+		if callback != nil {
+			callback(p, err)
+		}
+	})
+}
+
 // CreateAsync 异步创建玩家
 // 创建成功后更新缓存
 // 参数:
@@ -121,6 +133,19 @@ func (r *PlayerRepositoryImpl) DeleteAsync(playerID int64, callback func(bool, e
 	})
 }
 
+// GetPendingDeletionAsync 异步获取删除冷却期已满、待清除的玩家
+// 参数:
+//   - before: 截止时间
+//   - limit: 最大数量，0表示不限制
+//   - callback: 回调函数
+func (r *PlayerRepositoryImpl) GetPendingDeletionAsync(before time.Time, limit int, callback func([]*models.Player, error)) {
+	r.playerDAO.GetPlayersPendingDeletion(before, limit, func(players []*models.Player, err error) {
+		if callback != nil {
+			callback(players, err)
+		}
+	})
+}
+
 // GetByID 同步获取玩家
 // 参数:
 //   - playerID: 玩家ID
@@ -157,6 +182,24 @@ func (r *PlayerRepositoryImpl) GetByAccountID(accountID int64) ([]*models.Player
 	return result, resultErr
 }
 
+// GetByName 同步按名称获取玩家
+// 参数:
+//   - name: 玩家名称
+//
+// 返回: 玩家数据（不存在时为nil）和错误
+func (r *PlayerRepositoryImpl) GetByName(name string) (*models.Player, error) {
+	var result *models.Player
+	var resultErr error
+	ch := make(chan struct{})
+	r.GetByNameAsync(name, func(p *models.Player, err error) {
+		result = p
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
+
 // Create 同步创建玩家
 // 参数:
 //   - player: 玩家数据
@@ -210,3 +253,22 @@ func (r *PlayerRepositoryImpl) Delete(playerID int64) (bool, error) {
 	<-ch
 	return result, resultErr
 }
+
+// GetPendingDeletion 同步获取删除冷却期已满、待清除的玩家
+// 参数:
+//   - before: 截止时间
+//   - limit: 最大数量，0表示不限制
+//
+// 返回: 玩家列表（按计划删除时间升序）和错误
+func (r *PlayerRepositoryImpl) GetPendingDeletion(before time.Time, limit int) ([]*models.Player, error) {
+	var result []*models.Player
+	var resultErr error
+	ch := make(chan struct{})
+	r.GetPendingDeletionAsync(before, limit, func(players []*models.Player, err error) {
+		result = players
+		resultErr = err
+		close(ch)
+	})
+	<-ch
+	return result, resultErr
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/pzqf/zGameServer/db/models"
 )
 
@@ -18,6 +20,12 @@ type AccountRepository interface {
 	DeleteAsync(accountID int64, callback func(bool, error))
 	// UpdateLastLoginAtAsync 异步更新最后登录时间
 	UpdateLastLoginAtAsync(accountID int64, lastLoginAt string, callback func(bool, error))
+	// GetSanctionsAsync 异步获取账号的全部处罚记录
+	GetSanctionsAsync(accountID int64, callback func([]*models.AccountSanction, error))
+	// CreateSanctionAsync 异步创建处罚记录
+	CreateSanctionAsync(sanction *models.AccountSanction, callback func(int64, error))
+	// RevokeSanctionAsync 异步撤销处罚记录
+	RevokeSanctionAsync(sanction *models.AccountSanction, callback func(bool, error))
 
 	// GetByID 根据ID获取账号
 	GetByID(accountID int64) (*models.Account, error)
@@ -31,20 +39,49 @@ type AccountRepository interface {
 	Delete(accountID int64) (bool, error)
 	// UpdateLastLoginAt 更新最后登录时间
 	UpdateLastLoginAt(accountID int64, lastLoginAt string) (bool, error)
+	// GetSanctions 获取账号的全部处罚记录（含已撤销与已到期，按创建时间升序）
+	GetSanctions(accountID int64) ([]*models.AccountSanction, error)
+	// CreateSanction 创建处罚记录
+	CreateSanction(sanction *models.AccountSanction) (int64, error)
+	// RevokeSanction 撤销处罚记录（只更新撤销人、时间与原因）
+	RevokeSanction(sanction *models.AccountSanction) (bool, error)
 }
 
 type PlayerRepository interface {
 	GetByIDAsync(playerID int64, callback func(*models.Player, error))
 	GetByAccountIDAsync(accountID int64, callback func([]*models.Player, error))
+	GetByNameAsync(name string, callback func(*models.Player, error))
 	CreateAsync(player *models.Player, callback func(int64, error))
 	UpdateAsync(player *models.Player, callback func(bool, error))
 	DeleteAsync(playerID int64, callback func(bool, error))
+	// GetPendingDeletionAsync 异步获取计划删除时间不晚于before的玩家
+	GetPendingDeletionAsync(before time.Time, limit int, callback func([]*models.Player, error))
 
 	GetByID(playerID int64) (*models.Player, error)
 	GetByAccountID(accountID int64) ([]*models.Player, error)
+	GetByName(name string) (*models.Player, error)
 	Create(player *models.Player) (int64, error)
 	Update(player *models.Player) (bool, error)
 	Delete(playerID int64) (bool, error)
+	// GetPendingDeletion 获取计划删除时间不晚于before的玩家（按计划删除时间升序，limit为0表示不限制）
+	GetPendingDeletion(before time.Time, limit int) ([]*models.Player, error)
+}
+
+// NameRepository 名称占用数据仓库接口
+type NameRepository interface {
+	// GetAsync 异步获取名称占用记录
+	GetAsync(kind int32, nameKey string, callback func(*models.NameRecord, error))
+	// ReserveAsync 异步占用名称，名称已被占用时返回false
+	ReserveAsync(record *models.NameRecord, callback func(bool, error))
+	// ReleaseAsync 异步释放名称（只释放属于指定所有者的记录）
+	ReleaseAsync(kind int32, nameKey string, ownerID int64, callback func(bool, error))
+
+	// Get 获取名称占用记录（不存在时返回nil）
+	Get(kind int32, nameKey string) (*models.NameRecord, error)
+	// Reserve 占用名称，名称已被占用时返回false
+	Reserve(record *models.NameRecord) (bool, error)
+	// Release 释放名称（只释放属于指定所有者的记录）
+	Release(kind int32, nameKey string, ownerID int64) (bool, error)
 }
 
 type PlayerItemRepository interface {
@@ -163,10 +200,14 @@ type LoginLogRepository interface {
 	CreateAsync(loginLog *models.LoginLog, callback func(int64, error))
 	GetByPlayerIDAsync(playerID int64, limit int, callback func([]*models.LoginLog, error))
 	GetByOpTypeAsync(opType int32, limit int, callback func([]*models.LoginLog, error))
+	// QueryAsync 异步按条件查询登录日志（按时间倒序）
+	QueryAsync(filter *models.LoginLogFilter, callback func([]*models.LoginLog, error))
 
 	Create(loginLog *models.LoginLog) (int64, error)
 	GetByPlayerID(playerID int64, limit int) ([]*models.LoginLog, error)
 	GetByOpType(opType int32, limit int) ([]*models.LoginLog, error)
+	// Query 按条件查询登录日志（按时间倒序）
+	Query(filter *models.LoginLogFilter) ([]*models.LoginLog, error)
 }
 
 type MailLogRepository interface {
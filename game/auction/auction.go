@@ -1,9 +1,16 @@
 package auction
 
 import (
+	"errors"
+
 	"github.com/pzqf/zUtil/zMap"
 )
 
+// 拍卖行错误
+var (
+	ErrTradeLocked = errors.New("trade locked") // 玩家交易已被冻结
+)
+
 // 拍卖类型定义
 const (
 	AuctionTypeBid  = 1 // 竞拍（仅竞价）
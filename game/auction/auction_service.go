@@ -6,6 +6,8 @@ import (
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zService"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/sanction"
 	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
 )
@@ -160,6 +162,10 @@ func (as *AuctionService) removeFromActiveItems(auctionId common.AuctionIdType)
 // 返回:
 //   - error: 创建错误
 func (as *AuctionService) CreateAuction(item *AuctionItem) error {
+	if isTradeLocked(common.PlayerIdType(item.SellerId)) {
+		return ErrTradeLocked
+	}
+
 	// 检查拍卖ID是否已存在
 	if _, exists := as.items.Load(common.AuctionIdType(item.AuctionId)); exists {
 		return nil
@@ -191,6 +197,10 @@ func (as *AuctionService) CreateAuction(item *AuctionItem) error {
 // 返回:
 //   - error: 竞拍错误
 func (as *AuctionService) PlaceBid(playerId common.PlayerIdType, playerName string, auctionId common.AuctionIdType, bidPrice int64) error {
+	if isTradeLocked(playerId) {
+		return ErrTradeLocked
+	}
+
 	item, exists := as.items.Load(auctionId)
 	if !exists {
 		return nil
@@ -234,6 +244,10 @@ func (as *AuctionService) PlaceBid(playerId common.PlayerIdType, playerName stri
 // 返回:
 //   - error: 购买错误
 func (as *AuctionService) BuyoutItem(playerId common.PlayerIdType, playerName string, auctionId common.AuctionIdType) error {
+	if isTradeLocked(playerId) {
+		return ErrTradeLocked
+	}
+
 	item, exists := as.items.Load(auctionId)
 	if !exists {
 		return nil
@@ -345,6 +359,37 @@ func (as *AuctionService) GetPlayerAuctions(playerId common.PlayerIdType) ([]*Au
 	return items, true
 }
 
+// HasActiveAuctions 检查玩家是否有未结束的拍卖
+// 包括玩家上架的待开始或进行中的拍卖，以及玩家当前领先的进行中拍卖
+// 参数:
+//   - playerId: 玩家ID
+//
+// 返回: 有未结束的拍卖时返回true
+func (as *AuctionService) HasActiveAuctions(playerId common.PlayerIdType) bool {
+	if items, exists := as.GetPlayerAuctions(playerId); exists {
+		for _, item := range items {
+			if item.Status == AuctionStatusPending || item.Status == AuctionStatusActive {
+				return true
+			}
+		}
+	}
+
+	leading := false
+	as.items.Range(func(auctionId common.AuctionIdType, item *AuctionItem) bool {
+		if item.Status == AuctionStatusActive && item.CurrentWinner == int64(playerId) {
+			leading = true
+			return false
+		}
+		return true
+	})
+	return leading
+}
+
+// isTradeLocked 玩家交易是否已被冻结（账号或角色的交易冻结处罚生效中）
+func isTradeLocked(playerId common.PlayerIdType) bool {
+	return sanction.GetManager().FindByPlayer(int64(playerId), models.SanctionTypeTradeLock, time.Now()) != nil
+}
+
 // isValidBid 检查竞拍价格是否合法
 // 参数:
 //   - item: 拍卖物品
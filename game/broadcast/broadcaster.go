@@ -0,0 +1,135 @@
+package broadcast
+
+import (
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	gamecommon "github.com/pzqf/zGameServer/game/common"
+	"github.com/pzqf/zGameServer/game/guild"
+	"github.com/pzqf/zGameServer/game/maps"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// Broadcaster 消息广播
+// 按目标范围（全服、地图、公会、区域）解析接收玩家，将同一份已序列化的消息体经路由器按消息声明的投递方式
+// （如地图同步经UDP不可靠有序发送）分发给每个玩家的会话；
+// 断线保留中的玩家没有会话，直接跳过；广播不写入玩家的补发缓冲区，不计入重连序号，重连后不补发
+type Broadcaster struct {
+	packetRouter  *router.PacketRouter
+	playerService *player.PlayerService
+	mapService    *maps.MapService
+	guildService  *guild.GuildService
+}
+
+// NewBroadcaster 创建消息广播
+// 参数:
+//   - packetRouter: 数据包路由器（提供序列化协议与消息投递方式）
+//   - playerService: 玩家服务（解析在线玩家及其会话）
+//   - mapService: 地图服务（解析地图与区域内的玩家）
+//   - guildService: 公会服务（解析公会成员）
+//
+// 返回:
+//   - *Broadcaster: 新创建的消息广播
+func NewBroadcaster(packetRouter *router.PacketRouter, playerService *player.PlayerService,
+	mapService *maps.MapService, guildService *guild.GuildService) *Broadcaster {
+	return &Broadcaster{
+		packetRouter:  packetRouter,
+		playerService: playerService,
+		mapService:    mapService,
+		guildService:  guildService,
+	}
+}
+
+// Encode 将消息序列化为消息体，广播前只需调用一次
+func (b *Broadcaster) Encode(v interface{}) ([]byte, error) {
+	return b.packetRouter.GetProtocol().Marshal(v)
+}
+
+// ToAll 发送给所有在线玩家
+// 返回: 成功发送的玩家数
+func (b *Broadcaster) ToAll(protoId int32, data []byte) int {
+	sent := 0
+	b.playerService.RangePlayers(func(p *player.Player) bool {
+		if b.send(p, protoId, data) {
+			sent++
+		}
+		return true
+	})
+	return sent
+}
+
+// ToPlayers 发送给指定玩家，不在线的玩家跳过
+// 返回: 成功发送的玩家数
+func (b *Broadcaster) ToPlayers(playerIds []common.PlayerIdType, protoId int32, data []byte) int {
+	sent := 0
+	for _, playerId := range playerIds {
+		if b.send(b.playerService.GetPlayer(playerId), protoId, data) {
+			sent++
+		}
+	}
+	return sent
+}
+
+// ToMap 发送给地图内的所有玩家
+// 返回: 成功发送的玩家数
+func (b *Broadcaster) ToMap(mapId common.MapIdType, protoId int32, data []byte) int {
+	mapObj, exists := b.mapService.GetMap(mapId)
+	if !exists {
+		return 0
+	}
+	return b.ToPlayers(mapObj.GetPlayerIds(), protoId, data)
+}
+
+// ToArea 发送给地图内指定范围的玩家
+// 参数:
+//   - mapId: 地图ID
+//   - center: 中心坐标
+//   - radius: 半径
+//   - protoId: 消息ID
+//   - data: 消息体
+//
+// 返回: 成功发送的玩家数
+func (b *Broadcaster) ToArea(mapId common.MapIdType, center gamecommon.Vector3, radius float32, protoId int32, data []byte) int {
+	mapObj, exists := b.mapService.GetMap(mapId)
+	if !exists {
+		return 0
+	}
+	return b.ToPlayers(mapObj.GetPlayersInRange(center, radius), protoId, data)
+}
+
+// ToGuild 发送给公会的所有在线成员
+// 返回: 成功发送的玩家数
+func (b *Broadcaster) ToGuild(guildId common.GuildIdType, protoId int32, data []byte) int {
+	g, exists := b.guildService.GetGuild(guildId)
+	if !exists {
+		return 0
+	}
+
+	playerIds := make([]common.PlayerIdType, 0, g.MemberCount)
+	g.Members.Range(func(playerId common.PlayerIdType, member *guild.GuildMember) bool {
+		playerIds = append(playerIds, playerId)
+		return true
+	})
+	return b.ToPlayers(playerIds, protoId, data)
+}
+
+// send 按消息的投递方式发送给单个玩家
+// 返回: 是否发送成功
+func (b *Broadcaster) send(p *player.Player, protoId int32, data []byte) bool {
+	if p == nil {
+		return false
+	}
+	session := p.GetSession()
+	if session == nil {
+		return false
+	}
+	if err := b.packetRouter.Deliver(session, protoId, data); err != nil {
+		zLog.Debug("Broadcast send failed",
+			zap.Int64("playerId", int64(p.GetPlayerId())),
+			zap.Int32("cmd", protoId),
+			zap.Error(err))
+		return false
+	}
+	return true
+}
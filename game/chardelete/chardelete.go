@@ -0,0 +1,349 @@
+package chardelete
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zService"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/auction"
+	"github.com/pzqf/zGameServer/game/guild"
+	"github.com/pzqf/zGameServer/game/naming"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPlayerNotFound  = errors.New("chardelete: player not found")
+	ErrAlreadyPending  = errors.New("chardelete: player is already pending deletion")
+	ErrNotPending      = errors.New("chardelete: player is not pending deletion")
+	ErrRestoreExpired  = errors.New("chardelete: restore window has expired")
+	ErrGuildLeader     = errors.New("chardelete: player is a guild leader")
+	ErrActiveAuction   = errors.New("chardelete: player has active auctions")
+	ErrRepositoryUnset = errors.New("chardelete: player repository not initialized")
+)
+
+// ErrorCode 将角色删除错误转换为客户端错误码
+func ErrorCode(err error) protocol.ErrorCode {
+	switch err {
+	case nil:
+		return protocol.ErrorCode_ERR_OK
+	case ErrPlayerNotFound:
+		return protocol.ErrorCode_ERR_PLAYER_NOT_FOUND
+	case ErrAlreadyPending:
+		return protocol.ErrorCode_ERR_PLAYER_PENDING_DELETE
+	case ErrNotPending:
+		return protocol.ErrorCode_ERR_PLAYER_NOT_PENDING_DELETE
+	case ErrRestoreExpired:
+		return protocol.ErrorCode_ERR_PLAYER_RESTORE_EXPIRED
+	case ErrGuildLeader:
+		return protocol.ErrorCode_ERR_PLAYER_GUILD_LEADER
+	case ErrActiveAuction:
+		return protocol.ErrorCode_ERR_PLAYER_ACTIVE_AUCTION
+	default:
+		return protocol.ErrorCode_ERR_SERVER
+	}
+}
+
+// CharDeleteService 角色删除服务
+// 申请删除只标记计划删除时间：冷却期内角色不可登录、可以恢复，名称仍被占用；
+// 冷却期满后由清除任务删除角色的物品、邮件、任务、宠物、技能与状态数据，最后删除角色并释放名称
+type CharDeleteService struct {
+	zService.BaseService
+	mu             sync.Mutex              // 串行化删除、恢复与清除，避免恢复与清除同一角色交错
+	guildService   *guild.GuildService     // 公会服务（检查会长身份，清除时退出公会）
+	auctionService *auction.AuctionService // 拍卖行服务（检查未结束的拍卖）
+	stopCh         chan struct{}           // 停止信号（结束清除任务）
+}
+
+// NewCharDeleteService 创建角色删除服务
+// 参数:
+//   - guildService: 公会服务，为nil时不检查会长身份
+//   - auctionService: 拍卖行服务，为nil时不检查拍卖
+//
+// 返回: 新创建的CharDeleteService实例
+func NewCharDeleteService(guildService *guild.GuildService, auctionService *auction.AuctionService) *CharDeleteService {
+	return &CharDeleteService{
+		BaseService:    *zService.NewBaseService(common.ServiceIdCharDelete),
+		guildService:   guildService,
+		auctionService: auctionService,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+// Init 初始化角色删除服务
+func (s *CharDeleteService) Init() error {
+	s.SetState(zService.ServiceStateInit)
+	zLog.Info("Initializing char delete service...", zap.String("serviceId", s.ServiceId()))
+	return nil
+}
+
+// Close 关闭角色删除服务，停止清除任务
+func (s *CharDeleteService) Close() error {
+	s.SetState(zService.ServiceStateStopping)
+	zLog.Info("Closing char delete service...", zap.String("serviceId", s.ServiceId()))
+
+	select {
+	case <-s.stopCh:
+	default:
+		close(s.stopCh)
+	}
+
+	s.SetState(zService.ServiceStateStopped)
+	return nil
+}
+
+// Serve 启动服务
+// 将服务状态设置为Running，并开始定期清除冷却期已满的角色
+func (s *CharDeleteService) Serve() {
+	s.SetState(zService.ServiceStateRunning)
+	go s.purgeLoop()
+}
+
+// RequestDelete 申请删除角色
+// 公会会长与有未结束拍卖的角色不能删除
+// 参数:
+//   - accountID: 发起请求的账号ID，角色须属于该账号
+//   - playerID: 角色ID
+//   - now: 当前时间
+//
+// 返回:
+//   - *models.Player: 标记计划删除时间后的角色
+//   - error: 删除错误
+func (s *CharDeleteService) RequestDelete(accountID, playerID int64, now time.Time) (*models.Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pl, err := s.loadPlayer(accountID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if !pl.DeleteAt.IsZero() {
+		return nil, ErrAlreadyPending
+	}
+	if s.guildService != nil && s.guildService.IsGuildLeader(common.PlayerIdType(playerID)) {
+		return nil, ErrGuildLeader
+	}
+	if s.auctionService != nil && s.auctionService.HasActiveAuctions(common.PlayerIdType(playerID)) {
+		return nil, ErrActiveAuction
+	}
+
+	// 数据仓库缓存共享玩家对象，修改副本后写回
+	updated := *pl
+	updated.DeleteAt = now.Add(time.Duration(config.GetCharDeleteConfig().Cooldown) * time.Second)
+	updated.UpdatedAt = now
+	if _, err := db.GetMgr().PlayerRepository.Update(&updated); err != nil {
+		return nil, err
+	}
+
+	zLog.Info("Player deletion requested",
+		zap.Int64("accountId", accountID),
+		zap.Int64("playerId", playerID),
+		zap.Time("deleteAt", updated.DeleteAt))
+	return &updated, nil
+}
+
+// Restore 恢复冷却期内申请删除的角色
+// 参数:
+//   - accountID: 发起请求的账号ID，角色须属于该账号
+//   - playerID: 角色ID
+//   - now: 当前时间
+//
+// 返回:
+//   - *models.Player: 恢复后的角色
+//   - error: 恢复错误
+func (s *CharDeleteService) Restore(accountID, playerID int64, now time.Time) (*models.Player, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pl, err := s.loadPlayer(accountID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if pl.DeleteAt.IsZero() {
+		return nil, ErrNotPending
+	}
+	if !now.Before(pl.DeleteAt) {
+		return nil, ErrRestoreExpired
+	}
+
+	updated := *pl
+	updated.DeleteAt = time.Time{}
+	updated.UpdatedAt = now
+	if _, err := db.GetMgr().PlayerRepository.Update(&updated); err != nil {
+		return nil, err
+	}
+
+	zLog.Info("Player restored", zap.Int64("accountId", accountID), zap.Int64("playerId", playerID))
+	return &updated, nil
+}
+
+// Purge 清除冷却期已满的角色
+// 单个角色清除失败时保留其待删除状态，由下一次清除任务重试
+// 参数:
+//   - now: 当前时间
+//
+// 返回:
+//   - int: 本次清除的角色数
+//   - error: 查询待清除角色的错误
+func (s *CharDeleteService) Purge(now time.Time) (int, error) {
+	if db.GetMgr() == nil || db.GetMgr().PlayerRepository == nil {
+		return 0, ErrRepositoryUnset
+	}
+	players, err := db.GetMgr().PlayerRepository.GetPendingDeletion(now, config.GetCharDeleteConfig().PurgeBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	purged := 0
+	for _, pl := range players {
+		if err := s.purgePlayer(pl); err != nil {
+			zLog.Error("Failed to purge player", zap.Int64("playerId", pl.PlayerID), zap.Error(err))
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purgeLoop 定期清除冷却期已满的角色，直到服务关闭
+func (s *CharDeleteService) purgeLoop() {
+	ticker := time.NewTicker(time.Duration(config.GetCharDeleteConfig().PurgeInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			purged, err := s.Purge(now)
+			if err != nil {
+				zLog.Error("Failed to query players pending deletion", zap.Error(err))
+			} else if purged > 0 {
+				zLog.Info("Purged deleted players", zap.Int("count", purged))
+			}
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+// purgePlayer 删除角色的全部数据，最后删除角色并释放名称
+// 角色记录最后删除，中途失败时角色仍处于待删除状态
+func (s *CharDeleteService) purgePlayer(pl *models.Player) error {
+	if err := purgePlayerData(pl.PlayerID); err != nil {
+		return err
+	}
+	if _, err := db.GetMgr().PlayerRepository.Delete(pl.PlayerID); err != nil {
+		return err
+	}
+
+	if s.guildService != nil {
+		if err := s.guildService.LeaveGuild(common.PlayerIdType(pl.PlayerID)); err != nil {
+			zLog.Warn("Failed to remove purged player from guild", zap.Int64("playerId", pl.PlayerID), zap.Error(err))
+		}
+	}
+	if err := naming.GetService().Release(models.NameKindPlayer, pl.PlayerName, pl.PlayerID); err != nil {
+		zLog.Warn("Failed to release purged player name", zap.String("name", pl.PlayerName), zap.Error(err))
+	}
+
+	zLog.Info("Player purged",
+		zap.Int64("accountId", pl.AccountID),
+		zap.Int64("playerId", pl.PlayerID),
+		zap.String("name", pl.PlayerName))
+	return nil
+}
+
+// purgePlayerData 删除角色的物品、邮件、任务、宠物、技能与状态数据（未初始化的数据仓库跳过）
+func purgePlayerData(playerID int64) error {
+	mgr := db.GetMgr()
+
+	if repo := mgr.PlayerItemRepository; repo != nil {
+		items, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if _, err := repo.Delete(item.ItemID); err != nil {
+				return err
+			}
+		}
+	}
+	if repo := mgr.PlayerMailRepository; repo != nil {
+		mails, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, mail := range mails {
+			if _, err := repo.Delete(mail.MailID); err != nil {
+				return err
+			}
+		}
+	}
+	if repo := mgr.PlayerQuestRepository; repo != nil {
+		quests, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, quest := range quests {
+			if _, err := repo.Delete(quest.ID); err != nil {
+				return err
+			}
+		}
+	}
+	if repo := mgr.PlayerPetRepository; repo != nil {
+		pets, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, pet := range pets {
+			if _, err := repo.Delete(pet.PetID); err != nil {
+				return err
+			}
+		}
+	}
+	if repo := mgr.PlayerSkillRepository; repo != nil {
+		skills, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, skill := range skills {
+			if _, err := repo.Delete(skill.ID); err != nil {
+				return err
+			}
+		}
+	}
+	if repo := mgr.PlayerBuffRepository; repo != nil {
+		buffs, err := repo.GetByPlayerID(playerID)
+		if err != nil {
+			return err
+		}
+		for _, buff := range buffs {
+			if _, err := repo.Delete(buff.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadPlayer 加载属于指定账号的角色
+func (s *CharDeleteService) loadPlayer(accountID, playerID int64) (*models.Player, error) {
+	if db.GetMgr() == nil || db.GetMgr().PlayerRepository == nil {
+		return nil, ErrRepositoryUnset
+	}
+	pl, err := db.GetMgr().PlayerRepository.GetByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	if pl == nil || pl.AccountID != accountID {
+		return nil, ErrPlayerNotFound
+	}
+	return pl, nil
+}
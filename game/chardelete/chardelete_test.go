@@ -0,0 +1,108 @@
+package chardelete
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/auction"
+	"github.com/pzqf/zGameServer/game/guild"
+	"github.com/pzqf/zGameServer/game/naming"
+)
+
+func TestDeleteRestorePurge(t *testing.T) {
+	db.InitMemoryDBManager()
+	s := NewCharDeleteService(nil, nil)
+
+	const accountID, playerID = 101, 1001
+	if err := naming.GetService().Reserve(models.NameKindPlayer, "Ranger", playerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetMgr().PlayerRepository.Create(&models.Player{PlayerID: playerID, AccountID: accountID, PlayerName: "Ranger"}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(10000, 0)
+	if _, err := s.RequestDelete(accountID+1, playerID, now); err != ErrPlayerNotFound {
+		t.Fatalf("expected other accounts to be rejected, got %v", err)
+	}
+	pl, err := s.RequestDelete(accountID, playerID, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pl.DeleteAt.After(now) {
+		t.Fatalf("expected delete time after cooldown, got %v", pl.DeleteAt)
+	}
+	if _, err := s.RequestDelete(accountID, playerID, now); err != ErrAlreadyPending {
+		t.Fatalf("expected already pending, got %v", err)
+	}
+
+	if _, err := s.Restore(accountID, playerID, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Restore(accountID, playerID, now.Add(time.Hour)); err != ErrNotPending {
+		t.Fatalf("expected not pending after restore, got %v", err)
+	}
+
+	pl, err = s.RequestDelete(accountID, playerID, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Restore(accountID, playerID, pl.DeleteAt); err != ErrRestoreExpired {
+		t.Fatalf("expected restore window expired, got %v", err)
+	}
+
+	// 冷却期内不清除，名称仍被占用
+	if purged, err := s.Purge(pl.DeleteAt.Add(-time.Second)); err != nil || purged != 0 {
+		t.Fatalf("expected nothing purged before cooldown, got %d %v", purged, err)
+	}
+	if err := naming.GetService().Reserve(models.NameKindPlayer, "ranger", playerID+1); err != naming.ErrNameTaken {
+		t.Fatalf("expected name held while pending, got %v", err)
+	}
+
+	if purged, err := s.Purge(pl.DeleteAt); err != nil || purged != 1 {
+		t.Fatalf("expected player purged, got %d %v", purged, err)
+	}
+	if stored, _ := db.GetMgr().PlayerRepository.GetByID(playerID); stored != nil {
+		t.Fatal("expected player record deleted")
+	}
+	if err := naming.GetService().Reserve(models.NameKindPlayer, "ranger", playerID+1); err != nil {
+		t.Fatalf("expected name released after purge, got %v", err)
+	}
+}
+
+func TestDeleteBlockedForGuildLeaderAndAuctions(t *testing.T) {
+	db.InitMemoryDBManager()
+	guildService, auctionService := guild.NewGuildService(), auction.NewAuctionService()
+	s := NewCharDeleteService(guildService, auctionService)
+
+	const accountID = 202
+	for _, playerID := range []int64{2001, 2002} {
+		if _, err := db.GetMgr().PlayerRepository.Create(&models.Player{PlayerID: playerID, AccountID: accountID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := guildService.CreateGuild(1, "Vanguard", 2001, "leader"); err != nil {
+		t.Fatal(err)
+	}
+	if err := auctionService.CreateAuction(&auction.AuctionItem{AuctionId: 1, SellerId: 2002, Status: auction.AuctionStatusActive}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	if _, err := s.RequestDelete(accountID, 2001, now); err != ErrGuildLeader {
+		t.Fatalf("expected guild leader blocked, got %v", err)
+	}
+	if _, err := s.RequestDelete(accountID, 2002, now); err != ErrActiveAuction {
+		t.Fatalf("expected active auction blocked, got %v", err)
+	}
+
+	if err := auctionService.CancelAuction(common.AuctionIdType(1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RequestDelete(accountID, 2002, now); err != nil {
+		t.Fatalf("expected deletion allowed after auction canceled, got %v", err)
+	}
+}
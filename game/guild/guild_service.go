@@ -7,6 +7,8 @@ import (
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zService"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/naming"
 	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
 )
@@ -17,7 +19,7 @@ type GuildService struct {
 	zService.BaseService
 	guilds       *zMap.TypedMap[common.GuildIdType, *Guild]                     // 公会映射表（GuildId -> Guild）
 	playerGuild  *zMap.TypedShardedMap[common.PlayerIdType, common.GuildIdType] // 玩家公会映射表（PlayerId -> GuildId）
-	guildNameMap *zMap.TypedMap[string, common.GuildIdType]                     // 公会名称映射表（Name -> GuildId）
+	guildNameMap *zMap.TypedMap[string, common.GuildIdType]                     // 公会名称映射表（折叠后的Name -> GuildId）
 	maxGuilds    int                                                            // 最大公会数量限制
 }
 
@@ -70,11 +72,11 @@ func (gs *GuildService) Serve() {
 //
 // 返回:
 //   - *Guild: 新创建的公会对象
-//   - error: 创建错误（名称重复、数量超限、玩家已有公会等）
+//   - error: 创建错误（名称不合规或重复、数量超限、玩家已有公会等）
 func (gs *GuildService) CreateGuild(guildId common.GuildIdType, guildName string, leaderId common.PlayerIdType, leaderName string) (*Guild, error) {
-	// 检查公会名称是否已存在
-	if _, exists := gs.guildNameMap.Load(guildName); exists {
-		return nil, nil
+	// 检查公会名称是否已存在（不区分大小写）
+	if _, exists := gs.guildNameMap.Load(naming.Fold(guildName)); exists {
+		return nil, naming.ErrNameTaken
 	}
 
 	// 检查公会数量上限
@@ -87,6 +89,11 @@ func (gs *GuildService) CreateGuild(guildId common.GuildIdType, guildName string
 		return nil, nil
 	}
 
+	// 检查命名规则并全服占用公会名称
+	if err := naming.GetService().Reserve(models.NameKindGuild, guildName, int64(guildId)); err != nil {
+		return nil, err
+	}
+
 	currentTime := time.Now().UnixMilli()
 
 	// 初始化权限配置
@@ -148,7 +155,7 @@ func (gs *GuildService) CreateGuild(guildId common.GuildIdType, guildName string
 	// 注册到映射表
 	gs.guilds.Store(guildId, guild)
 	gs.playerGuild.Store(leaderId, guildId)
-	gs.guildNameMap.Store(guildName, guildId)
+	gs.guildNameMap.Store(naming.Fold(guildName), guildId)
 
 	zLog.Info("Guild created", zap.Int64("guildId", int64(guildId)), zap.String("guildName", guildName), zap.Int64("leaderId", int64(leaderId)))
 	return guild, nil
@@ -236,8 +243,11 @@ func (gs *GuildService) DisbandGuild(guildId common.GuildIdType) error {
 		return true
 	})
 
-	// 清理名称映射
-	gs.guildNameMap.Delete(guild.Name)
+	// 清理名称映射并释放名称占用
+	gs.guildNameMap.Delete(naming.Fold(guild.Name))
+	if err := naming.GetService().Release(models.NameKindGuild, guild.Name, int64(guildId)); err != nil {
+		zLog.Warn("Failed to release guild name", zap.String("guildName", guild.Name), zap.Error(err))
+	}
 
 	// 删除公会
 	gs.guilds.Delete(guildId)
@@ -272,6 +282,16 @@ func (gs *GuildService) GetGuildByPlayer(playerId common.PlayerIdType) (*Guild,
 	return gs.GetGuild(guildId)
 }
 
+// IsGuildLeader 检查玩家是否为公会会长
+// 参数:
+//   - playerId: 玩家ID
+//
+// 返回: 玩家所在公会的会长为该玩家时返回true
+func (gs *GuildService) IsGuildLeader(playerId common.PlayerIdType) bool {
+	guild, exists := gs.GetGuildByPlayer(playerId)
+	return exists && guild.LeaderId == playerId
+}
+
 // ApplyGuild 申请加入公会
 // 参数:
 //   - applyId: 申请ID
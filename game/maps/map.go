@@ -116,8 +116,7 @@ func (m *Map) GetObjectsInRange(center gamecommon.Vector3, radius float32) []gam
 	objects := make([]gamecommon.IGameObject, 0)
 
 	for _, obj := range m.objects {
-		distance := obj.GetPosition().DistanceTo(center)
-		if distance <= radius*radius {
+		if obj.GetPosition().DistanceTo(center) <= radius {
 			objects = append(objects, obj)
 		}
 	}
@@ -136,6 +135,10 @@ func (m *Map) AddObject(object gamecommon.IGameObject) {
 
 	objectID := object.GetID()
 	m.objects[objectID] = object
+	if object.GetType() == gamecommon.GameObjectTypePlayer {
+		// 玩家对象ID与玩家ID一致
+		m.players[common.PlayerIdType(objectID)] = true
+	}
 
 	// 添加到对应的区域
 	regionID := m.getRegionID(object.GetPosition())
@@ -155,6 +158,7 @@ func (m *Map) RemoveObject(objectID common.ObjectIdType) {
 	defer m.mu.Unlock()
 
 	delete(m.objects, objectID)
+	delete(m.players, common.PlayerIdType(objectID))
 
 	// 从区域中移除
 	for regionID, region := range m.regions {
@@ -253,3 +257,54 @@ func (m *Map) GetObjectsByType(objectType gamecommon.GameObjectType) []gamecommo
 
 	return objects
 }
+
+// GetAllObjects 获取地图内的所有游戏对象
+func (m *Map) GetAllObjects() []gamecommon.IGameObject {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	objects := make([]gamecommon.IGameObject, 0, len(m.objects))
+	for _, obj := range m.objects {
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// GetPlayerIds 获取地图内的所有玩家ID
+func (m *Map) GetPlayerIds() []common.PlayerIdType {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	playerIds := make([]common.PlayerIdType, 0, len(m.players))
+	for playerId := range m.players {
+		playerIds = append(playerIds, playerId)
+	}
+	return playerIds
+}
+
+// GetPlayersInRange 获取指定范围内的玩家ID
+// 参数:
+//   - center: 中心坐标
+//   - radius: 半径
+//
+// 返回: 玩家ID列表
+func (m *Map) GetPlayersInRange(center gamecommon.Vector3, radius float32) []common.PlayerIdType {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	playerIds := make([]common.PlayerIdType, 0)
+	for playerId := range m.players {
+		obj, exists := m.objects[common.ObjectIdType(playerId)]
+		if exists && obj.GetPosition().DistanceTo(center) <= radius {
+			playerIds = append(playerIds, playerId)
+		}
+	}
+	return playerIds
+}
+
+// GetPlayerCount 获取地图内的玩家数量
+func (m *Map) GetPlayerCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.players)
+}
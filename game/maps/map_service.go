@@ -9,10 +9,20 @@ import (
 	"github.com/pzqf/zEngine/zService"
 	"github.com/pzqf/zGameServer/common"
 	gamecommon "github.com/pzqf/zGameServer/game/common"
+	"github.com/pzqf/zGameServer/net/protocol"
 	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
 )
 
+// MapBroadcaster 地图消息广播接口
+// 由广播模块实现，地图服务通过它向地图内的玩家推送同步消息
+type MapBroadcaster interface {
+	// Encode 将消息序列化为消息体
+	Encode(v interface{}) ([]byte, error)
+	// ToMap 将消息体发送给地图内的所有玩家，返回成功发送的玩家数
+	ToMap(mapId common.MapIdType, protoId int32, data []byte) int
+}
+
 // MapService 地图服务
 // 负责管理所有地图实例，处理地图的加载、卸载和同步
 // 提供地图对象的管理和查询功能
@@ -24,6 +34,7 @@ type MapService struct {
 	gameObjects *zMap.TypedShardedMap[common.ObjectIdType, gamecommon.IGameObject] // 存储所有游戏对象，key: ObjectIdType, value: gamecommon.IGameObject
 	maxMaps     int                                                                // 最大地图数量限制
 	stopSyncCh  chan struct{}                                                      // 停止同步循环的信号通道
+	broadcaster MapBroadcaster                                                     // 地图消息广播（为空时不推送同步消息）
 }
 
 // NewMapService 创建地图服务实例
@@ -155,16 +166,60 @@ func (ms *MapService) mapSyncLoop() {
 	}
 }
 
+// SetBroadcaster 设置地图消息广播
+// 需在Serve之前调用
+func (ms *MapService) SetBroadcaster(broadcaster MapBroadcaster) {
+	ms.broadcaster = broadcaster
+}
+
+// GetMap 根据地图ID获取地图
+// mapId: 地图ID
+// 返回地图实例及是否存在
+func (ms *MapService) GetMap(mapId common.MapIdType) (*Map, bool) {
+	return ms.maps.Load(mapId)
+}
+
 // syncMaps 同步地图
-// 遍历所有地图，同步地图对象的位置和状态
+// 遍历所有有玩家的地图，将地图对象的位置和状态广播给地图内的玩家
 func (ms *MapService) syncMaps() {
-	// 遍历所有地图
+	if ms.broadcaster == nil {
+		return
+	}
+
+	syncTime := time.Now().UnixMilli()
 	ms.maps.Range(func(key common.MapIdType, value *Map) bool {
 		mapObj := value
-		// 同步地图对象的位置和状态
-		// 这里可以实现地图对象的同步逻辑
-		// 由于Map类型没有SyncObjects方法，我们可以简单地打印日志或者实现自己的同步逻辑
-		zLog.Debug("Synchronizing map objects", zap.Any("mapId", mapObj.GetID()))
+		if mapObj.GetPlayerCount() == 0 {
+			return true
+		}
+
+		msg := &protocol.MapSyncObjects{
+			MapId:    int64(mapObj.GetID()),
+			SyncTime: syncTime,
+		}
+		for _, obj := range mapObj.GetAllObjects() {
+			pos := obj.GetPosition()
+			msg.Objects = append(msg.Objects, &protocol.MapObjectInfo{
+				ObjectId:   int64(obj.GetID()),
+				ObjectType: int32(obj.GetType()),
+				MapId:      int64(mapObj.GetID()),
+				X:          pos.X,
+				Y:          pos.Y,
+				Z:          pos.Z,
+			})
+		}
+
+		// 每张地图只序列化一次，再分发给地图内的所有玩家
+		data, err := ms.broadcaster.Encode(msg)
+		if err != nil {
+			zLog.Error("Failed to encode map sync objects", zap.Any("mapId", mapObj.GetID()), zap.Error(err))
+			return true
+		}
+		sent := ms.broadcaster.ToMap(mapObj.GetID(), int32(protocol.MapMsgId_MSG_MAP_SYNC_OBJECTS), data)
+		zLog.Debug("Synchronized map objects",
+			zap.Any("mapId", mapObj.GetID()),
+			zap.Int("objects", len(msg.Objects)),
+			zap.Int("players", sent))
 		return true
 	})
 }
@@ -0,0 +1,41 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/pzqf/zGameServer/common"
+	gamecommon "github.com/pzqf/zGameServer/game/common"
+	"github.com/pzqf/zGameServer/game/object"
+)
+
+func TestMapPlayerRecipients(t *testing.T) {
+	m := NewMap(1, 1, "test", 1000, 1000)
+
+	near := object.NewGameObjectWithType(10, "near", gamecommon.GameObjectTypePlayer)
+	near.SetPosition(gamecommon.NewVector3(3, 4, 0))
+	far := object.NewGameObjectWithType(11, "far", gamecommon.GameObjectTypePlayer)
+	far.SetPosition(gamecommon.NewVector3(30, 40, 0))
+	monster := object.NewGameObjectWithType(12, "monster", gamecommon.GameObjectTypeMonster)
+
+	m.AddObject(near)
+	m.AddObject(far)
+	m.AddObject(monster)
+
+	if count := m.GetPlayerCount(); count != 2 {
+		t.Fatalf("player count = %d, want 2", count)
+	}
+
+	inRange := m.GetPlayersInRange(gamecommon.NewVector3(0, 0, 0), 5)
+	if len(inRange) != 1 || inRange[0] != common.PlayerIdType(10) {
+		t.Fatalf("players in range = %v, want [10]", inRange)
+	}
+	if objects := m.GetObjectsInRange(gamecommon.NewVector3(0, 0, 0), 5); len(objects) != 2 {
+		t.Fatalf("objects in range = %d, want 2", len(objects))
+	}
+
+	m.RemoveObject(10)
+	playerIds := m.GetPlayerIds()
+	if len(playerIds) != 1 || playerIds[0] != common.PlayerIdType(11) {
+		t.Fatalf("player ids = %v, want [11]", playerIds)
+	}
+}
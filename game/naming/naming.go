@@ -0,0 +1,338 @@
+package naming
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/db/repository"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNameEmpty       = errors.New("naming: name is empty")
+	ErrNameLength      = errors.New("naming: invalid name length")
+	ErrNameCharset     = errors.New("naming: name contains invalid characters")
+	ErrNameReserved    = errors.New("naming: name is reserved")
+	ErrNameSensitive   = errors.New("naming: name contains sensitive words")
+	ErrNameTaken       = errors.New("naming: name is already taken")
+	ErrRepositoryUnset = errors.New("naming: name repository not initialized")
+)
+
+// ErrorCode 将名称检查错误转换为客户端错误码
+func ErrorCode(err error) protocol.ErrorCode {
+	switch err {
+	case nil:
+		return protocol.ErrorCode_ERR_OK
+	case ErrNameEmpty:
+		return protocol.ErrorCode_ERR_PLAYER_NAME_EMPTY
+	case ErrNameLength:
+		return protocol.ErrorCode_ERR_NAME_LENGTH
+	case ErrNameCharset:
+		return protocol.ErrorCode_ERR_NAME_CHARSET
+	case ErrNameReserved:
+		return protocol.ErrorCode_ERR_NAME_RESERVED
+	case ErrNameSensitive:
+		return protocol.ErrorCode_ERR_NAME_SENSITIVE
+	case ErrNameTaken:
+		return protocol.ErrorCode_ERR_NAME_TAKEN
+	default:
+		return protocol.ErrorCode_ERR_SERVER
+	}
+}
+
+// localeScripts 各语言区域除英文字母与数字外允许的文字，这些文字按宽度2计算长度
+var localeScripts = map[string][]*unicode.RangeTable{
+	"zh": {unicode.Han},
+	"en": nil,
+	"ja": {unicode.Han, unicode.Hiragana, unicode.Katakana},
+	"ko": {unicode.Hangul},
+}
+
+// uniqueKinds 需要全服唯一的名称类型（宠物名只做规则检查）
+var uniqueKinds = map[int32]bool{
+	models.NameKindPlayer: true,
+	models.NameKindGuild:  true,
+}
+
+// Fold 名称折叠：全角字母数字转为半角并转为小写，用于不区分大小写的唯一性、保留名称与敏感词检查
+func Fold(name string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if r >= 0xFF01 && r <= 0xFF5E {
+			return r - 0xFEE0
+		}
+		if r == 0x3000 {
+			return ' '
+		}
+		return r
+	}, name))
+}
+
+// Service 命名服务（并发安全）
+// 角色创建、改名、公会创建与宠物命名共用的名称检查：长度与字符集、保留名称、敏感词，以及角色名与公会名的全服唯一
+type Service struct {
+	mu        sync.RWMutex
+	reserved  map[string]struct{} // 折叠后的保留名称
+	sensitive *Trie               // 折叠后的敏感词
+}
+
+var (
+	service     *Service
+	serviceOnce sync.Once
+)
+
+// GetService 获取全局命名服务
+func GetService() *Service {
+	serviceOnce.Do(func() {
+		service = NewService()
+	})
+	return service
+}
+
+// NewService 创建命名服务
+func NewService() *Service {
+	return &Service{
+		reserved:  make(map[string]struct{}),
+		sensitive: NewTrie(),
+	}
+}
+
+// LoadWordLists 从文件加载保留名称与敏感词（每行一个，#开头为注释），替换当前列表
+// 参数:
+//   - reservedFile: 保留名称列表文件，为空时不加载
+//   - sensitiveFile: 敏感词列表文件，为空时不加载
+func (s *Service) LoadWordLists(reservedFile, sensitiveFile string) error {
+	reserved, err := readWordList(reservedFile)
+	if err != nil {
+		return err
+	}
+	sensitive, err := readWordList(sensitiveFile)
+	if err != nil {
+		return err
+	}
+	s.SetWords(reserved, sensitive)
+
+	zLog.Info("Naming word lists loaded", zap.Int("reserved", len(reserved)), zap.Int("sensitive", len(sensitive)))
+	return nil
+}
+
+// SetWords 设置保留名称与敏感词，替换当前列表
+func (s *Service) SetWords(reserved, sensitive []string) {
+	reservedSet := make(map[string]struct{}, len(reserved))
+	for _, word := range reserved {
+		reservedSet[Fold(word)] = struct{}{}
+	}
+	trie := NewTrie()
+	for _, word := range sensitive {
+		trie.Add(Fold(word))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reserved = reservedSet
+	s.sensitive = trie
+}
+
+// Validate 检查名称是否符合命名规则（不检查是否已被占用）
+// 参数:
+//   - kind: 名称类型
+//   - name: 名称
+func (s *Service) Validate(kind int32, name string) error {
+	if name == "" {
+		return ErrNameEmpty
+	}
+
+	cfg := config.GetNamingConfig()
+	scripts, ok := localeScripts[cfg.Locale]
+	if !ok {
+		scripts = localeScripts["zh"]
+	}
+
+	width := 0
+	for _, r := range name {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			width++
+		case unicode.IsLetter(r) && unicode.In(r, scripts...):
+			width += 2
+		default:
+			return ErrNameCharset
+		}
+	}
+	minLength, maxLength := nameLengths(cfg, kind)
+	if width < minLength || width > maxLength {
+		return ErrNameLength
+	}
+
+	folded := Fold(name)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, reserved := s.reserved[folded]; reserved {
+		return ErrNameReserved
+	}
+	if _, found := s.sensitive.Match(folded); found {
+		return ErrNameSensitive
+	}
+	return nil
+}
+
+// Reserve 检查并占用名称
+// 需要全服唯一的名称类型写入名称占用记录，其他类型只检查命名规则
+// 参数:
+//   - kind: 名称类型
+//   - name: 名称
+//   - ownerID: 所有者ID（角色ID、公会ID等）
+func (s *Service) Reserve(kind int32, name string, ownerID int64) error {
+	if err := s.Validate(kind, name); err != nil {
+		return err
+	}
+	if !uniqueKinds[kind] {
+		return nil
+	}
+	repo := s.nameRepository()
+	if repo == nil {
+		return ErrRepositoryUnset
+	}
+
+	// 占用记录之前创建的角色与公会不在名称占用表中，按原名称再检查一次
+	taken, err := legacyNameTaken(kind, name, ownerID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrNameTaken
+	}
+
+	reserved, err := repo.Reserve(&models.NameRecord{
+		Kind:      kind,
+		NameKey:   Fold(name),
+		Name:      name,
+		OwnerID:   ownerID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		return ErrNameTaken
+	}
+	return nil
+}
+
+// Release 释放名称（角色删除、公会解散或占用后创建失败时调用）
+// 参数:
+//   - kind: 名称类型
+//   - name: 名称
+//   - ownerID: 所有者ID，只释放属于该所有者的占用
+func (s *Service) Release(kind int32, name string, ownerID int64) error {
+	if !uniqueKinds[kind] {
+		return nil
+	}
+	repo := s.nameRepository()
+	if repo == nil {
+		return ErrRepositoryUnset
+	}
+	_, err := repo.Release(kind, Fold(name), ownerID)
+	return err
+}
+
+// Rename 改名：占用新名称后释放旧名称
+// 只改变大小写时沿用原占用记录
+// 参数:
+//   - kind: 名称类型
+//   - ownerID: 所有者ID
+//   - oldName: 原名称
+//   - newName: 新名称
+func (s *Service) Rename(kind int32, ownerID int64, oldName, newName string) error {
+	if Fold(oldName) == Fold(newName) {
+		return s.Validate(kind, newName)
+	}
+	if err := s.Reserve(kind, newName, ownerID); err != nil {
+		return err
+	}
+	if err := s.Release(kind, oldName, ownerID); err != nil {
+		zLog.Warn("Failed to release old name", zap.Int32("kind", kind), zap.String("name", oldName), zap.Int64("ownerId", ownerID), zap.Error(err))
+	}
+	return nil
+}
+
+// legacyNameTaken 检查名称是否已被名称占用表之前创建的角色或公会使用
+// 公会只检查数据库，内存中的公会名称由公会服务检查
+// 参数:
+//   - kind: 名称类型
+//   - name: 名称
+//   - ownerID: 所有者ID，名称属于该所有者时不算占用
+func legacyNameTaken(kind int32, name string, ownerID int64) (bool, error) {
+	switch kind {
+	case models.NameKindPlayer:
+		existing, err := db.GetMgr().PlayerRepository.GetByName(name)
+		if err != nil {
+			return false, err
+		}
+		return existing != nil && existing.PlayerID != ownerID, nil
+	case models.NameKindGuild:
+		if db.GetMgr().GuildRepository == nil {
+			return false, nil
+		}
+		existing, err := db.GetMgr().GuildRepository.GetByName(name)
+		if err != nil {
+			return false, err
+		}
+		return existing != nil && existing.GuildID != ownerID, nil
+	}
+	return false, nil
+}
+
+// nameRepository 获取名称占用数据仓库，数据库未初始化时返回nil
+func (s *Service) nameRepository() repository.NameRepository {
+	if db.GetMgr() == nil {
+		return nil
+	}
+	return db.GetMgr().NameRepository
+}
+
+// nameLengths 获取名称类型的宽度范围
+func nameLengths(cfg *config.NamingConfig, kind int32) (int, int) {
+	switch kind {
+	case models.NameKindGuild:
+		return cfg.GuildMinLength, cfg.GuildMaxLength
+	case models.NameKindPet:
+		return cfg.PetMinLength, cfg.PetMaxLength
+	default:
+		return cfg.PlayerMinLength, cfg.PlayerMaxLength
+	}
+}
+
+// readWordList 读取词表文件（每行一个，忽略空行与#开头的注释）
+func readWordList(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
@@ -0,0 +1,124 @@
+package naming
+
+import (
+	"testing"
+
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/db/repository"
+)
+
+func TestFold(t *testing.T) {
+	if got := Fold("ＡｂＣ１２"); got != "abc12" {
+		t.Fatalf("expected full-width letters folded to half-width lowercase, got %q", got)
+	}
+}
+
+func TestTrieMatch(t *testing.T) {
+	trie := NewTrie()
+	trie.Add("bad")
+	trie.Add("badword")
+	trie.Add("坏人")
+	trie.Add("bad")
+	if trie.Len() != 3 {
+		t.Fatalf("expected 3 words, got %d", trie.Len())
+	}
+
+	cases := map[string]string{
+		"xbadwordx": "bad",
+		"我是坏人":      "坏人",
+		"goodname":  "",
+		"ba":        "",
+	}
+	for text, want := range cases {
+		got, found := trie.Match(text)
+		if found != (want != "") || got != want {
+			t.Fatalf("Match(%q) = %q, %v; want %q", text, got, found, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s := NewService()
+	s.SetWords([]string{"Admin"}, []string{"fuck"})
+
+	cases := []struct {
+		kind int32
+		name string
+		want error
+	}{
+		{models.NameKindPlayer, "Hero1", nil},
+		{models.NameKindPlayer, "张三", nil},
+		{models.NameKindPlayer, "", ErrNameEmpty},
+		{models.NameKindPlayer, "abc", ErrNameLength},
+		{models.NameKindPlayer, "张三李四王五赵六钱", ErrNameLength},
+		{models.NameKindPlayer, "Hero 1", ErrNameCharset},
+		{models.NameKindPlayer, "ひらがな", ErrNameCharset},
+		{models.NameKindPlayer, "ADMIN", ErrNameReserved},
+		{models.NameKindGuild, "MyFuckGuild", ErrNameSensitive},
+		{models.NameKindPet, "喵", nil},
+	}
+	for _, c := range cases {
+		if err := s.Validate(c.kind, c.name); err != c.want {
+			t.Fatalf("Validate(%d, %q) = %v; want %v", c.kind, c.name, err, c.want)
+		}
+	}
+}
+
+func TestReserveRelease(t *testing.T) {
+	db.InitMemoryDBManager()
+	s := NewService()
+
+	if err := s.Reserve(models.NameKindPlayer, "Knight", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reserve(models.NameKindPlayer, "KNIGHT", 2); err != ErrNameTaken {
+		t.Fatalf("expected case-insensitive conflict, got %v", err)
+	}
+	if err := s.Reserve(models.NameKindGuild, "Knight", 10); err != nil {
+		t.Fatalf("expected guild names to be independent of player names, got %v", err)
+	}
+
+	if err := s.Rename(models.NameKindPlayer, 1, "Knight", "Paladin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reserve(models.NameKindPlayer, "knight", 2); err != nil {
+		t.Fatalf("expected old name released after rename, got %v", err)
+	}
+
+	if err := s.Release(models.NameKindPlayer, "Paladin", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reserve(models.NameKindPlayer, "Paladin", 3); err != ErrNameTaken {
+		t.Fatalf("expected release by another owner to be ignored, got %v", err)
+	}
+}
+
+// legacyGuildRepository 只提供按名称查询的公会数据仓库
+type legacyGuildRepository struct {
+	repository.GuildRepository
+	guilds map[string]*models.Guild
+}
+
+func (r *legacyGuildRepository) GetByName(name string) (*models.Guild, error) {
+	return r.guilds[name], nil
+}
+
+func TestReserveLegacyGuildName(t *testing.T) {
+	db.InitMemoryDBManager()
+	previous := db.GetMgr().GuildRepository
+	t.Cleanup(func() { db.GetMgr().GuildRepository = previous })
+
+	// 名称占用表之前创建的公会只存在于公会表中
+	db.GetMgr().GuildRepository = &legacyGuildRepository{guilds: map[string]*models.Guild{
+		"Legacy": {GuildID: 20, GuildName: "Legacy"},
+	}}
+	s := NewService()
+
+	if err := s.Reserve(models.NameKindGuild, "Legacy", 21); err != ErrNameTaken {
+		t.Fatalf("expected legacy guild name to be taken, got %v", err)
+	}
+	if err := s.Reserve(models.NameKindGuild, "Legacy", 20); err != nil {
+		t.Fatalf("expected legacy guild to reserve its own name, got %v", err)
+	}
+}
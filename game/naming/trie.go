@@ -0,0 +1,72 @@
+package naming
+
+// trieNode 字典树节点
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool // 是否为某个词的结尾
+}
+
+// Trie 敏感词字典树
+// 从文本的每个位置开始沿树匹配，找出文本中包含的词
+type Trie struct {
+	root *trieNode
+	size int
+}
+
+// NewTrie 创建字典树
+func NewTrie() *Trie {
+	return &Trie{root: &trieNode{}}
+}
+
+// Add 添加词（空字符串忽略）
+func (t *Trie) Add(word string) {
+	if word == "" {
+		return
+	}
+	node := t.root
+	for _, r := range word {
+		child, ok := node.children[r]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[rune]*trieNode)
+			}
+			child = &trieNode{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	if !node.end {
+		node.end = true
+		t.size++
+	}
+}
+
+// Len 获取词数量
+func (t *Trie) Len() int {
+	return t.size
+}
+
+// Match 查找文本中包含的词
+// 参数:
+//   - text: 待检查的文本
+//
+// 返回:
+//   - string: 最先出现的词（同一位置取最短的词）
+//   - bool: 是否包含
+func (t *Trie) Match(text string) (string, bool) {
+	runes := []rune(text)
+	for start := range runes {
+		node := t.root
+		for i := start; i < len(runes); i++ {
+			child, ok := node.children[runes[i]]
+			if !ok {
+				break
+			}
+			if child.end {
+				return string(runes[start : i+1]), true
+			}
+			node = child
+		}
+	}
+	return "", false
+}
@@ -2,7 +2,9 @@ package pet
 
 import (
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db/models"
 	gamecommon "github.com/pzqf/zGameServer/game/common"
+	"github.com/pzqf/zGameServer/game/naming"
 	"github.com/pzqf/zGameServer/game/object"
 	"github.com/pzqf/zGameServer/game/object/component"
 )
@@ -197,3 +199,18 @@ func (p *Pet) GetPetGrowth() *PetGrowthSystem {
 func (p *Pet) GetIntimacy() *IntimacySystem {
 	return p.intimacy
 }
+
+// Rename 宠物改名
+// 宠物名不要求全服唯一，只检查长度、字符集、保留名称与敏感词
+// 参数:
+//   - name: 新名称
+//
+// 返回:
+//   - error: 名称不符合命名规则时返回naming包的错误
+func (p *Pet) Rename(name string) error {
+	if err := naming.GetService().Validate(models.NameKindPet, name); err != nil {
+		return err
+	}
+	p.SetName(name)
+	return nil
+}
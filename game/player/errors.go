@@ -8,6 +8,9 @@ var (
 	errTooManyPlayers       = errors.New("too many players online")
 	errPlayerSessionInvalid = errors.New("invalid player session")
 	errPlayerServiceClosed  = errors.New("player service is closed")
+	errResumeTokenInvalid   = errors.New("invalid or expired resume token")
+	errLoginQueueFull       = errors.New("login queue is full")
+	errLoginQueueDuplicate  = errors.New("session already in login queue")
 )
 
 func IsPlayerNotFound(err error) bool {
@@ -28,4 +31,16 @@ func IsPlayerSessionInvalid(err error) bool {
 
 func IsPlayerServiceClosed(err error) bool {
 	return errors.Is(err, errPlayerServiceClosed)
-}
\ No newline at end of file
+}
+
+func IsResumeTokenInvalid(err error) bool {
+	return errors.Is(err, errResumeTokenInvalid)
+}
+
+func IsLoginQueueFull(err error) bool {
+	return errors.Is(err, errLoginQueueFull)
+}
+
+func IsLoginQueueDuplicate(err error) bool {
+	return errors.Is(err, errLoginQueueDuplicate)
+}
@@ -0,0 +1,45 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/protolayer"
+)
+
+// fuzzSession 丢弃下行消息的测试会话
+type fuzzSession struct{}
+
+func (s *fuzzSession) GetSid() zNet.SessionIdType            { return 1 }
+func (s *fuzzSession) Send(protoId int32, data []byte) error { return nil }
+func (s *fuzzSession) Close()                                {}
+
+// 玩家Actor处理任意网络消息：在测试协程中直接分发，处理函数不应panic
+func FuzzPlayerMsgHandlers(f *testing.F) {
+	msgIds := GetNetworkMsgIds()
+	if len(msgIds) == 0 {
+		f.Skip("no player message handlers registered")
+	}
+
+	records, err := capture.LoadInbound("../../testdata/captures")
+	if err != nil {
+		f.Fatalf("load captures: %v", err)
+	}
+	for _, record := range records {
+		if _, exists := playerMsgHandlers[record.ProtoId]; exists {
+			f.Add(record.ProtoId, record.Data)
+		}
+	}
+
+	p := protolayer.NewProtobufProtocol()
+	f.Fuzz(func(t *testing.T, protoId int32, data []byte) {
+		// 未注册的消息ID映射到已注册的消息，集中覆盖处理函数
+		if _, exists := playerMsgHandlers[protoId]; !exists {
+			protoId = msgIds[int(uint32(protoId)%uint32(len(msgIds)))]
+		}
+		// 每次使用新的玩家，避免背包、邮件等状态跨输入累积
+		pa := NewPlayerActor(1, "fuzz", &fuzzSession{})
+		pa.handleNetworkMessage(&zNet.NetPacket{ProtoId: protoId, DataSize: int32(len(data)), Data: data}, p)
+	})
+}
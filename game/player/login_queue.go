@@ -0,0 +1,313 @@
+package player
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+)
+
+// LoginQueueTickInterval 登录排队的处理间隔（超时检查、放行与进度推送）
+const LoginQueueTickInterval = time.Second
+
+// LoginQueueEntry 登录排队条目
+type LoginQueueEntry struct {
+	SessionId  zNet.SessionIdType  // 排队的会话ID
+	PlayerId   common.PlayerIdType // 登录的角色ID
+	VipLevel   int                 // 角色VIP等级（决定VIP通道）
+	IsGM       bool                // 是否GM账号（GM通道，可使用保留名额）
+	EnqueuedAt time.Time           // 进入队列的时间
+	Data       interface{}         // 放行后完成登录所需的数据（由网络层保存原登录请求）
+
+	rank       int       // 通道优先级，越小越优先
+	position   int       // 最近一次推送的排队位置
+	lastNotify time.Time // 最近一次推送进度的时间
+}
+
+// LoginQueueStatus 排队进度
+type LoginQueueStatus struct {
+	Position int           // 排队位置（从1开始）
+	Length   int           // 排队总人数
+	ETA      time.Duration // 预计等待时间，0表示暂无法估计
+}
+
+// LoginQueueCapacity 获取在线人数与最大在线人数
+type LoginQueueCapacity func() (online int64, max int64)
+
+// LoginQueue 登录排队（并发安全）
+// 服务器满员时角色登录进入队列，按通道优先级放行：GM通道最先，其次VIP通道（最低等级由高到低），最后普通通道；
+// 同一通道先到先放行。GM可使用保留名额，其他玩家在线人数达到 最大在线人数-保留名额 即需排队。
+// 回调在锁外执行，可在回调中完成登录或发送消息
+type LoginQueue struct {
+	mu         sync.Mutex
+	entries    []*LoginQueueEntry // 按通道优先级与入队顺序排列
+	capacity   LoginQueueCapacity
+	lastAdmit  time.Time     // 最近一次放行的时间（队列为空时为入队时间），用于估算放行间隔
+	admitEvery time.Duration // 平均每放行一人的间隔（指数移动平均）
+
+	onAdmit  func(entry *LoginQueueEntry)
+	onNotify func(entry *LoginQueueEntry, status LoginQueueStatus)
+	onExpire func(entry *LoginQueueEntry)
+}
+
+// NewLoginQueue 创建登录排队
+// 参数:
+//   - capacity: 获取当前在线人数与最大在线人数
+func NewLoginQueue(capacity LoginQueueCapacity) *LoginQueue {
+	return &LoginQueue{
+		capacity: capacity,
+	}
+}
+
+// SetHandlers 设置排队回调
+// 参数:
+//   - onAdmit: 排到后调用，由调用方完成登录
+//   - onNotify: 排队位置变化或到达推送间隔时调用
+//   - onExpire: 排队超时移出队列时调用
+func (q *LoginQueue) SetHandlers(onAdmit func(entry *LoginQueueEntry), onNotify func(entry *LoginQueueEntry, status LoginQueueStatus), onExpire func(entry *LoginQueueEntry)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.onAdmit = onAdmit
+	q.onNotify = onNotify
+	q.onExpire = onExpire
+}
+
+// MustWait 判断登录是否需要排队
+// 已有玩家排队时新登录同样排队（GM只需排在其他GM之后），以保证先到先放行
+// 参数:
+//   - isGM: 是否GM账号
+func (q *LoginQueue) MustWait(isGM bool) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.entries) > 0 && (!isGM || q.entries[0].IsGM) {
+		return true
+	}
+	online, limit := q.limitUnsafe(isGM)
+	return online >= limit
+}
+
+// Enqueue 加入排队
+// 参数:
+//   - entry: 排队条目（需填写会话、角色、VIP等级与是否GM）
+//   - now: 当前时间
+//
+// 返回:
+//   - LoginQueueStatus: 入队后的排队进度
+//   - error: 队列已满或会话已在排队
+func (q *LoginQueue) Enqueue(entry *LoginQueueEntry, now time.Time) (LoginQueueStatus, error) {
+	cfg := config.GetLoginQueueConfig()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.indexUnsafe(entry.SessionId) >= 0 {
+		return LoginQueueStatus{}, errLoginQueueDuplicate
+	}
+	if len(q.entries) >= cfg.MaxLength {
+		return LoginQueueStatus{}, errLoginQueueFull
+	}
+
+	if len(q.entries) == 0 {
+		q.lastAdmit = now
+	}
+	entry.EnqueuedAt = now
+	entry.rank = loginQueueRank(entry, parseVIPLanes(cfg.VIPLanes))
+
+	// 插入到同优先级通道的末尾
+	index := sort.Search(len(q.entries), func(i int) bool {
+		return q.entries[i].rank > entry.rank
+	})
+	q.entries = append(q.entries, nil)
+	copy(q.entries[index+1:], q.entries[index:])
+	q.entries[index] = entry
+
+	entry.position = index + 1
+	entry.lastNotify = now
+	return q.statusUnsafe(index), nil
+}
+
+// Remove 移除会话的排队（会话关闭或取消登录）
+// 返回: 会话是否在排队
+func (q *LoginQueue) Remove(sessionId zNet.SessionIdType) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	index := q.indexUnsafe(sessionId)
+	if index < 0 {
+		return false
+	}
+	q.entries = append(q.entries[:index], q.entries[index+1:]...)
+	return true
+}
+
+// ContainsPlayer 角色是否在排队登录
+func (q *LoginQueue) ContainsPlayer(playerId common.PlayerIdType) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, entry := range q.entries {
+		if entry.PlayerId == playerId {
+			return true
+		}
+	}
+	return false
+}
+
+// Len 获取排队人数
+func (q *LoginQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.entries)
+}
+
+// Tick 处理排队：移出超时条目，按空余名额放行，并推送排队进度
+// 参数:
+//   - now: 当前时间
+func (q *LoginQueue) Tick(now time.Time) {
+	cfg := config.GetLoginQueueConfig()
+	maxWait := time.Duration(cfg.MaxWait) * time.Second
+	notifyInterval := time.Duration(cfg.NotifyInterval) * time.Second
+
+	q.mu.Lock()
+
+	var expired, admitted []*LoginQueueEntry
+	remaining := q.entries[:0]
+	for _, entry := range q.entries {
+		if now.Sub(entry.EnqueuedAt) >= maxWait {
+			expired = append(expired, entry)
+		} else {
+			remaining = append(remaining, entry)
+		}
+	}
+	q.entries = remaining
+
+	// GM通道排在最前，队首无法放行时后面的条目同样无法放行
+	online, gmLimit := q.limitUnsafe(true)
+	_, limit := q.limitUnsafe(false)
+	for len(q.entries) > 0 {
+		entry := q.entries[0]
+		if online >= limit && (!entry.IsGM || online >= gmLimit) {
+			break
+		}
+		admitted = append(admitted, entry)
+		q.entries = q.entries[1:]
+		online++
+	}
+	if len(admitted) > 0 {
+		q.recordAdmitUnsafe(len(admitted), now)
+	}
+
+	type notification struct {
+		entry  *LoginQueueEntry
+		status LoginQueueStatus
+	}
+	var notifications []notification
+	for i, entry := range q.entries {
+		if entry.position == i+1 && now.Sub(entry.lastNotify) < notifyInterval {
+			continue
+		}
+		entry.position = i + 1
+		entry.lastNotify = now
+		notifications = append(notifications, notification{entry: entry, status: q.statusUnsafe(i)})
+	}
+
+	onAdmit, onNotify, onExpire := q.onAdmit, q.onNotify, q.onExpire
+	q.mu.Unlock()
+
+	if onExpire != nil {
+		for _, entry := range expired {
+			onExpire(entry)
+		}
+	}
+	if onAdmit != nil {
+		for _, entry := range admitted {
+			onAdmit(entry)
+		}
+	}
+	if onNotify != nil {
+		for _, n := range notifications {
+			onNotify(n.entry, n.status)
+		}
+	}
+}
+
+// recordAdmitUnsafe 按本次放行人数更新平均放行间隔
+// 注意: 调用前必须持有锁
+func (q *LoginQueue) recordAdmitUnsafe(count int, now time.Time) {
+	sample := now.Sub(q.lastAdmit) / time.Duration(count)
+	if q.admitEvery == 0 {
+		q.admitEvery = sample
+	} else {
+		q.admitEvery = (q.admitEvery*4 + sample) / 5
+	}
+	q.lastAdmit = now
+}
+
+// statusUnsafe 获取队列中第index个条目的排队进度
+// 注意: 调用前必须持有锁
+func (q *LoginQueue) statusUnsafe(index int) LoginQueueStatus {
+	return LoginQueueStatus{
+		Position: index + 1,
+		Length:   len(q.entries),
+		ETA:      q.admitEvery * time.Duration(index+1),
+	}
+}
+
+// limitUnsafe 获取当前在线人数与可登录的人数上限（非GM需扣除保留名额）
+// 注意: 调用前必须持有锁
+func (q *LoginQueue) limitUnsafe(isGM bool) (int64, int64) {
+	online, limit := q.capacity()
+	if !isGM {
+		limit -= int64(config.GetLoginQueueConfig().GMReservedSlots)
+	}
+	return online, limit
+}
+
+// indexUnsafe 获取会话在队列中的下标，不在队列中返回-1
+// 注意: 调用前必须持有锁
+func (q *LoginQueue) indexUnsafe(sessionId zNet.SessionIdType) int {
+	for i, entry := range q.entries {
+		if entry.SessionId == sessionId {
+			return i
+		}
+	}
+	return -1
+}
+
+// loginQueueRank 计算排队条目的通道优先级：GM为0，VIP通道依次为1..n，普通通道为n+1
+// 参数:
+//   - entry: 排队条目
+//   - vipLanes: VIP通道的最低VIP等级（由高到低）
+func loginQueueRank(entry *LoginQueueEntry, vipLanes []int) int {
+	if entry.IsGM {
+		return 0
+	}
+	for i, minLevel := range vipLanes {
+		if entry.VipLevel >= minLevel {
+			return i + 1
+		}
+	}
+	return len(vipLanes) + 1
+}
+
+// parseVIPLanes 解析VIP通道配置（逗号分隔的最低VIP等级），忽略无效值，按等级由高到低排列
+func parseVIPLanes(text string) []int {
+	var lanes []int
+	for _, field := range strings.Split(text, ",") {
+		level, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || level <= 0 {
+			continue
+		}
+		lanes = append(lanes, level)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(lanes)))
+	return lanes
+}
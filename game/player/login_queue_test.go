@@ -0,0 +1,111 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+)
+
+func TestLoginQueueLanesAndReservedSlots(t *testing.T) {
+	saved := config.GlobalConfig
+	config.GlobalConfig = &config.Config{LoginQueue: config.LoginQueueConfig{
+		Enabled:         true,
+		MaxLength:       4,
+		MaxWait:         60,
+		NotifyInterval:  10,
+		GMReservedSlots: 1,
+		VIPLanes:        "1,5",
+	}}
+	defer func() { config.GlobalConfig = saved }()
+
+	online, max := int64(9), int64(10)
+	q := NewLoginQueue(func() (int64, int64) { return online, max })
+
+	var admitted []zNet.SessionIdType
+	positions := make(map[zNet.SessionIdType]int)
+	q.SetHandlers(
+		func(entry *LoginQueueEntry) { admitted = append(admitted, entry.SessionId) },
+		func(entry *LoginQueueEntry, status LoginQueueStatus) { positions[entry.SessionId] = status.Position },
+		nil,
+	)
+
+	if !q.MustWait(false) || q.MustWait(true) {
+		t.Fatal("expected only non-GM logins to wait when only reserved slots are left")
+	}
+
+	now := time.Unix(1000, 0)
+	enqueue := func(sid zNet.SessionIdType, vip int, gm bool) LoginQueueStatus {
+		t.Helper()
+		status, err := q.Enqueue(&LoginQueueEntry{SessionId: sid, VipLevel: vip, IsGM: gm}, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return status
+	}
+	enqueue(1, 0, false)
+	enqueue(2, 3, false)
+	enqueue(3, 6, false)
+	if status := enqueue(4, 0, true); status.Position != 1 || status.Length != 4 {
+		t.Fatalf("expected GM at the front, got %+v", status)
+	}
+	if _, err := q.Enqueue(&LoginQueueEntry{SessionId: 5}, now); !IsLoginQueueFull(err) {
+		t.Fatalf("expected queue full, got %v", err)
+	}
+	if _, err := q.Enqueue(&LoginQueueEntry{SessionId: 1}, now); !IsLoginQueueDuplicate(err) {
+		t.Fatalf("expected duplicate session, got %v", err)
+	}
+
+	// 只剩保留名额时只放行GM；位置与上次推送不同的条目才推送（VIP6入队时即为第1位）
+	now = now.Add(time.Second)
+	q.Tick(now)
+	if len(admitted) != 1 || admitted[0] != 4 {
+		t.Fatalf("expected GM admitted first, got %v", admitted)
+	}
+	if _, notified := positions[3]; notified || positions[2] != 2 || positions[1] != 3 {
+		t.Fatalf("unexpected positions: %v", positions)
+	}
+
+	// 空出名额后按VIP通道放行
+	online = 7
+	now = now.Add(time.Second)
+	q.Tick(now)
+	if len(admitted) != 3 || admitted[1] != 3 || admitted[2] != 2 {
+		t.Fatalf("expected VIP lanes admitted in order, got %v", admitted)
+	}
+	if q.Len() != 1 || positions[1] != 1 {
+		t.Fatalf("expected normal lane to remain at the front, len=%d positions=%v", q.Len(), positions)
+	}
+}
+
+func TestLoginQueueTimeout(t *testing.T) {
+	saved := config.GlobalConfig
+	config.GlobalConfig = &config.Config{LoginQueue: config.LoginQueueConfig{
+		Enabled:        true,
+		MaxLength:      10,
+		MaxWait:        30,
+		NotifyInterval: 10,
+	}}
+	defer func() { config.GlobalConfig = saved }()
+
+	q := NewLoginQueue(func() (int64, int64) { return 10, 10 })
+	var expired []zNet.SessionIdType
+	q.SetHandlers(nil, nil, func(entry *LoginQueueEntry) { expired = append(expired, entry.SessionId) })
+
+	now := time.Unix(1000, 0)
+	for sid := zNet.SessionIdType(1); sid <= 2; sid++ {
+		if _, err := q.Enqueue(&LoginQueueEntry{SessionId: sid}, now); err != nil {
+			t.Fatal(err)
+		}
+		now = now.Add(10 * time.Second)
+	}
+	if !q.Remove(2) || q.Remove(2) {
+		t.Fatal("expected session to be removed once")
+	}
+
+	q.Tick(now.Add(10 * time.Second))
+	if len(expired) != 1 || expired[0] != 1 || q.Len() != 0 {
+		t.Fatalf("expected first entry to expire, got %v", expired)
+	}
+}
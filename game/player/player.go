@@ -1,6 +1,8 @@
 package player
 
 import (
+	"sync"
+
 	"github.com/pzqf/zEngine/zEvent"
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zNet"
@@ -26,9 +28,10 @@ const (
 // 继承自 LivingObject，是游戏中玩家的核心实体
 // 管理玩家的所有组件：基础信息、背包、装备、邮箱、任务、技能等
 type Player struct {
-	*object.LivingObject                        // 继承活体对象（包含生命、魔法、属性等）
-	playerId             common.PlayerIdType    // 玩家唯一ID
-	session              *zNet.TcpServerSession // 网络会话（用于与客户端通信）
+	*object.LivingObject                     // 继承活体对象（包含生命、魔法、属性等）
+	playerId             common.PlayerIdType // 玩家唯一ID
+	sessionMu            sync.RWMutex        // 保护session：玩家Actor断线与重连时切换会话，网络层与广播并发读取
+	session              zNet.Session        // 网络会话（用于与客户端通信）
 }
 
 // NewPlayer 创建新玩家对象
@@ -39,7 +42,7 @@ type Player struct {
 //
 // 返回:
 //   - *Player: 新创建的玩家对象
-func NewPlayer(playerId common.PlayerIdType, name string, session *zNet.TcpServerSession) *Player {
+func NewPlayer(playerId common.PlayerIdType, name string, session zNet.Session) *Player {
 	livingObj := object.NewLivingObject(common.ObjectIdType(playerId), name)
 	livingObj.SetType(gamecommon.GameObjectTypePlayer)
 
@@ -116,14 +119,18 @@ func (p *Player) SetName(name string) {
 	}
 }
 
-// GetSession 获取网络会话
-func (p *Player) GetSession() *zNet.TcpServerSession {
+// GetSession 获取网络会话（并发安全）
+func (p *Player) GetSession() zNet.Session {
+	p.sessionMu.RLock()
+	defer p.sessionMu.RUnlock()
 	return p.session
 }
 
-// SetSession 设置网络会话
-func (p *Player) SetSession(session *zNet.TcpServerSession) {
+// SetSession 设置网络会话（并发安全）
+func (p *Player) SetSession(session zNet.Session) {
+	p.sessionMu.Lock()
 	p.session = session
+	p.sessionMu.Unlock()
 	baseInfo := p.GetComponent("baseinfo")
 	if baseInfo != nil {
 		baseInfo.(*BaseInfo).SetSession(session)
@@ -149,7 +156,7 @@ func (p *Player) SetStatus(status PlayerStatus) {
 
 // IsOnline 检查玩家是否在线
 func (p *Player) IsOnline() bool {
-	return p.GetStatus() == PlayerStatusOnline && p.session != nil
+	return p.GetStatus() == PlayerStatusOnline && p.GetSession() != nil
 }
 
 // IsBusy 检查玩家是否忙碌
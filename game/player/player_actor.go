@@ -9,6 +9,7 @@ import (
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zNet"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
 	gamecommon "github.com/pzqf/zGameServer/game/common"
 	"go.uber.org/zap"
 )
@@ -21,17 +22,20 @@ const (
 type PlayerActor struct {
 	*zActor.BaseActor
 	Player  *Player
+	service *PlayerService // 所属玩家服务（用于跨玩家交互，如邮件投递）
+	replay  *replayBuffer  // 下行消息补发缓冲区（断线重连时补发）
 	stopCh  chan struct{}
 	running atomic.Bool
 }
 
-func NewPlayerActor(playerID common.PlayerIdType, name string, session *zNet.TcpServerSession) *PlayerActor {
+func NewPlayerActor(playerID common.PlayerIdType, name string, session zNet.Session) *PlayerActor {
 	baseActor := zActor.NewBaseActor(int64(playerID), PlayerActorMsgChanSize)
 	player := NewPlayer(playerID, name, session)
 
 	actor := &PlayerActor{
 		BaseActor: baseActor,
 		Player:    player,
+		replay:    newReplayBuffer(config.GetReconnectConfig().ReplayBufferSize),
 		stopCh:    make(chan struct{}),
 	}
 
@@ -61,8 +65,19 @@ func (pa *PlayerActor) ProcessMessage(msg zActor.ActorMessage) {
 		}
 	case *PlayerActorNetworkMessage:
 		if pa.Player != nil {
-			zLog.Info("Player received network packet",
-				zap.Int64("playerId", int64(pa.Player.GetPlayerId())))
+			pa.handleNetworkMessage(typedMsg.Packet, typedMsg.Protocol)
+		}
+	case *PlayerActorMailMessage:
+		if pa.Player != nil && pa.Player.GetMailbox() != nil {
+			pa.Player.GetMailbox().SendMail(typedMsg.Mail)
+		}
+	case *PlayerActorDetachMessage:
+		if pa.Player != nil {
+			pa.detachSession(typedMsg.SessionID)
+		}
+	case *PlayerActorResumeMessage:
+		if pa.Player != nil {
+			pa.resumeSession(typedMsg)
 		}
 	}
 }
@@ -0,0 +1,208 @@
+package player
+
+import (
+	"sort"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"go.uber.org/zap"
+)
+
+// playerMsgHandler 玩家网络消息处理函数
+// 在玩家Actor协程中执行，返回的响应以相同的消息ID回发给客户端（返回nil表示无需响应，返回protocol.ErrorCode表示失败）
+type playerMsgHandler func(pa *PlayerActor, proto protolayer.Protocol, packet *zNet.NetPacket) (interface{}, error)
+
+// playerMsgHandlers 玩家网络消息分发表（消息ID -> 处理函数）
+// 仅在init阶段注册，运行期只读
+var playerMsgHandlers = make(map[int32]playerMsgHandler)
+
+// registerPlayerMsgHandler 注册玩家网络消息处理函数
+// 按网络层配置的协议将消息体解码为请求类型T，处理函数只需关注业务逻辑
+// 参数:
+//   - msgId: 消息ID
+//   - handler: 处理函数
+func registerPlayerMsgHandler[T any](msgId protocol.PlayerMsgId, handler func(pa *PlayerActor, req *T) interface{}) {
+	if _, exists := playerMsgHandlers[int32(msgId)]; exists {
+		zLog.Warn("Player message handler already registered, overwriting", zap.Int32("msgId", int32(msgId)))
+	}
+
+	playerMsgHandlers[int32(msgId)] = func(pa *PlayerActor, proto protolayer.Protocol, packet *zNet.NetPacket) (interface{}, error) {
+		req := new(T)
+		if err := protolayer.DecodeMessage(proto, packet, req); err != nil {
+			return nil, err
+		}
+		return handler(pa, req), nil
+	}
+}
+
+// GetNetworkMsgIds 获取玩家Actor可处理的网络消息ID列表
+// 网络层据此注册转发路由，未注册的消息ID不会被转发给玩家Actor
+// 返回: 按升序排列的消息ID列表
+func GetNetworkMsgIds() []int32 {
+	msgIds := make([]int32, 0, len(playerMsgHandlers))
+	for msgId := range playerMsgHandlers {
+		msgIds = append(msgIds, msgId)
+	}
+	sort.Slice(msgIds, func(i, j int) bool { return msgIds[i] < msgIds[j] })
+	return msgIds
+}
+
+// ProtocolVersion 获取玩家当前会话协商的协议版本
+// 处理函数据此兼容仍在使用旧协议格式的客户端（断线保留期间没有会话，返回0）
+func (pa *PlayerActor) ProtocolVersion() int32 {
+	return protolayer.SessionProtocolVersion(pa.Player.GetSession())
+}
+
+// handleNetworkMessage 分发网络消息到对应的处理函数
+// 参数:
+//   - packet: 网络数据包
+//   - p: 消息编解码协议（为空时使用protobuf）
+func (pa *PlayerActor) handleNetworkMessage(packet *zNet.NetPacket, p protolayer.Protocol) {
+	if packet == nil {
+		return
+	}
+	if p == nil {
+		p = protolayer.NewProtobufProtocol()
+	}
+
+	playerId := int64(pa.Player.GetPlayerId())
+	handler, exists := playerMsgHandlers[packet.ProtoId]
+	if !exists {
+		zLog.Warn("No player message handler found",
+			zap.Int64("playerId", playerId),
+			zap.Int32("msgId", packet.ProtoId))
+		return
+	}
+
+	resp, err := handler(pa, p, packet)
+	if err != nil {
+		zLog.Error("Failed to decode player message",
+			zap.Int64("playerId", playerId),
+			zap.Int32("msgId", packet.ProtoId),
+			zap.Error(err))
+		resp = protocol.ErrorCode_ERR_INVALID_REQUEST
+	}
+	if resp == nil {
+		return
+	}
+
+	code := protocol.ErrorCode_ERR_OK
+	if errCode, ok := resp.(protocol.ErrorCode); ok {
+		resp, code = nil, errCode
+	}
+
+	data, err := protolayer.EncodeResponse(p, packet, code, resp)
+	if err != nil {
+		zLog.Error("Failed to marshal player message response",
+			zap.Int64("playerId", playerId),
+			zap.Int32("msgId", packet.ProtoId),
+			zap.Error(err))
+		return
+	}
+
+	if err := pa.sendPacket(packet.ProtoId, data); err != nil {
+		zLog.Error("Failed to send player message response",
+			zap.Int64("playerId", playerId),
+			zap.Int32("msgId", packet.ProtoId),
+			zap.Error(err))
+	}
+}
+
+// itemToInfo 将物品转换为协议物品信息
+func itemToInfo(item *Item, position int) *protocol.ItemInfo {
+	bindType := int32(0)
+	if item.bind {
+		bindType = 1
+	}
+	return &protocol.ItemInfo{
+		ItemId:      item.itemId,
+		ItemType:    int32(item.itemType),
+		ItemName:    item.itemName,
+		ItemCount:   item.count.Load(),
+		ItemLevel:   int32(item.levelReq),
+		ItemQuality: int32(item.quality),
+		BindType:    bindType,
+		Position:    int32(position),
+	}
+}
+
+// inventoryToInfos 将背包物品转换为协议物品信息列表（按槽位排序）
+func inventoryToInfos(inv *Inventory) []*protocol.ItemInfo {
+	var items []*protocol.ItemInfo
+	inv.RangeItems(func(slot int, item *Item) bool {
+		items = append(items, itemToInfo(item, slot))
+		return true
+	})
+	sort.Slice(items, func(i, j int) bool { return items[i].Position < items[j].Position })
+	return items
+}
+
+// mailToInfo 将邮件转换为协议邮件信息
+func mailToInfo(mail *Mail) *protocol.MailInfo {
+	info := &protocol.MailInfo{
+		MailId:       mail.mailId,
+		SenderId:     mail.senderId,
+		SenderName:   mail.senderName,
+		ReceiverId:   mail.receiverId,
+		ReceiverName: mail.receiverName,
+		Title:        mail.title,
+		Content:      mail.content,
+		Status:       int32(mail.status),
+		SendTime:     mail.sendTime,
+	}
+	if mail.attachments != nil {
+		mail.attachments.Range(func(key, value interface{}) bool {
+			info.Items = append(info.Items, &protocol.ItemInfo{
+				ItemId:    key.(int64),
+				ItemCount: int32(value.(int)),
+			})
+			return true
+		})
+	}
+	return info
+}
+
+// taskToInfo 将任务转换为协议任务信息
+func taskToInfo(task *Task) *protocol.TaskInfo {
+	info := &protocol.TaskInfo{
+		TaskId:       task.taskId,
+		TaskType:     int32(task.taskType),
+		TaskName:     task.title,
+		TaskDesc:     task.description,
+		Status:       int32(task.status),
+		AcceptTime:   task.acceptTime,
+		CompleteTime: task.completeTime,
+	}
+	for _, cond := range task.conditions {
+		info.Progress = append(info.Progress, int32(cond.progress))
+	}
+	for _, reward := range task.rewards {
+		if reward.rewardType == TaskRewardTypeItem {
+			info.Rewards = append(info.Rewards, &protocol.ItemInfo{
+				ItemId:    reward.itemId,
+				ItemCount: int32(reward.count),
+			})
+		}
+	}
+	return info
+}
+
+// skillToInfo 将技能转换为协议技能信息
+func skillToInfo(skill *Skill) *protocol.SkillInfo {
+	info := &protocol.SkillInfo{
+		SkillId:       skill.skillId,
+		SkillName:     skill.name,
+		SkillLevel:    int32(skill.level),
+		SkillType:     int32(skill.skillType),
+		SkillCoolTime: int64(skill.cooldown),
+	}
+	for _, effect := range skill.effects {
+		if effect.effectType == SkillEffectTypeDamage {
+			info.SkillDamage = int64(effect.value)
+			break
+		}
+	}
+	return info
+}
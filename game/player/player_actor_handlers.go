@@ -0,0 +1,675 @@
+package player
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/config/tables"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/naming"
+	"github.com/pzqf/zGameServer/game/sanction"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zUtil/zMap"
+	"go.uber.org/zap"
+)
+
+// 注册玩家Actor处理的网络消息
+// MSG_PLAYER_UPDATE_INFO、MSG_PLAYER_INVENTORY_ADD（服务器推送）、MSG_PLAYER_EQUIPMENT_UPGRADE 暂未实现
+func init() {
+	// 基础信息
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_GET_INFO, handleGetInfo)
+
+	// 背包
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_GET, handleInventoryGet)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_REMOVE, handleInventoryRemove)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_USE, handleInventoryUse)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_SORT, handleInventorySort)
+
+	// 装备
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_GET, handleEquipmentGet)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_EQUIP, handleEquipmentEquip)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_UNEQUIP, handleEquipmentUnequip)
+
+	// 邮件
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_MAIL_GET_LIST, handleMailGetList)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_MAIL_GET_DETAIL, handleMailGetDetail)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_MAIL_SEND, handleMailSend)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_MAIL_DELETE, handleMailDelete)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_MAIL_RECEIVE, handleMailReceive)
+
+	// 任务
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_TASK_GET_LIST, handleTaskGetList)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_TASK_GET_DETAIL, handleTaskGetDetail)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_TASK_ACCEPT, handleTaskAccept)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_TASK_SUBMIT, handleTaskSubmit)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_TASK_CANCEL, handleTaskCancel)
+
+	// 技能
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_SKILL_GET_LIST, handleSkillGetList)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_SKILL_LEARN, handleSkillLearn)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_SKILL_UPGRADE, handleSkillUpgrade)
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_SKILL_USE, handleSkillUse)
+
+	// 改名
+	registerPlayerMsgHandler(protocol.PlayerMsgId_MSG_PLAYER_RENAME, handleRename)
+}
+
+// handleGetInfo 获取玩家基础信息（player_id为0时返回自身信息）
+func handleGetInfo(pa *PlayerActor, req *protocol.PlayerGetInfoRequest) interface{} {
+	target := pa.Player
+	if req.PlayerId != 0 && req.PlayerId != int64(pa.Player.GetPlayerId()) {
+		if pa.service != nil {
+			target = pa.service.GetPlayer(common.PlayerIdType(req.PlayerId))
+		} else {
+			target = nil
+		}
+	}
+	if target == nil {
+		return protocol.ErrorCode_ERR_PLAYER_OFFLINE
+	}
+
+	info := &protocol.PlayerBasicInfo{
+		PlayerId:   int64(target.GetPlayerId()),
+		Name:       target.GetName(),
+		Level:      int32(target.GetLevel()),
+		Exp:        target.GetExp(),
+		Gold:       target.GetGold(),
+		VipLevel:   int32(target.GetVIPLevel()),
+		CreateTime: target.GetCreateTime(),
+	}
+	if baseInfo := target.GetBaseInfo(); baseInfo != nil {
+		info.ServerId = int32(baseInfo.GetServerId())
+	}
+
+	return &protocol.PlayerGetInfoResponse{Success: true, PlayerInfo: info}
+}
+
+// handleRename 使用改名卡修改角色名
+// 新名称占用成功并写入数据库后才消耗改名卡，任一步骤失败时恢复原名称的占用与数据库记录
+func handleRename(pa *PlayerActor, req *protocol.PlayerRenameRequest) interface{} {
+	cardItem := config.GetNamingConfig().RenameCardItem
+	if cardItem == 0 {
+		return protocol.ErrorCode_ERR_NOT_IMPLEMENTED
+	}
+	inv := pa.Player.GetInventory()
+	if inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	if item, exists := inv.GetItem(int(req.Position)); !exists || item.itemId != int64(cardItem) {
+		return protocol.ErrorCode_ERR_RENAME_CARD_REQUIRED
+	}
+
+	playerId := int64(pa.Player.GetPlayerId())
+	oldName := pa.Player.GetName()
+	if req.Name == oldName {
+		return protocol.ErrorCode_ERR_NAME_TAKEN
+	}
+	if err := naming.GetService().Rename(models.NameKindPlayer, playerId, oldName, req.Name); err != nil {
+		if code := naming.ErrorCode(err); code != protocol.ErrorCode_ERR_SERVER {
+			return code
+		}
+		zLog.Error("Failed to reserve player name", zap.Int64("playerId", playerId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	if err := updatePlayerName(playerId, req.Name); err != nil {
+		zLog.Error("Failed to save player name", zap.Int64("playerId", playerId), zap.Error(err))
+		restorePlayerName(playerId, req.Name, oldName, false)
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	if err := inv.RemoveItem(int(req.Position), 1); err != nil {
+		zLog.Error("Failed to consume rename card", zap.Int64("playerId", playerId), zap.Error(err))
+		restorePlayerName(playerId, req.Name, oldName, true)
+		return protocol.ErrorCode_ERR_RENAME_CARD_REQUIRED
+	}
+	pa.Player.SetName(req.Name)
+
+	zLog.Info("Player renamed", zap.Int64("playerId", playerId), zap.String("oldName", oldName), zap.String("newName", req.Name))
+	return &protocol.PlayerRenameResponse{Success: true, Name: req.Name}
+}
+
+// restorePlayerName 改名失败时恢复原名称
+// 参数:
+//   - playerId: 角色ID
+//   - newName: 已占用的新名称
+//   - oldName: 要恢复的原名称
+//   - saved: 新名称是否已写入数据库
+func restorePlayerName(playerId int64, newName, oldName string, saved bool) {
+	if saved {
+		if err := updatePlayerName(playerId, oldName); err != nil {
+			zLog.Error("Failed to restore saved player name", zap.Int64("playerId", playerId), zap.String("name", oldName), zap.Error(err))
+		}
+	}
+	if err := naming.GetService().Rename(models.NameKindPlayer, playerId, newName, oldName); err != nil {
+		zLog.Error("Failed to restore player name", zap.Int64("playerId", playerId), zap.String("name", oldName), zap.Error(err))
+	}
+}
+
+// updatePlayerName 将角色名写入数据库
+func updatePlayerName(playerId int64, name string) error {
+	pl, err := db.GetMgr().PlayerRepository.GetByID(playerId)
+	if err != nil {
+		return err
+	}
+	if pl == nil {
+		return errPlayerNotFound
+	}
+
+	// 数据仓库缓存的是共享对象，修改副本后再写回
+	updated := *pl
+	updated.PlayerName = name
+	updated.UpdatedAt = time.Now()
+	if _, err := db.GetMgr().PlayerRepository.Update(&updated); err != nil {
+		return err
+	}
+	return nil
+}
+
+// handleInventoryGet 获取背包物品列表
+func handleInventoryGet(pa *PlayerActor, req *protocol.InventoryGetRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	if inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.InventoryGetResponse{
+		Success: true,
+		Size:    int32(inv.GetSize()),
+		Items:   inventoryToInfos(inv),
+	}
+}
+
+// handleInventoryRemove 丢弃背包物品
+func handleInventoryRemove(pa *PlayerActor, req *protocol.InventoryRemoveRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	if inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	if req.Count <= 0 {
+		return protocol.ErrorCode_ERR_ITEM_COUNT_INVALID
+	}
+	if _, exists := inv.GetItem(int(req.Position)); !exists {
+		return protocol.ErrorCode_ERR_ITEM_NOT_FOUND
+	}
+
+	if err := inv.RemoveItem(int(req.Position), int(req.Count)); err != nil {
+		zLog.Error("Failed to remove item", zap.Int64("playerId", int64(pa.Player.GetPlayerId())), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.InventoryRemoveResponse{Success: true}
+}
+
+// handleInventoryUse 使用背包物品
+func handleInventoryUse(pa *PlayerActor, req *protocol.InventoryUseRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	if inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	if _, exists := inv.GetItem(int(req.Position)); !exists {
+		return protocol.ErrorCode_ERR_ITEM_NOT_FOUND
+	}
+
+	if !inv.UseItem(int(req.Position), pa.Player.GetLevel()) {
+		return protocol.ErrorCode_ERR_LEVEL_TOO_LOW
+	}
+	return &protocol.InventoryUseResponse{Success: true}
+}
+
+// handleInventorySort 整理背包
+func handleInventorySort(pa *PlayerActor, req *protocol.InventorySortRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	if inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	inv.Sort()
+	return &protocol.InventorySortResponse{Success: true, Items: inventoryToInfos(inv)}
+}
+
+// handleEquipmentGet 获取已穿戴装备列表
+func handleEquipmentGet(pa *PlayerActor, req *protocol.EquipmentGetRequest) interface{} {
+	eq := pa.Player.GetEquipment()
+	if eq == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	var equipments []*protocol.ItemInfo
+	eq.GetAllEquipments().Range(func(pos EquipPosType, item *Item) bool {
+		equipments = append(equipments, itemToInfo(item, int(pos)))
+		return true
+	})
+	sort.Slice(equipments, func(i, j int) bool { return equipments[i].Position < equipments[j].Position })
+
+	return &protocol.EquipmentGetResponse{Success: true, Equipments: equipments}
+}
+
+// handleEquipmentEquip 从背包穿戴装备，替换下的旧装备放回背包
+func handleEquipmentEquip(pa *PlayerActor, req *protocol.EquipmentEquipRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	eq := pa.Player.GetEquipment()
+	if inv == nil || eq == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	equipPos := EquipPosType(req.EquipPos)
+	if !eq.IsValidEquipPos(equipPos) {
+		return protocol.ErrorCode_ERR_EQUIP_SLOT_INVALID
+	}
+	item, exists := inv.GetItem(int(req.Position))
+	if !exists {
+		return protocol.ErrorCode_ERR_ITEM_NOT_FOUND
+	}
+	if !eq.CanEquip(item, equipPos) {
+		return protocol.ErrorCode_ERR_EQUIP_SLOT_MISMATCH
+	}
+	if pa.Player.GetLevel() < item.levelReq {
+		return protocol.ErrorCode_ERR_LEVEL_TOO_LOW
+	}
+
+	// 先从背包取出，保证替换下的旧装备有空位放回
+	if err := inv.RemoveItem(int(req.Position), int(item.count.Load())); err != nil {
+		zLog.Error("Failed to remove item for equip", zap.Int64("playerId", int64(pa.Player.GetPlayerId())), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	oldItem, err := eq.Equip(equipPos, item)
+	if err != nil {
+		zLog.Error("Failed to equip item", zap.Int64("playerId", int64(pa.Player.GetPlayerId())), zap.Error(err))
+		inv.AddItem(item)
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	if oldItem != nil {
+		inv.AddItem(oldItem)
+	}
+
+	return &protocol.EquipmentEquipResponse{Success: true, Equipment: itemToInfo(item, int(equipPos))}
+}
+
+// handleEquipmentUnequip 卸下装备放回背包
+func handleEquipmentUnequip(pa *PlayerActor, req *protocol.EquipmentUnequipRequest) interface{} {
+	inv := pa.Player.GetInventory()
+	eq := pa.Player.GetEquipment()
+	if inv == nil || eq == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	equipPos := EquipPosType(req.EquipPos)
+	if _, exists := eq.GetEquipment(equipPos); !exists {
+		return protocol.ErrorCode_ERR_EQUIP_SLOT_EMPTY
+	}
+	if !inv.HasSpace(1, 1) {
+		return protocol.ErrorCode_ERR_INVENTORY_FULL
+	}
+
+	item, err := eq.Unequip(equipPos)
+	if err != nil || item == nil {
+		zLog.Error("Failed to unequip item", zap.Int64("playerId", int64(pa.Player.GetPlayerId())), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	slot, err := inv.AddItem(item)
+	if err != nil || slot == 0 {
+		// 放回失败时恢复装备，避免物品丢失
+		eq.Equip(equipPos, item)
+		return protocol.ErrorCode_ERR_INVENTORY_FULL
+	}
+
+	return &protocol.EquipmentUnequipResponse{Success: true, Position: int32(slot)}
+}
+
+// handleMailGetList 获取邮件列表（按发送时间倒序）
+func handleMailGetList(pa *PlayerActor, req *protocol.MailGetListRequest) interface{} {
+	mailbox := pa.Player.GetMailbox()
+	if mailbox == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	mails := mailbox.GetAllMails()
+	sort.Slice(mails, func(i, j int) bool { return mails[i].sendTime > mails[j].sendTime })
+
+	resp := &protocol.MailGetListResponse{Success: true}
+	for _, mail := range mails {
+		resp.Mails = append(resp.Mails, mailToInfo(mail))
+	}
+	return resp
+}
+
+// handleMailGetDetail 获取邮件详情（同时标记为已读）
+func handleMailGetDetail(pa *PlayerActor, req *protocol.MailGetDetailRequest) interface{} {
+	mailbox := pa.Player.GetMailbox()
+	if mailbox == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	mail, exists := mailbox.GetMail(req.MailId)
+	if !exists {
+		return protocol.ErrorCode_ERR_MAIL_NOT_FOUND
+	}
+	return &protocol.MailGetDetailResponse{Success: true, Mail: mailToInfo(mail)}
+}
+
+// handleMailSend 发送邮件给在线玩家
+// 邮件投递到收件人的Actor中执行，避免跨协程修改收件人邮箱
+func handleMailSend(pa *PlayerActor, req *protocol.MailSendRequest) interface{} {
+	if req.Title == "" {
+		return protocol.ErrorCode_ERR_MAIL_TITLE_EMPTY
+	}
+	if sanction.GetManager().FindByPlayer(int64(pa.Player.GetPlayerId()), models.SanctionTypeChatMute, time.Now()) != nil {
+		return protocol.ErrorCode_ERR_PLAYER_MUTED
+	}
+	if pa.service == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	receiverActor := pa.service.GetPlayerActor(common.PlayerIdType(req.ReceiverId))
+	if receiverActor == nil || receiverActor.Player == nil {
+		return protocol.ErrorCode_ERR_MAIL_RECEIVER_OFFLINE
+	}
+
+	mailId, err := common.GenerateMailID()
+	if err != nil {
+		zLog.Error("Failed to generate mail ID", zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	mail := &Mail{
+		mailId:       int64(mailId),
+		senderId:     int64(pa.Player.GetPlayerId()),
+		senderName:   pa.Player.GetName(),
+		receiverId:   req.ReceiverId,
+		receiverName: receiverActor.Player.GetName(),
+		title:        req.Title,
+		content:      req.Content,
+		attachments:  zMap.NewMap(),
+		sendTime:     time.Now().Unix(),
+		status:       MailStatusUnread,
+	}
+	receiverActor.SendMessage(NewPlayerActorMailMessage(req.ReceiverId, mail))
+
+	return &protocol.MailSendResponse{Success: true, MailId: int64(mailId)}
+}
+
+// handleMailDelete 删除邮件
+func handleMailDelete(pa *PlayerActor, req *protocol.MailDeleteRequest) interface{} {
+	mailbox := pa.Player.GetMailbox()
+	if mailbox == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	if err := mailbox.DeleteMail(req.MailId); err != nil {
+		zLog.Error("Failed to delete mail", zap.Int64("mailId", req.MailId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.MailDeleteResponse{Success: true}
+}
+
+// handleMailReceive 领取邮件附件到背包
+func handleMailReceive(pa *PlayerActor, req *protocol.MailReceiveRequest) interface{} {
+	mailbox := pa.Player.GetMailbox()
+	inv := pa.Player.GetInventory()
+	if mailbox == nil || inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	mail, exists := mailbox.GetMail(req.MailId)
+	if !exists {
+		return protocol.ErrorCode_ERR_MAIL_NOT_FOUND
+	}
+	if mail.attachments == nil || mail.attachments.Len() == 0 {
+		return protocol.ErrorCode_ERR_MAIL_NO_ATTACHMENT
+	}
+	if !inv.HasSpace(int(mail.attachments.Len()), 1) {
+		return protocol.ErrorCode_ERR_INVENTORY_FULL
+	}
+
+	attachments, err := mailbox.ClaimAttachments(req.MailId)
+	if err != nil || attachments == nil {
+		zLog.Error("Failed to claim mail attachments", zap.Int64("mailId", req.MailId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	resp := &protocol.MailReceiveResponse{Success: true}
+	attachments.Range(func(key, value interface{}) bool {
+		itemId, count := key.(int64), value.(int)
+		item := NewItemFromConfig(itemId, count)
+		if item == nil {
+			zLog.Warn("Mail attachment item config not found", zap.Int64("mailId", req.MailId), zap.Int64("itemId", itemId))
+			return true
+		}
+		if slot, _ := inv.AddItem(item); slot != 0 {
+			resp.Items = append(resp.Items, itemToInfo(item, slot))
+		}
+		return true
+	})
+	return resp
+}
+
+// handleTaskGetList 获取任务列表
+func handleTaskGetList(pa *PlayerActor, req *protocol.TaskGetListRequest) interface{} {
+	tm := pa.Player.GetTaskManager()
+	if tm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	tasks := tm.GetAllTasks()
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].taskId < tasks[j].taskId })
+
+	resp := &protocol.TaskGetListResponse{Success: true}
+	for _, task := range tasks {
+		resp.Tasks = append(resp.Tasks, taskToInfo(task))
+	}
+	return resp
+}
+
+// handleTaskGetDetail 获取任务详情
+func handleTaskGetDetail(pa *PlayerActor, req *protocol.TaskGetDetailRequest) interface{} {
+	tm := pa.Player.GetTaskManager()
+	if tm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	task, exists := tm.GetTask(req.TaskId)
+	if !exists {
+		return protocol.ErrorCode_ERR_TASK_NOT_FOUND
+	}
+	return &protocol.TaskGetDetailResponse{Success: true, Task: taskToInfo(task)}
+}
+
+// handleTaskAccept 接受任务
+func handleTaskAccept(pa *PlayerActor, req *protocol.TaskAcceptRequest) interface{} {
+	tm := pa.Player.GetTaskManager()
+	if tm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	quest := tables.GetQuestByID(int32(req.TaskId))
+	if quest == nil {
+		return protocol.ErrorCode_ERR_TASK_NOT_FOUND
+	}
+	if _, exists := tm.GetTask(req.TaskId); exists {
+		return protocol.ErrorCode_ERR_TASK_ACCEPTED
+	}
+	if len(tm.GetAllTasks()) >= tm.maxCount {
+		return protocol.ErrorCode_ERR_TASK_LIMIT
+	}
+	if pa.Player.GetLevel() < int(quest.Level) {
+		return protocol.ErrorCode_ERR_LEVEL_TOO_LOW
+	}
+
+	task, err := NewTaskFromConfig(quest)
+	if err != nil {
+		zLog.Error("Failed to create task from config", zap.Int64("taskId", req.TaskId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	task.acceptTime = time.Now().Unix()
+
+	if err := tm.AcceptTask(task); err != nil {
+		zLog.Error("Failed to accept task", zap.Int64("taskId", req.TaskId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.TaskAcceptResponse{Success: true, Task: taskToInfo(task)}
+}
+
+// handleTaskSubmit 提交已完成的任务并发放奖励
+func handleTaskSubmit(pa *PlayerActor, req *protocol.TaskSubmitRequest) interface{} {
+	tm := pa.Player.GetTaskManager()
+	inv := pa.Player.GetInventory()
+	if tm == nil || inv == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	task, exists := tm.GetTask(req.TaskId)
+	if !exists {
+		return protocol.ErrorCode_ERR_TASK_NOT_FOUND
+	}
+	if task.status != TaskStatusCompleted {
+		return protocol.ErrorCode_ERR_TASK_NOT_COMPLETED
+	}
+
+	rewards, err := tm.CompleteTask(req.TaskId)
+	if err != nil {
+		zLog.Error("Failed to complete task", zap.Int64("taskId", req.TaskId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	resp := &protocol.TaskSubmitResponse{Success: true}
+	for _, reward := range rewards {
+		switch reward.rewardType {
+		case TaskRewardTypeCoin:
+			pa.Player.AddGold(reward.value)
+			resp.Gold += reward.value
+		case TaskRewardTypeExp:
+			pa.Player.AddExp(reward.value)
+			resp.Exp += reward.value
+		case TaskRewardTypeItem:
+			item := NewItemFromConfig(reward.itemId, reward.count)
+			if item == nil {
+				zLog.Warn("Task reward item config not found", zap.Int64("taskId", req.TaskId), zap.Int64("itemId", reward.itemId))
+				continue
+			}
+			if slot, _ := inv.AddItem(item); slot != 0 {
+				resp.Items = append(resp.Items, itemToInfo(item, slot))
+			}
+		}
+	}
+	return resp
+}
+
+// handleTaskCancel 放弃进行中的任务
+func handleTaskCancel(pa *PlayerActor, req *protocol.TaskCancelRequest) interface{} {
+	tm := pa.Player.GetTaskManager()
+	if tm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	if !tm.AbandonTask(req.TaskId) {
+		return protocol.ErrorCode_ERR_TASK_CANNOT_CANCEL
+	}
+	return &protocol.TaskCancelResponse{Success: true}
+}
+
+// handleSkillGetList 获取技能列表
+func handleSkillGetList(pa *PlayerActor, req *protocol.SkillGetListRequest) interface{} {
+	sm := pa.Player.GetSkillManager()
+	if sm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	skills := sm.GetAllSkills()
+	sort.Slice(skills, func(i, j int) bool { return skills[i].skillId < skills[j].skillId })
+
+	resp := &protocol.SkillGetListResponse{Success: true}
+	for _, skill := range skills {
+		resp.Skills = append(resp.Skills, skillToInfo(skill))
+	}
+	return resp
+}
+
+// handleSkillLearn 学习技能
+func handleSkillLearn(pa *PlayerActor, req *protocol.SkillLearnRequest) interface{} {
+	sm := pa.Player.GetSkillManager()
+	if sm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	skillConfig := tables.GetSkillByID(int32(req.SkillId))
+	if skillConfig == nil {
+		return protocol.ErrorCode_ERR_SKILL_NOT_FOUND
+	}
+	if _, exists := sm.GetSkill(req.SkillId); exists {
+		return protocol.ErrorCode_ERR_SKILL_LEARNED
+	}
+	if len(sm.GetAllSkills()) >= sm.maxCount {
+		return protocol.ErrorCode_ERR_SKILL_LIMIT
+	}
+	if pa.Player.GetLevel() < int(skillConfig.RequiredLevel) {
+		return protocol.ErrorCode_ERR_LEVEL_TOO_LOW
+	}
+
+	if err := sm.LearnSkill(req.SkillId); err != nil {
+		zLog.Error("Failed to learn skill", zap.Int64("skillId", req.SkillId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	skill, exists := sm.GetSkill(req.SkillId)
+	if !exists {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.SkillLearnResponse{Success: true, Skill: skillToInfo(skill)}
+}
+
+// handleSkillUpgrade 升级技能
+func handleSkillUpgrade(pa *PlayerActor, req *protocol.SkillUpgradeRequest) interface{} {
+	sm := pa.Player.GetSkillManager()
+	if sm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	skill, exists := sm.GetSkill(req.SkillId)
+	if !exists {
+		return protocol.ErrorCode_ERR_SKILL_NOT_FOUND
+	}
+	if skill.status == SkillStatusLocked {
+		return protocol.ErrorCode_ERR_SKILL_LOCKED
+	}
+	if !sm.CanUpgradeSkill(skill) {
+		return protocol.ErrorCode_ERR_SKILL_UPGRADE_CONDITION
+	}
+
+	if err := sm.UpgradeSkill(req.SkillId); err != nil {
+		zLog.Error("Failed to upgrade skill", zap.Int64("skillId", req.SkillId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.SkillUpgradeResponse{Success: true, Skill: skillToInfo(skill)}
+}
+
+// handleSkillUse 使用技能
+func handleSkillUse(pa *PlayerActor, req *protocol.SkillUseRequest) interface{} {
+	sm := pa.Player.GetSkillManager()
+	if sm == nil {
+		return protocol.ErrorCode_ERR_SERVER
+	}
+
+	skill, exists := sm.GetSkill(req.SkillId)
+	if !exists {
+		return protocol.ErrorCode_ERR_SKILL_NOT_FOUND
+	}
+	if skill.status == SkillStatusLocked {
+		return protocol.ErrorCode_ERR_SKILL_LOCKED
+	}
+	if skill.skillType == SkillTypePassive {
+		return protocol.ErrorCode_ERR_SKILL_PASSIVE
+	}
+	if time.Now().UnixMilli()-skill.lastUseTime < int64(skill.cooldown) {
+		return protocol.ErrorCode_ERR_SKILL_COOLDOWN
+	}
+
+	if err := sm.UseSkill(req.SkillId, req.TargetId); err != nil {
+		zLog.Error("Failed to use skill", zap.Int64("skillId", req.SkillId), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	return &protocol.SkillUseResponse{Success: true, SkillId: req.SkillId, TargetId: req.TargetId}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/pzqf/zEngine/zActor"
 	"github.com/pzqf/zEngine/zNet"
 	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/net/protolayer"
 )
 
 // PlayerActorMessage 通用玩家消息
@@ -165,12 +166,62 @@ func NewPlayerActorUpdateMessage(actorID int64, deltaTime float32) *PlayerActorU
 // PlayerActorNetworkMessage 网络消息
 type PlayerActorNetworkMessage struct {
 	zActor.BaseActorMessage
-	Packet *zNet.NetPacket
+	Packet   *zNet.NetPacket
+	Protocol protolayer.Protocol // 消息编解码协议
 }
 
-func NewPlayerActorNetworkMessage(actorID int64, packet *zNet.NetPacket) *PlayerActorNetworkMessage {
+func NewPlayerActorNetworkMessage(actorID int64, packet *zNet.NetPacket, protocol protolayer.Protocol) *PlayerActorNetworkMessage {
 	return &PlayerActorNetworkMessage{
 		BaseActorMessage: zActor.BaseActorMessage{ActorID: actorID},
 		Packet:           packet,
+		Protocol:         protocol,
+	}
+}
+
+// PlayerActorMailMessage 邮件投递消息
+type PlayerActorMailMessage struct {
+	zActor.BaseActorMessage
+	Mail *Mail
+}
+
+func NewPlayerActorMailMessage(actorID int64, mail *Mail) *PlayerActorMailMessage {
+	return &PlayerActorMailMessage{
+		BaseActorMessage: zActor.BaseActorMessage{ActorID: actorID},
+		Mail:             mail,
+	}
+}
+
+// PlayerActorDetachMessage 会话断开消息（进入断线保留期）
+type PlayerActorDetachMessage struct {
+	zActor.BaseActorMessage
+	SessionID zNet.SessionIdType
+}
+
+func NewPlayerActorDetachMessage(actorID int64, sessionID zNet.SessionIdType) *PlayerActorDetachMessage {
+	return &PlayerActorDetachMessage{
+		BaseActorMessage: zActor.BaseActorMessage{ActorID: actorID},
+		SessionID:        sessionID,
+	}
+}
+
+// PlayerActorResumeMessage 断线重连消息
+// 玩家Actor切换到新会话后，回复重连请求并补发LastRecvSeq之后的下行消息
+type PlayerActorResumeMessage struct {
+	zActor.BaseActorMessage
+	Session     zNet.Session
+	Packet      *zNet.NetPacket // 重连请求数据包
+	Protocol    protolayer.Protocol
+	ResumeToken string
+	LastRecvSeq uint64
+}
+
+func NewPlayerActorResumeMessage(actorID int64, session zNet.Session, packet *zNet.NetPacket, protocol protolayer.Protocol, resumeToken string, lastRecvSeq uint64) *PlayerActorResumeMessage {
+	return &PlayerActorResumeMessage{
+		BaseActorMessage: zActor.BaseActorMessage{ActorID: actorID},
+		Session:          session,
+		Packet:           packet,
+		Protocol:         protocol,
+		ResumeToken:      resumeToken,
+		LastRecvSeq:      lastRecvSeq,
 	}
 }
@@ -1,6 +1,7 @@
 package player
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -15,7 +16,8 @@ import (
 type BaseInfo struct {
 	*component.BaseComponent      // 继承基础组件
 	name       string             // 玩家名称
-	session    *zNet.TcpServerSession // 网络会话
+	sessionMu  sync.RWMutex       // 保护session（断线与重连时切换会话）
+	session    zNet.Session       // 网络会话
 	status     atomic.Int32       // 玩家状态（原子操作）
 	exp        atomic.Int64       // 经验值（原子操作）
 	gold       atomic.Int64       // 金币（原子操作）
@@ -32,7 +34,7 @@ type BaseInfo struct {
 //
 // 返回:
 //   - *BaseInfo: 新创建的组件
-func NewBaseInfo(name string, session *zNet.TcpServerSession) *BaseInfo {
+func NewBaseInfo(name string, session zNet.Session) *BaseInfo {
 	return &BaseInfo{
 		BaseComponent: component.NewBaseComponent("baseinfo"),
 		name:          name,
@@ -71,13 +73,17 @@ func (b *BaseInfo) SetServerId(serverId int) {
 	b.serverId = serverId
 }
 
-// GetSession 获取网络会话
-func (b *BaseInfo) GetSession() *zNet.TcpServerSession {
+// GetSession 获取网络会话（并发安全）
+func (b *BaseInfo) GetSession() zNet.Session {
+	b.sessionMu.RLock()
+	defer b.sessionMu.RUnlock()
 	return b.session
 }
 
-// SetSession 设置网络会话
-func (b *BaseInfo) SetSession(session *zNet.TcpServerSession) {
+// SetSession 设置网络会话（并发安全）
+func (b *BaseInfo) SetSession(session zNet.Session) {
+	b.sessionMu.Lock()
+	defer b.sessionMu.Unlock()
 	b.session = session
 }
 
@@ -95,7 +101,7 @@ func (b *BaseInfo) SetStatus(status PlayerStatus) {
 // IsOnline 检查玩家是否在线
 // 返回: true表示在线
 func (b *BaseInfo) IsOnline() bool {
-	return b.GetStatus() == PlayerStatusOnline && b.session != nil
+	return b.GetStatus() == PlayerStatusOnline && b.GetSession() != nil
 }
 
 // IsBusy 检查玩家是否忙碌
@@ -212,11 +218,12 @@ func (b *BaseInfo) GetCreateTime() int64 {
 // 返回:
 //   - error: 发送错误
 func (b *BaseInfo) SendPacket(packetId int32, data []byte) error {
-	if b.session == nil {
+	session := b.GetSession()
+	if session == nil {
 		zLog.Warn("Player session is nil")
 		return nil
 	}
-	return b.session.Send(packetId, data)
+	return session.Send(packetId, data)
 }
 
 // SendText 发送文本消息
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config/tables"
 	"github.com/pzqf/zGameServer/event"
 	"github.com/pzqf/zGameServer/game/object/component"
 	"github.com/pzqf/zUtil/zMap"
@@ -62,6 +63,26 @@ func NewItem(itemId int64, itemType int, itemName string, count int, maxStack in
 	return item
 }
 
+// NewItemFromConfig 根据物品配置表创建物品
+// 参数:
+//   - itemId: 物品ID
+//   - count: 数量
+//
+// 返回:
+//   - *Item: 新创建的物品（配置不存在时返回nil）
+func NewItemFromConfig(itemId int64, count int) *Item {
+	itemConfig := tables.GetItemByID(int32(itemId))
+	if itemConfig == nil {
+		return nil
+	}
+
+	maxStack := int(itemConfig.StackLimit)
+	if maxStack <= 0 {
+		maxStack = 1
+	}
+	return NewItem(itemId, int(itemConfig.Type), itemConfig.Name, count, maxStack, false, int(itemConfig.Quality), int(itemConfig.Level))
+}
+
 // NewInventory 创建背包组件
 // 参数:
 //   - playerId: 玩家ID
@@ -226,6 +247,20 @@ func (inv *Inventory) GetAllItems() []*Item {
 	return items
 }
 
+// RangeItems 遍历背包中所有物品
+// 参数:
+//   - f: 遍历函数（返回false时停止遍历）
+func (inv *Inventory) RangeItems(f func(slot int, item *Item) bool) {
+	inv.items.Range(func(key, value interface{}) bool {
+		return f(key.(int), value.(*Item))
+	})
+}
+
+// GetSize 获取背包容量
+func (inv *Inventory) GetSize() int {
+	return inv.size
+}
+
 // Expand 扩展背包
 // 参数:
 //   - size: 新的背包大小
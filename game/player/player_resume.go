@@ -0,0 +1,307 @@
+package player
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"go.uber.org/zap"
+)
+
+// resumeTokenSize 重连令牌随机字节数
+const resumeTokenSize = 32
+
+// replayPacket 待补发的下行消息
+type replayPacket struct {
+	seq     uint64
+	protoId int32
+	data    []byte
+}
+
+// replayBuffer 玩家下行消息环形缓冲区
+// 为每条经玩家Actor下发的消息分配递增序号（从1开始），断线重连时补发客户端未收到的消息
+// 只有玩家Actor处理的请求的响应经过缓冲区；广播与网络层直接回复的消息不占用序号，断线期间丢失后不补发，
+// 客户端计算LastRecvSeq时也不计入这些消息
+// 注意: 仅在玩家Actor协程中访问
+type replayBuffer struct {
+	packets []replayPacket
+	seq     uint64 // 最近一条消息的序号
+}
+
+// newReplayBuffer 创建下行消息缓冲区
+// 参数:
+//   - size: 最多保留的消息数量
+func newReplayBuffer(size int) *replayBuffer {
+	if size <= 0 {
+		size = 1
+	}
+	return &replayBuffer{
+		packets: make([]replayPacket, size),
+	}
+}
+
+// push 记录一条下行消息
+// 返回: 分配的消息序号
+func (rb *replayBuffer) push(protoId int32, data []byte) uint64 {
+	rb.seq++
+	rb.packets[rb.seq%uint64(len(rb.packets))] = replayPacket{
+		seq:     rb.seq,
+		protoId: protoId,
+		data:    data,
+	}
+	return rb.seq
+}
+
+// since 获取序号大于lastSeq的消息
+// 参数:
+//   - lastSeq: 客户端已收到的最后一条消息序号
+//
+// 返回:
+//   - []replayPacket: 需要补发的消息（按序号升序）
+//   - bool: false表示部分消息已被覆盖，无法完整补发
+func (rb *replayBuffer) since(lastSeq uint64) ([]replayPacket, bool) {
+	if lastSeq >= rb.seq {
+		return nil, true
+	}
+
+	size := uint64(len(rb.packets))
+	if rb.seq-lastSeq > size {
+		return nil, false
+	}
+
+	packets := make([]replayPacket, 0, rb.seq-lastSeq)
+	for seq := lastSeq + 1; seq <= rb.seq; seq++ {
+		packets = append(packets, rb.packets[seq%size])
+	}
+	return packets, true
+}
+
+// newResumeToken 生成随机重连令牌
+func newResumeToken() (string, error) {
+	buf := make([]byte, resumeTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// sendPacket 经玩家Actor向客户端下发消息
+// 消息先写入补发缓冲区，断线保留期间只缓存不发送，待重连后补发
+func (pa *PlayerActor) sendPacket(protoId int32, data []byte) error {
+	pa.replay.push(protoId, data)
+	if pa.Player.GetSession() == nil {
+		return nil
+	}
+	return pa.Player.SendPacket(protoId, data)
+}
+
+// detachSession 解除玩家与已断开会话的绑定，进入断线保留期
+// 参数:
+//   - sessionId: 已断开的会话ID
+func (pa *PlayerActor) detachSession(sessionId zNet.SessionIdType) {
+	session := pa.Player.GetSession()
+	if session == nil || session.GetSid() != sessionId {
+		return
+	}
+	pa.Player.SetSession(nil)
+
+	zLog.Info("Player session detached, waiting for reconnect",
+		zap.Int64("playerId", int64(pa.Player.GetPlayerId())),
+		zap.Uint64("sessionId", sessionId))
+}
+
+// resumeSession 将玩家绑定到重连的会话
+// 先回复重连结果，再按序补发客户端未收到的消息
+func (pa *PlayerActor) resumeSession(msg *PlayerActorResumeMessage) {
+	playerId := int64(pa.Player.GetPlayerId())
+
+	// 服务器尚未感知旧连接断开时，由新连接接管并关闭旧连接
+	if oldSession := pa.Player.GetSession(); oldSession != nil && oldSession.GetSid() != msg.Session.GetSid() {
+		oldSession.Close()
+	}
+	pa.Player.SetSession(msg.Session)
+
+	packets, complete := pa.replay.since(msg.LastRecvSeq)
+	resp := &protocol.PlayerReconnectResponse{
+		Success:         true,
+		PlayerId:        playerId,
+		ResumeToken:     msg.ResumeToken,
+		ServerSeq:       pa.replay.seq,
+		FullResync:      !complete,
+		ProtocolVersion: protolayer.SessionProtocolVersion(msg.Session),
+	}
+	data, err := protolayer.EncodeResponse(msg.Protocol, msg.Packet, protocol.ErrorCode_ERR_OK, resp)
+	if err != nil {
+		zLog.Error("Failed to marshal reconnect response", zap.Int64("playerId", playerId), zap.Error(err))
+		return
+	}
+	if err := msg.Session.Send(msg.Packet.ProtoId, data); err != nil {
+		zLog.Error("Failed to send reconnect response", zap.Int64("playerId", playerId), zap.Error(err))
+		return
+	}
+
+	for _, packet := range packets {
+		if err := pa.Player.SendPacket(packet.protoId, packet.data); err != nil {
+			zLog.Error("Failed to replay player message",
+				zap.Int64("playerId", playerId),
+				zap.Uint64("seq", packet.seq),
+				zap.Error(err))
+			return
+		}
+	}
+
+	zLog.Info("Player session resumed",
+		zap.Int64("playerId", playerId),
+		zap.Uint64("sessionId", msg.Session.GetSid()),
+		zap.Int("replayed", len(packets)),
+		zap.Bool("fullResync", !complete))
+}
+
+// IssueResumeToken 为在线玩家签发断线重连令牌，旧令牌随之失效
+// 参数:
+//   - playerId: 玩家ID
+//
+// 返回:
+//   - string: 重连令牌（未启用断线重连时为空）
+//   - error: 玩家不在线或生成令牌失败
+func (ps *PlayerService) IssueResumeToken(playerId common.PlayerIdType) (string, error) {
+	if !config.GetReconnectConfig().Enabled {
+		return "", nil
+	}
+
+	token, err := newResumeToken()
+	if err != nil {
+		return "", err
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if _, exists := ps.playerActors.Load(playerId); !exists {
+		return "", errPlayerNotFound
+	}
+	ps.setResumeTokenUnsafe(playerId, token)
+	return token, nil
+}
+
+// GetResumeTokenPlayer 获取重连令牌对应的玩家ID
+// 参数:
+//   - token: 重连令牌
+//
+// 返回:
+//   - common.PlayerIdType: 玩家ID
+//   - bool: 令牌是否有效
+func (ps *PlayerService) GetResumeTokenPlayer(token string) (common.PlayerIdType, bool) {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	playerId, ok := ps.resumeTokens[token]
+	return playerId, ok
+}
+
+// ResumeSession 凭重连令牌将玩家绑定到新会话
+// 取消断线保留计时并轮换令牌，会话的实际切换与消息补发由玩家Actor完成（见PlayerActorResumeMessage）
+// 参数:
+//   - token: 重连令牌
+//   - session: 新的网络会话
+//
+// 返回:
+//   - *PlayerActor: 玩家Actor
+//   - string: 新的重连令牌
+//   - error: 令牌无效或已过期
+func (ps *PlayerService) ResumeSession(token string, session zNet.Session) (*PlayerActor, string, error) {
+	if !config.GetReconnectConfig().Enabled {
+		return nil, "", errResumeTokenInvalid
+	}
+
+	newToken, err := newResumeToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	playerId, ok := ps.resumeTokens[token]
+	if !ok {
+		return nil, "", errResumeTokenInvalid
+	}
+	playerActor, exists := ps.playerActors.Load(playerId)
+	if !exists {
+		ps.clearResumeUnsafe(playerId)
+		return nil, "", errResumeTokenInvalid
+	}
+
+	if timer, exists := ps.detachTimers[playerId]; exists {
+		timer.Stop()
+		delete(ps.detachTimers, playerId)
+	}
+
+	// 旧会话不再映射到该玩家，其后续的关闭通知不会影响已恢复的玩家
+	ps.sessionPlayer.Range(func(sessionId zNet.SessionIdType, id common.PlayerIdType) bool {
+		if id == playerId {
+			ps.sessionPlayer.Delete(sessionId)
+		}
+		return true
+	})
+	ps.sessionPlayer.Store(session.GetSid(), playerId)
+	ps.setResumeTokenUnsafe(playerId, newToken)
+
+	return playerActor, newToken, nil
+}
+
+// detachPlayerUnsafe 会话断开后保留玩家Actor，等待客户端重连
+// 超过保留时长仍未重连则移除玩家
+// 注意: 调用前必须持有锁
+func (ps *PlayerService) detachPlayerUnsafe(playerId common.PlayerIdType, playerActor *PlayerActor, sessionId zNet.SessionIdType) {
+	playerActor.SendMessage(NewPlayerActorDetachMessage(int64(playerId), sessionId))
+
+	if timer, exists := ps.detachTimers[playerId]; exists {
+		timer.Stop()
+	}
+	gracePeriod := time.Duration(config.GetReconnectConfig().GracePeriod) * time.Second
+
+	var timer *time.Timer
+	timer = time.AfterFunc(gracePeriod, func() {
+		ps.mu.Lock()
+		if ps.detachTimers[playerId] != timer {
+			ps.mu.Unlock()
+			return
+		}
+		delete(ps.detachTimers, playerId)
+		ps.mu.Unlock()
+
+		zLog.Info("Reconnect grace period expired", zap.Int64("playerId", int64(playerId)))
+		ps.RemovePlayer(playerId)
+	})
+	ps.detachTimers[playerId] = timer
+}
+
+// setResumeTokenUnsafe 设置玩家的重连令牌，旧令牌失效
+// 注意: 调用前必须持有锁
+func (ps *PlayerService) setResumeTokenUnsafe(playerId common.PlayerIdType, token string) {
+	if oldToken, exists := ps.playerTokens[playerId]; exists {
+		delete(ps.resumeTokens, oldToken)
+	}
+	ps.playerTokens[playerId] = token
+	ps.resumeTokens[token] = playerId
+}
+
+// clearResumeUnsafe 清理玩家的重连令牌与断线保留计时
+// 注意: 调用前必须持有锁
+func (ps *PlayerService) clearResumeUnsafe(playerId common.PlayerIdType) {
+	if token, exists := ps.playerTokens[playerId]; exists {
+		delete(ps.resumeTokens, token)
+		delete(ps.playerTokens, playerId)
+	}
+	if timer, exists := ps.detachTimers[playerId]; exists {
+		timer.Stop()
+		delete(ps.detachTimers, playerId)
+	}
+}
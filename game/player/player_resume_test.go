@@ -0,0 +1,30 @@
+package player
+
+import "testing"
+
+func TestReplayBufferSince(t *testing.T) {
+	rb := newReplayBuffer(3)
+	for i := int32(1); i <= 5; i++ {
+		rb.push(i, []byte{byte(i)})
+	}
+
+	packets, complete := rb.since(3)
+	if !complete {
+		t.Fatal("expected complete replay")
+	}
+	if len(packets) != 2 || packets[0].seq != 4 || packets[1].seq != 5 || packets[1].protoId != 5 {
+		t.Fatalf("unexpected packets: %+v", packets)
+	}
+
+	if packets, complete := rb.since(5); !complete || len(packets) != 0 {
+		t.Fatalf("expected nothing to replay, got %d packets", len(packets))
+	}
+
+	// 序号1已被覆盖，无法完整补发
+	if _, complete := rb.since(1); complete {
+		t.Fatal("expected incomplete replay when packets were overwritten")
+	}
+	if packets, complete := rb.since(2); !complete || len(packets) != 3 {
+		t.Fatalf("expected 3 packets, got %d", len(packets))
+	}
+}
@@ -22,6 +22,11 @@ type PlayerService struct {
 	sessionPlayer *zMap.TypedShardedMap[zNet.SessionIdType, common.PlayerIdType]       // 会话玩家映射表（SessionId -> PlayerId）
 	playerCount   int64                                                                // 当前在线玩家数
 	metrics       *PlayerMetrics                                                       // 性能指标统计
+	resumeTokens  map[string]common.PlayerIdType                                       // 重连令牌映射表（Token -> PlayerId）
+	playerTokens  map[common.PlayerIdType]string                                       // 玩家令牌映射表（PlayerId -> Token）
+	detachTimers  map[common.PlayerIdType]*time.Timer                                  // 断线保留计时器（PlayerId -> Timer）
+	loginQueue    *LoginQueue                                                          // 登录排队（服务器满员时）
+	stopCh        chan struct{}                                                        // 停止信号（结束登录排队处理）
 }
 
 // PlayerMetrics 玩家统计指标
@@ -41,7 +46,14 @@ func NewPlayerService() *PlayerService {
 		metrics: &PlayerMetrics{
 			OnlineTime: make(map[common.PlayerIdType]time.Time),
 		},
+		resumeTokens: make(map[string]common.PlayerIdType),
+		playerTokens: make(map[common.PlayerIdType]string),
+		detachTimers: make(map[common.PlayerIdType]*time.Timer),
+		stopCh:       make(chan struct{}),
 	}
+	ps.loginQueue = NewLoginQueue(func() (int64, int64) {
+		return ps.getPlayerCount(), int64(config.GetServerConfig().MaxClientCount)
+	})
 	return ps
 }
 
@@ -60,6 +72,12 @@ func (ps *PlayerService) Close() error {
 	ps.SetState(zService.ServiceStateStopping)
 	zLog.Info("Closing player service...", zap.String("serviceId", ps.ServiceId()))
 
+	select {
+	case <-ps.stopCh:
+	default:
+		close(ps.stopCh)
+	}
+
 	ps.mu.Lock()
 	defer ps.mu.Unlock()
 
@@ -73,6 +91,12 @@ func (ps *PlayerService) Close() error {
 	})
 
 	ps.sessionPlayer.Clear()
+	for playerId := range ps.playerTokens {
+		ps.clearResumeUnsafe(playerId)
+	}
+	for playerId := range ps.detachTimers {
+		ps.clearResumeUnsafe(playerId)
+	}
 	ps.SetState(zService.ServiceStateStopped)
 	return nil
 }
@@ -86,7 +110,7 @@ func (ps *PlayerService) Close() error {
 // 返回:
 //   - *PlayerActor: 新创建的玩家Actor
 //   - error: 创建错误（玩家数已满或玩家已存在）
-func (ps *PlayerService) CreatePlayerActor(session *zNet.TcpServerSession, playerId common.PlayerIdType, name string) (*PlayerActor, error) {
+func (ps *PlayerService) CreatePlayerActor(session zNet.Session, playerId common.PlayerIdType, name string) (*PlayerActor, error) {
 	// 检查服务器人数上限
 	maxPlayers := config.GetServerConfig().MaxClientCount
 	if ps.getPlayerCount() >= int64(maxPlayers) {
@@ -98,11 +122,12 @@ func (ps *PlayerService) CreatePlayerActor(session *zNet.TcpServerSession, playe
 
 	// 检查玩家是否已在线
 	if _, exists := ps.playerActors.Load(playerId); exists {
-		return nil, nil
+		return nil, errPlayerAlreadyExists
 	}
 
 	// 创建新的玩家Actor
 	playerActor := NewPlayerActor(playerId, name, session)
+	playerActor.service = ps
 
 	// 注册到映射表
 	ps.playerActors.Store(playerId, playerActor)
@@ -119,9 +144,30 @@ func (ps *PlayerService) CreatePlayerActor(session *zNet.TcpServerSession, playe
 }
 
 // Serve 启动服务
-// 将服务状态设置为Running
+// 将服务状态设置为Running，并开始处理登录排队
 func (ps *PlayerService) Serve() {
 	ps.SetState(zService.ServiceStateRunning)
+	go ps.loginQueueLoop()
+}
+
+// GetLoginQueue 获取登录排队
+func (ps *PlayerService) GetLoginQueue() *LoginQueue {
+	return ps.loginQueue
+}
+
+// loginQueueLoop 定期处理登录排队，直到服务关闭
+func (ps *PlayerService) loginQueueLoop() {
+	ticker := time.NewTicker(LoginQueueTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			ps.loginQueue.Tick(now)
+		case <-ps.stopCh:
+			return
+		}
+	}
 }
 
 // GetPlayer 获取玩家对象
@@ -188,54 +234,92 @@ func (ps *PlayerService) GetPlayerActorBySession(sessionId zNet.SessionIdType) *
 	return nil
 }
 
+// RangePlayers 遍历所有在线玩家
+// 参数:
+//   - f: 遍历函数，返回false时停止遍历
+func (ps *PlayerService) RangePlayers(f func(player *Player) bool) {
+	ps.playerActors.Range(func(playerId common.PlayerIdType, playerActor *PlayerActor) bool {
+		if playerActor.Player == nil {
+			return true
+		}
+		return f(playerActor.Player)
+	})
+}
+
 // RemovePlayer 移除玩家
 // 停止玩家Actor，清理映射表
 // 参数:
 //   - playerId: 玩家ID
 func (ps *PlayerService) RemovePlayer(playerId common.PlayerIdType) {
 	ps.mu.Lock()
-	defer ps.mu.Unlock()
+	playerActor, exists := ps.playerActors.Load(playerId)
+	if !exists {
+		ps.mu.Unlock()
+		return
+	}
 
-	if playerActor, exists := ps.playerActors.Load(playerId); exists {
-		playerActor.Stop()
-		ps.playerActors.Delete(playerId)
-		delete(ps.metrics.OnlineTime, playerId)
-		ps.playerCount--
-
-		// 清理会话映射
-		if player := playerActor.Player; player != nil {
-			if session := player.GetSession(); session != nil {
-				ps.sessionPlayer.Delete(session.GetSid())
-			}
+	// 停止Actor时玩家会话会被置空，需提前清理会话映射
+	if player := playerActor.Player; player != nil {
+		if session := player.GetSession(); session != nil {
+			ps.sessionPlayer.Delete(session.GetSid())
 		}
-
-		zLog.Info("Removed player actor",
-			zap.Int64("playerId", int64(playerId)),
-			zap.Int64("totalPlayers", ps.playerCount))
 	}
+	ps.playerActors.Delete(playerId)
+	ps.clearResumeUnsafe(playerId)
+	delete(ps.metrics.OnlineTime, playerId)
+	ps.playerCount--
+	totalPlayers := ps.playerCount
+	ps.mu.Unlock()
+
+	// 停止Actor会关闭会话并触发会话关闭回调，不能在持有锁时执行
+	playerActor.Stop()
+
+	zLog.Info("Removed player actor",
+		zap.Int64("playerId", int64(playerId)),
+		zap.Int64("totalPlayers", totalPlayers))
 }
 
 // OnSessionClose 会话关闭处理
-// 当客户端断开连接时调用，清理相关玩家数据
+// 当客户端断开连接时调用：启用断线重连且玩家持有重连令牌时保留玩家Actor等待重连，
+// 否则立即清理相关玩家数据
 // 参数:
 //   - sessionId: 关闭的会话ID
 func (ps *PlayerService) OnSessionClose(sessionId zNet.SessionIdType) {
 	ps.mu.Lock()
-	defer ps.mu.Unlock()
+	playerId, exists := ps.sessionPlayer.Load(sessionId)
+	if !exists {
+		ps.mu.Unlock()
+		return
+	}
+	ps.sessionPlayer.Delete(sessionId)
 
-	if playerId, exists := ps.sessionPlayer.Load(sessionId); exists {
-		if playerActor, exists := ps.playerActors.Load(playerId); exists {
-			playerActor.Stop()
-			ps.playerActors.Delete(playerId)
-			ps.sessionPlayer.Delete(sessionId)
-			delete(ps.metrics.OnlineTime, playerId)
-			ps.playerCount--
-
-			zLog.Info("Session closed, removed player actor",
-				zap.Uint64("sessionId", uint64(sessionId)),
-				zap.Int64("playerId", int64(playerId)))
-		}
+	playerActor, exists := ps.playerActors.Load(playerId)
+	if !exists {
+		ps.mu.Unlock()
+		return
+	}
+
+	if _, hasToken := ps.playerTokens[playerId]; hasToken && config.GetReconnectConfig().Enabled {
+		ps.detachPlayerUnsafe(playerId, playerActor, sessionId)
+		ps.mu.Unlock()
+
+		zLog.Info("Session closed, player actor kept for reconnect",
+			zap.Uint64("sessionId", uint64(sessionId)),
+			zap.Int64("playerId", int64(playerId)))
+		return
 	}
+
+	ps.playerActors.Delete(playerId)
+	ps.clearResumeUnsafe(playerId)
+	delete(ps.metrics.OnlineTime, playerId)
+	ps.playerCount--
+	ps.mu.Unlock()
+
+	playerActor.Stop()
+
+	zLog.Info("Session closed, removed player actor",
+		zap.Uint64("sessionId", uint64(sessionId)),
+		zap.Int64("playerId", int64(playerId)))
 }
 
 // getPlayerCount 获取当前在线玩家数量
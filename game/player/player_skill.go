@@ -5,6 +5,8 @@ import (
 
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config/models"
+	"github.com/pzqf/zGameServer/config/tables"
 	"github.com/pzqf/zGameServer/game/object/component"
 	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
@@ -153,6 +155,33 @@ func (sm *SkillManager) initBasicSkills() {
 	sm.skills.Store(basicDefense.skillId, basicDefense)
 }
 
+// newSkillFromConfig 根据技能配置创建技能
+func newSkillFromConfig(skillConfig *models.Skill) *Skill {
+	targetType := SkillTargetTypeSingle
+	if skillConfig.AreaRadius > 0 {
+		targetType = SkillTargetTypeAoE
+	}
+
+	return &Skill{
+		skillId:      int64(skillConfig.SkillID),
+		name:         skillConfig.Name,
+		description:  skillConfig.Description,
+		skillType:    int(skillConfig.Type),
+		status:       SkillStatusUnlocked,
+		level:        1,
+		requireLevel: int(skillConfig.RequiredLevel),
+		effects: []*SkillEffect{
+			{
+				effectType: SkillEffectTypeDamage,
+				value:      float64(skillConfig.Damage),
+				rangeValue: float64(skillConfig.Range),
+				targetType: targetType,
+			},
+		},
+		cooldown: int(skillConfig.Cooldown * 1000), // 配置表单位为秒
+	}
+}
+
 // LearnSkill 学习技能
 func (sm *SkillManager) LearnSkill(skillId int64) error {
 	// 检查技能是否已存在
@@ -165,11 +194,13 @@ func (sm *SkillManager) LearnSkill(skillId int64) error {
 		return nil // 已达到最大技能数量
 	}
 
-	// TODO: 从技能配置表获取技能信息
-	// skill := GetSkillFromConfig(skillId)
+	// 从技能配置表获取技能信息
+	skillConfig := tables.GetSkillByID(int32(skillId))
+	if skillConfig == nil {
+		return nil // 技能配置不存在
+	}
 
-	// 假设我们已经获取了技能信息
-	// sm.skills.Store(skillId, skill)
+	sm.skills.Store(skillId, newSkillFromConfig(skillConfig))
 
 	zLog.Info("Skill learned", zap.Int64("skillId", skillId), zap.Int64("playerId", int64(sm.playerId)))
 	return nil
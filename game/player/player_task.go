@@ -1,8 +1,11 @@
 package player
 
 import (
+	"encoding/json"
+
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config/models"
 	"github.com/pzqf/zGameServer/game/object/component"
 	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
@@ -72,6 +75,21 @@ type Task struct {
 	completeTime int64
 }
 
+// taskObjectiveConfig 任务目标配置（对应任务表Objectives字段）
+type taskObjectiveConfig struct {
+	Type   int `json:"type"`
+	Target int `json:"target"`
+	Count  int `json:"count"`
+}
+
+// taskRewardConfig 任务奖励配置（对应任务表Rewards字段）
+type taskRewardConfig struct {
+	ItemId int64 `json:"item_id"`
+	Count  int   `json:"count"`
+	Gold   int64 `json:"gold"`
+	Exp    int64 `json:"exp"`
+}
+
 // TaskManager 任务管理系统
 type TaskManager struct {
 	*component.BaseComponent
@@ -89,6 +107,57 @@ func NewTaskManager(playerId common.PlayerIdType) *TaskManager {
 	}
 }
 
+// NewTaskFromConfig 根据任务配置创建任务
+// 参数:
+//   - quest: 任务配置
+//
+// 返回:
+//   - *Task: 新创建的任务
+//   - error: 任务目标或奖励配置解析错误
+func NewTaskFromConfig(quest *models.Quest) (*Task, error) {
+	task := &Task{
+		taskId:      int64(quest.QuestID),
+		taskType:    int(quest.Type),
+		title:       quest.Name,
+		description: quest.Description,
+		status:      TaskStatusNotAccepted,
+	}
+
+	if quest.Objectives != "" {
+		var objectives []taskObjectiveConfig
+		if err := json.Unmarshal([]byte(quest.Objectives), &objectives); err != nil {
+			return nil, err
+		}
+		for _, objective := range objectives {
+			task.conditions = append(task.conditions, &TaskCondition{
+				condType:  objective.Type,
+				condValue: objective.Target,
+				target:    objective.Count,
+			})
+		}
+	}
+
+	if quest.Rewards != "" {
+		var rewards []taskRewardConfig
+		if err := json.Unmarshal([]byte(quest.Rewards), &rewards); err != nil {
+			return nil, err
+		}
+		for _, reward := range rewards {
+			if reward.ItemId > 0 {
+				task.rewards = append(task.rewards, &TaskReward{rewardType: TaskRewardTypeItem, itemId: reward.ItemId, count: reward.Count})
+			}
+			if reward.Gold > 0 {
+				task.rewards = append(task.rewards, &TaskReward{rewardType: TaskRewardTypeCoin, value: reward.Gold})
+			}
+			if reward.Exp > 0 {
+				task.rewards = append(task.rewards, &TaskReward{rewardType: TaskRewardTypeExp, value: reward.Exp})
+			}
+		}
+	}
+
+	return task, nil
+}
+
 func (tm *TaskManager) Init() error {
 	// 初始化任务管理系统
 	zLog.Debug("Initializing task manager", zap.Int64("playerId", int64(tm.playerId)))
@@ -0,0 +1,300 @@
+package sanction
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/db/repository"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidType     = errors.New("sanction: invalid type")
+	ErrNotFound        = errors.New("sanction: not found")
+	ErrAlreadyRevoked  = errors.New("sanction: already revoked")
+	ErrRepositoryUnset = errors.New("sanction: account repository not initialized")
+)
+
+// typeNames 处罚类型名称（管理接口与日志使用）
+var typeNames = map[int32]string{
+	models.SanctionTypeLoginBan:  "ban",
+	models.SanctionTypeChatMute:  "mute",
+	models.SanctionTypeTradeLock: "trade",
+}
+
+// TypeName 获取处罚类型名称
+func TypeName(sanctionType int32) string {
+	if name, ok := typeNames[sanctionType]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseType 按名称解析处罚类型
+// 返回: 处罚类型，名称无效时返回false
+func ParseType(name string) (int32, bool) {
+	for sanctionType, typeName := range typeNames {
+		if typeName == name {
+			return sanctionType, true
+		}
+	}
+	return 0, false
+}
+
+// IssueHandler 处罚签发后的回调（用于将在线的被封禁账号或角色踢下线）
+type IssueHandler func(sanction *models.AccountSanction)
+
+// Manager 处罚管理器（并发安全）
+// 只缓存生效中的处罚，登录、禁言与交易检查读取缓存；签发与撤销先写入账号数据仓库再更新缓存
+type Manager struct {
+	mu           sync.RWMutex
+	active       map[int64][]*models.AccountSanction // 账号ID -> 生效中的处罚
+	players      map[int64]int64                     // 在线角色ID -> 账号ID
+	issueHandler IssueHandler
+}
+
+var (
+	manager     *Manager
+	managerOnce sync.Once
+)
+
+// GetManager 获取全局处罚管理器
+func GetManager() *Manager {
+	managerOnce.Do(func() {
+		manager = NewManager()
+	})
+	return manager
+}
+
+// NewManager 创建处罚管理器
+func NewManager() *Manager {
+	return &Manager{
+		active:  make(map[int64][]*models.AccountSanction),
+		players: make(map[int64]int64),
+	}
+}
+
+// SetIssueHandler 设置处罚签发后的回调
+func (m *Manager) SetIssueHandler(handler IssueHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.issueHandler = handler
+}
+
+// Load 从账号数据仓库加载账号生效中的处罚（账号登录时调用，以数据库为准刷新缓存）
+// 参数:
+//   - accountID: 账号ID
+//   - now: 当前时间
+func (m *Manager) Load(accountID int64, now time.Time) error {
+	sanctions, err := m.History(accountID)
+	if err != nil {
+		return err
+	}
+
+	var active []*models.AccountSanction
+	for _, sanction := range sanctions {
+		if sanction.IsActive(now) {
+			active = append(active, sanction)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(active) == 0 {
+		delete(m.active, accountID)
+	} else {
+		m.active[accountID] = active
+	}
+	return nil
+}
+
+// BindPlayer 记录在线角色所属账号，此后可按角色ID检查处罚
+func (m *Manager) BindPlayer(playerID, accountID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.players[playerID] = accountID
+}
+
+// UnbindPlayer 角色下线时移除记录
+func (m *Manager) UnbindPlayer(playerID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.players, playerID)
+}
+
+// Find 获取对账号或角色生效中的处罚
+// 参数:
+//   - accountID: 账号ID
+//   - playerID: 角色ID，0表示只检查账号级处罚
+//   - sanctionType: 处罚类型
+//   - now: 当前时间
+//
+// 返回:
+//   - *models.AccountSanction: 到期最晚的处罚（永久处罚优先），没有时返回nil
+func (m *Manager) Find(accountID, playerID int64, sanctionType int32, now time.Time) *models.AccountSanction {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.findUnsafe(accountID, playerID, sanctionType, now)
+}
+
+// FindByPlayer 获取对在线角色生效中的处罚（含其账号级处罚）
+// 返回: 到期最晚的处罚，角色不在线或没有处罚时返回nil
+func (m *Manager) FindByPlayer(playerID int64, sanctionType int32, now time.Time) *models.AccountSanction {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	accountID, ok := m.players[playerID]
+	if !ok {
+		return nil
+	}
+	return m.findUnsafe(accountID, playerID, sanctionType, now)
+}
+
+// findUnsafe 获取生效中的处罚
+// 注意: 调用前必须持有锁
+func (m *Manager) findUnsafe(accountID, playerID int64, sanctionType int32, now time.Time) *models.AccountSanction {
+	var found *models.AccountSanction
+	for _, sanction := range m.active[accountID] {
+		if sanction.Type != sanctionType || !sanction.Covers(playerID) || !sanction.IsActive(now) {
+			continue
+		}
+		if found == nil || sanction.ExpireAt.IsZero() || (!found.ExpireAt.IsZero() && sanction.ExpireAt.After(found.ExpireAt)) {
+			found = sanction
+		}
+	}
+	return found
+}
+
+// Issue 签发处罚
+// 写入账号数据仓库后加入缓存，并通知签发回调
+// 参数:
+//   - sanction: 处罚记录（需填写账号、角色、类型、原因、签发人与到期时间，ID与创建时间由此生成）
+func (m *Manager) Issue(sanction *models.AccountSanction) error {
+	if _, ok := typeNames[sanction.Type]; !ok {
+		return ErrInvalidType
+	}
+	repo := m.accountRepository()
+	if repo == nil {
+		return ErrRepositoryUnset
+	}
+
+	sanctionID, err := common.GenerateSanctionID()
+	if err != nil {
+		return err
+	}
+	sanction.SanctionID = int64(sanctionID)
+	if sanction.CreatedAt.IsZero() {
+		sanction.CreatedAt = time.Now()
+	}
+	if _, err := repo.CreateSanction(sanction); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if sanction.IsActive(time.Now()) {
+		m.active[sanction.AccountID] = append(m.active[sanction.AccountID], sanction)
+	}
+	handler := m.issueHandler
+	m.mu.Unlock()
+
+	zLog.Info("Sanction issued",
+		zap.Int64("sanctionId", sanction.SanctionID),
+		zap.Int64("accountId", sanction.AccountID),
+		zap.Int64("playerId", sanction.PlayerID),
+		zap.String("type", TypeName(sanction.Type)),
+		zap.String("issuer", sanction.Issuer),
+		zap.String("reason", sanction.Reason),
+		zap.Time("expireAt", sanction.ExpireAt))
+
+	if handler != nil {
+		handler(sanction)
+	}
+	return nil
+}
+
+// Revoke 撤销处罚
+// 参数:
+//   - accountID: 账号ID
+//   - sanctionID: 处罚ID
+//   - revokedBy: 撤销人
+//   - reason: 撤销原因
+//
+// 返回:
+//   - *models.AccountSanction: 撤销后的处罚记录
+//   - error: 处罚不存在、已撤销或写入失败
+func (m *Manager) Revoke(accountID, sanctionID int64, revokedBy, reason string) (*models.AccountSanction, error) {
+	sanctions, err := m.History(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sanction *models.AccountSanction
+	for _, s := range sanctions {
+		if s.SanctionID == sanctionID {
+			sanction = s
+			break
+		}
+	}
+	if sanction == nil {
+		return nil, ErrNotFound
+	}
+	if !sanction.RevokedAt.IsZero() {
+		return nil, ErrAlreadyRevoked
+	}
+
+	sanction.RevokedAt = time.Now()
+	sanction.RevokedBy = revokedBy
+	sanction.RevokeReason = reason
+	if _, err := m.accountRepository().RevokeSanction(sanction); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	active := m.active[accountID][:0]
+	for _, s := range m.active[accountID] {
+		if s.SanctionID != sanctionID {
+			active = append(active, s)
+		}
+	}
+	if len(active) == 0 {
+		delete(m.active, accountID)
+	} else {
+		m.active[accountID] = active
+	}
+	m.mu.Unlock()
+
+	zLog.Info("Sanction revoked",
+		zap.Int64("sanctionId", sanctionID),
+		zap.Int64("accountId", accountID),
+		zap.String("type", TypeName(sanction.Type)),
+		zap.String("revokedBy", revokedBy),
+		zap.String("reason", reason))
+	return sanction, nil
+}
+
+// History 从账号数据仓库读取账号的全部处罚记录（含已撤销与已到期），用于审计
+func (m *Manager) History(accountID int64) ([]*models.AccountSanction, error) {
+	repo := m.accountRepository()
+	if repo == nil {
+		return nil, ErrRepositoryUnset
+	}
+	return repo.GetSanctions(accountID)
+}
+
+// accountRepository 获取账号数据仓库，数据库未初始化时返回nil
+func (m *Manager) accountRepository() repository.AccountRepository {
+	if db.GetMgr() == nil {
+		return nil
+	}
+	return db.GetMgr().AccountRepository
+}
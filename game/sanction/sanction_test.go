@@ -0,0 +1,74 @@
+package sanction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+)
+
+func TestManagerIssueFindRevoke(t *testing.T) {
+	if err := common.InitIDGenerator(1, 1); err != nil {
+		t.Fatal(err)
+	}
+	db.InitMemoryDBManager()
+
+	m := NewManager()
+	var kicked []*models.AccountSanction
+	m.SetIssueHandler(func(s *models.AccountSanction) { kicked = append(kicked, s) })
+
+	now := time.Now()
+	mute := &models.AccountSanction{AccountID: 1, PlayerID: 11, Type: models.SanctionTypeChatMute, Reason: "spam", Issuer: "gm", ExpireAt: now.Add(time.Hour)}
+	ban := &models.AccountSanction{AccountID: 1, Type: models.SanctionTypeLoginBan, Reason: "cheat", Issuer: "gm"}
+	for _, s := range []*models.AccountSanction{mute, ban} {
+		if err := m.Issue(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(kicked) != 2 {
+		t.Fatalf("expected issue handler called twice, got %d", len(kicked))
+	}
+
+	m.BindPlayer(11, 1)
+	m.BindPlayer(12, 1)
+	if m.FindByPlayer(11, models.SanctionTypeChatMute, now) != mute {
+		t.Fatal("expected character mute to apply")
+	}
+	if m.FindByPlayer(12, models.SanctionTypeChatMute, now) != nil {
+		t.Fatal("expected character mute not to apply to other characters")
+	}
+	if m.FindByPlayer(11, models.SanctionTypeChatMute, now.Add(2*time.Hour)) != nil {
+		t.Fatal("expected mute to expire")
+	}
+	if m.Find(1, 0, models.SanctionTypeLoginBan, now) != ban || m.FindByPlayer(12, models.SanctionTypeLoginBan, now) != ban {
+		t.Fatal("expected account ban to apply to the account and its characters")
+	}
+
+	if _, err := m.Revoke(1, ban.SanctionID, "gm2", "appeal"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Find(1, 0, models.SanctionTypeLoginBan, now) != nil {
+		t.Fatal("expected revoked ban to be removed")
+	}
+	if _, err := m.Revoke(1, ban.SanctionID, "gm2", "appeal"); err != ErrAlreadyRevoked {
+		t.Fatalf("expected ErrAlreadyRevoked, got %v", err)
+	}
+
+	// 重新加载以数据仓库为准，撤销记录保留用于审计
+	reloaded := NewManager()
+	if err := reloaded.Load(1, now); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Find(1, 11, models.SanctionTypeChatMute, now) == nil || reloaded.Find(1, 0, models.SanctionTypeLoginBan, now) != nil {
+		t.Fatal("unexpected sanctions after reload")
+	}
+	history, err := reloaded.History(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[1].RevokedBy != "gm2" || history[1].RevokeReason != "appeal" {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+}
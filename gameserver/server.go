@@ -2,17 +2,23 @@ package gameserver
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pzqf/zEngine/zInject"
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zObject"
 	"github.com/pzqf/zEngine/zService"
 	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/protocol"
 	"github.com/pzqf/zGameServer/net/protolayer"
 	"github.com/pzqf/zGameServer/net/router"
 	"go.uber.org/zap"
 )
 
+// slowPacketThreshold 慢请求告警阈值
+const slowPacketThreshold = 100 * time.Millisecond
+
 type GameServer struct {
 	*zService.ServiceManager
 	wg            sync.WaitGroup
@@ -90,6 +96,12 @@ func (gs *GameServer) resolveDependencies() {
 		gs.packetRouter = packetRouter.(*router.PacketRouter)
 	}
 
+	// 路由器使用配置的协议进行消息编解码
+	gs.packetRouter.SetProtocol(gs.protocol)
+
+	// 注册全局中间件
+	gs.registerMiddlewares()
+
 	// 解析ObjectManager
 	objectManager, err := gs.ResolveDependency("objectManager")
 	if err != nil {
@@ -171,3 +183,33 @@ func (gs *GameServer) GetProtocol() protolayer.Protocol {
 func (gs *GameServer) GetObjectManager() *zObject.ObjectManager {
 	return gs.objectManager
 }
+
+// registerMiddlewares 注册路由器全局中间件（异常恢复位于最外层），TCP与WebSocket等网络服务共用
+func (gs *GameServer) registerMiddlewares() {
+	// 连接关闭时释放其加密与压缩状态
+	gs.packetRouter.RegisterSessionCloseHandler(protolayer.RemoveSession)
+
+	networkMetrics := metrics.GetNetworkMetrics()
+	gs.packetRouter.Use(
+		router.Recovery(gs.packetRouter),
+		router.PacketCodec(gs.packetRouter, protolayer.GetSecurityLayer(), int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE), networkMetrics),
+	)
+
+	// 限流在解包之后、状态校验之前，超限的请求不会进入处理函数与玩家Actor
+	if rateLimitConfig := config.GetRateLimitConfig(); rateLimitConfig.Enabled {
+		limiter, err := router.NewRateLimiter(rateLimitConfig)
+		if err != nil {
+			zLog.Error("Invalid rate limit rules, skipped", zap.Error(err))
+		}
+		gs.packetRouter.SetRateLimiter(limiter)
+		gs.packetRouter.Use(router.RateLimit(gs.packetRouter, limiter, networkMetrics))
+		zLog.Info("Rate limit enabled", zap.Int("rules", len(limiter.Rules())), zap.Int("defaultRate", rateLimitConfig.DefaultRate))
+	}
+
+	gs.packetRouter.Use(
+		router.StateGuard(gs.packetRouter, networkMetrics),
+		router.Metrics(networkMetrics),
+		router.Tracing(slowPacketThreshold),
+		router.Logging(),
+	)
+}
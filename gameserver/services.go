@@ -0,0 +1,70 @@
+package gameserver
+
+import (
+	"fmt"
+
+	"github.com/pzqf/zGameServer/game/auction"
+	"github.com/pzqf/zGameServer/game/broadcast"
+	"github.com/pzqf/zGameServer/game/chardelete"
+	"github.com/pzqf/zGameServer/game/guild"
+	"github.com/pzqf/zGameServer/game/maps"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/handler"
+	"github.com/pzqf/zGameServer/net/service"
+)
+
+// SetupServices 创建网络与游戏服务并注册消息处理器，随后初始化所有服务
+func (gs *GameServer) SetupServices() error {
+	tcpService := service.NewTcpService(gs.GetPacketRouter())
+	if err := gs.AddService(tcpService); err != nil {
+		return fmt.Errorf("failed to add TCP service: %w", err)
+	}
+
+	webSocketService := service.NewWebSocketService(gs.GetPacketRouter())
+	if err := gs.AddService(webSocketService); err != nil {
+		return fmt.Errorf("failed to add WebSocket service: %w", err)
+	}
+
+	udpService := service.NewUdpService(gs.GetPacketRouter())
+	if err := gs.AddService(udpService); err != nil {
+		return fmt.Errorf("failed to add UDP service: %w", err)
+	}
+
+	httpService := service.NewHTTPService()
+	if err := gs.AddService(httpService); err != nil {
+		return fmt.Errorf("failed to add HTTP service: %w", err)
+	}
+
+	playerService := player.NewPlayerService()
+	if err := gs.AddService(playerService); err != nil {
+		return fmt.Errorf("failed to add player service: %w", err)
+	}
+
+	guildService := guild.NewGuildService()
+	if err := gs.AddService(guildService); err != nil {
+		return fmt.Errorf("failed to add guild service: %w", err)
+	}
+
+	auctionService := auction.NewAuctionService()
+	if err := gs.AddService(auctionService); err != nil {
+		return fmt.Errorf("failed to add auction service: %w", err)
+	}
+
+	mapService := maps.NewMapService()
+	if err := gs.AddService(mapService); err != nil {
+		return fmt.Errorf("failed to add map service: %w", err)
+	}
+
+	charDeleteService := chardelete.NewCharDeleteService(guildService, auctionService)
+	if err := gs.AddService(charDeleteService); err != nil {
+		return fmt.Errorf("failed to add char delete service: %w", err)
+	}
+
+	// 地图同步等推送通过广播分发给接收玩家
+	broadcaster := broadcast.NewBroadcaster(gs.GetPacketRouter(), playerService, mapService, guildService)
+	mapService.SetBroadcaster(broadcaster)
+
+	handler.Init(gs.GetPacketRouter(), playerService, guildService, auctionService, mapService, charDeleteService)
+
+	return gs.InitServices()
+}
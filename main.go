@@ -12,14 +12,10 @@ import (
 	"github.com/pzqf/zGameServer/config"
 	"github.com/pzqf/zGameServer/config/tables"
 	"github.com/pzqf/zGameServer/db"
-	"github.com/pzqf/zGameServer/game/auction"
-	"github.com/pzqf/zGameServer/game/guild"
-	"github.com/pzqf/zGameServer/game/maps"
-	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/game/naming"
 	"github.com/pzqf/zGameServer/gameserver"
 	"github.com/pzqf/zGameServer/metrics"
-	"github.com/pzqf/zGameServer/net/handler"
-	"github.com/pzqf/zGameServer/net/service"
+	"github.com/pzqf/zGameServer/net/protolayer"
 	"github.com/pzqf/zGameServer/util"
 	"go.uber.org/zap"
 )
@@ -65,6 +61,15 @@ func main() {
 		zLog.Fatal("Failed to load configuration tables", zap.Error(err))
 	}
 
+	namingCfg := config.GetNamingConfig()
+	if err := naming.GetService().LoadWordLists(namingCfg.ReservedFile, namingCfg.ProfanityFile); err != nil {
+		zLog.Fatal("Failed to load naming word lists", zap.Error(err))
+	}
+
+	if err := protolayer.InitSecurityLayer(); err != nil {
+		zLog.Fatal("Failed to load net security signing key", zap.Error(err))
+	}
+
 	zLog.Info("Starting MMO Game Server...")
 
 	if err := db.ValidateModelTags(); err != nil {
@@ -81,7 +86,7 @@ func main() {
 	metrics.RegisterBasicMetrics()
 
 	gameServer := gameserver.NewGameServer()
-	if err := setupServices(gameServer); err != nil {
+	if err := gameServer.SetupServices(); err != nil {
 		zLog.Fatal("Failed to initialize logger", zap.Error(err))
 	}
 
@@ -138,39 +143,3 @@ func setupPprof(ctx context.Context) {
 		}
 	}()
 }
-
-func setupServices(gameServer *gameserver.GameServer) error {
-	tcpService := service.NewTcpService(gameServer.GetPacketRouter())
-	if err := gameServer.AddService(tcpService); err != nil {
-		return fmt.Errorf("failed to add TCP service: %w", err)
-	}
-
-	httpService := service.NewHTTPService()
-	if err := gameServer.AddService(httpService); err != nil {
-		return fmt.Errorf("failed to add HTTP service: %w", err)
-	}
-
-	playerService := player.NewPlayerService()
-	if err := gameServer.AddService(playerService); err != nil {
-		return fmt.Errorf("failed to add player service: %w", err)
-	}
-
-	guildService := guild.NewGuildService()
-	if err := gameServer.AddService(guildService); err != nil {
-		return fmt.Errorf("failed to add guild service: %w", err)
-	}
-
-	auctionService := auction.NewAuctionService()
-	if err := gameServer.AddService(auctionService); err != nil {
-		return fmt.Errorf("failed to add auction service: %w", err)
-	}
-
-	mapService := maps.NewMapService()
-	if err := gameServer.AddService(mapService); err != nil {
-		return fmt.Errorf("failed to add map service: %w", err)
-	}
-
-	handler.Init(gameServer.GetPacketRouter(), playerService, guildService, auctionService, mapService)
-
-	return gameServer.InitServices()
-}
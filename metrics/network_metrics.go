@@ -32,6 +32,17 @@ type NetworkMetrics struct {
 	decodingErrors    int64
 	compressionErrors int64
 	droppedPackets    int64
+	rejectedPackets   int64 // 会话状态不允许而被拒绝的数据包数
+	slowConsumers     int64 // 因下行积压被断开的慢消费者连接数
+	rateLimited       int64 // 因请求过于频繁被限流的数据包数
+	rateLimitKicks    int64 // 因请求过于频繁被断开的连接数
+	rateLimitBans     int64 // 因反复超限被临时封禁的次数
+
+	// 压缩统计
+	compressedPackets      int64
+	decompressedPackets    int64
+	compressionInputBytes  int64 // 压缩前字节数
+	compressionOutputBytes int64 // 压缩后字节数
 
 	// 采样时间
 	lastSampleTime time.Time
@@ -136,6 +147,59 @@ func (m *NetworkMetrics) IncCompressionErrors() {
 	m.compressionErrors++
 }
 
+// IncSlowConsumers 增加被断开的慢消费者连接数
+func (m *NetworkMetrics) IncSlowConsumers() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.slowConsumers++
+}
+
+// IncRateLimitedPackets 增加被限流的数据包数
+func (m *NetworkMetrics) IncRateLimitedPackets() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimited++
+}
+
+// IncRateLimitKicks 增加因限流被断开的连接数
+func (m *NetworkMetrics) IncRateLimitKicks() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimitKicks++
+}
+
+// IncRateLimitBans 增加因限流临时封禁的次数
+func (m *NetworkMetrics) IncRateLimitBans() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimitBans++
+}
+
+// RecordCompression 记录一次发送压缩
+// 参数:
+//   - originalSize: 压缩前字节数
+//   - compressedSize: 压缩后字节数
+func (m *NetworkMetrics) RecordCompression(originalSize, compressedSize int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.compressedPackets++
+	m.compressionInputBytes += int64(originalSize)
+	m.compressionOutputBytes += int64(compressedSize)
+}
+
+// IncDecompressedPackets 增加解压数据包数
+func (m *NetworkMetrics) IncDecompressedPackets() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.decompressedPackets++
+}
+
 // IncDroppedPackets 增加丢弃数据包数
 func (m *NetworkMetrics) IncDroppedPackets() {
 	m.mu.Lock()
@@ -144,6 +208,14 @@ func (m *NetworkMetrics) IncDroppedPackets() {
 	m.droppedPackets++
 }
 
+// IncRejectedPackets 增加被拒绝数据包数
+func (m *NetworkMetrics) IncRejectedPackets() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rejectedPackets++
+}
+
 // GetStats 获取统计信息
 func (m *NetworkMetrics) GetStats() map[string]interface{} {
 	m.mu.RLock()
@@ -152,26 +224,40 @@ func (m *NetworkMetrics) GetStats() map[string]interface{} {
 	elapsed := time.Since(m.lastSampleTime)
 	throughputSent := float64(m.totalBytesSent) / elapsed.Seconds()
 	throughputReceived := float64(m.totalBytesReceived) / elapsed.Seconds()
+	compressionRatio := 1.0
+	if m.compressionInputBytes > 0 {
+		compressionRatio = float64(m.compressionOutputBytes) / float64(m.compressionInputBytes)
+	}
 
 	return map[string]interface{}{
-		"active_connections":      m.activeConnections,
-		"total_connections":       m.totalConnections,
-		"dropped_connections":     m.droppedConnections,
-		"avg_latency_ms":          float64(m.avgLatency.Milliseconds()),
-		"max_latency_ms":          float64(m.maxLatency.Milliseconds()),
-		"min_latency_ms":          float64(m.minLatency.Milliseconds()),
-		"throughput_sent_bps":     throughputSent,
-		"throughput_received_bps": throughputReceived,
-		"total_bytes_sent":        m.totalBytesSent,
-		"total_bytes_received":    m.totalBytesReceived,
-		"total_packets_sent":      m.totalPacketsSent,
-		"total_packets_received":  m.totalPacketsReceived,
-		"encoding_errors":         m.encodingErrors,
-		"decoding_errors":         m.decodingErrors,
-		"compression_errors":      m.compressionErrors,
-		"dropped_packets":         m.droppedPackets,
-		"sample_time":             m.lastSampleTime,
-		"elapsed_seconds":         elapsed.Seconds(),
+		"active_connections":       m.activeConnections,
+		"total_connections":        m.totalConnections,
+		"dropped_connections":      m.droppedConnections,
+		"avg_latency_ms":           float64(m.avgLatency.Milliseconds()),
+		"max_latency_ms":           float64(m.maxLatency.Milliseconds()),
+		"min_latency_ms":           float64(m.minLatency.Milliseconds()),
+		"throughput_sent_bps":      throughputSent,
+		"throughput_received_bps":  throughputReceived,
+		"total_bytes_sent":         m.totalBytesSent,
+		"total_bytes_received":     m.totalBytesReceived,
+		"total_packets_sent":       m.totalPacketsSent,
+		"total_packets_received":   m.totalPacketsReceived,
+		"encoding_errors":          m.encodingErrors,
+		"decoding_errors":          m.decodingErrors,
+		"compression_errors":       m.compressionErrors,
+		"dropped_packets":          m.droppedPackets,
+		"rejected_packets":         m.rejectedPackets,
+		"slow_consumers":           m.slowConsumers,
+		"rate_limited_packets":     m.rateLimited,
+		"rate_limit_kicks":         m.rateLimitKicks,
+		"rate_limit_bans":          m.rateLimitBans,
+		"compressed_packets":       m.compressedPackets,
+		"decompressed_packets":     m.decompressedPackets,
+		"compression_input_bytes":  m.compressionInputBytes,
+		"compression_output_bytes": m.compressionOutputBytes,
+		"compression_ratio":        compressionRatio,
+		"sample_time":              m.lastSampleTime,
+		"elapsed_seconds":          elapsed.Seconds(),
 	}
 }
 
@@ -200,6 +286,15 @@ func (m *NetworkMetrics) Reset() {
 	m.decodingErrors = 0
 	m.compressionErrors = 0
 	m.droppedPackets = 0
+	m.rejectedPackets = 0
+	m.slowConsumers = 0
+	m.rateLimited = 0
+	m.rateLimitKicks = 0
+	m.rateLimitBans = 0
+	m.compressedPackets = 0
+	m.decompressedPackets = 0
+	m.compressionInputBytes = 0
+	m.compressionOutputBytes = 0
 	m.lastSampleTime = time.Now()
 }
 
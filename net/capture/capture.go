@@ -0,0 +1,261 @@
+// Package capture 会话流量录制
+// 录制文件为JSON Lines：首行为录制头，其后每行为一条上行或下行消息（消息体均为明文、未压缩）
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zGameServer/db/models"
+)
+
+// Direction 消息方向
+type Direction string
+
+const (
+	DirectionIn  Direction = "in"  // 客户端 -> 服务器
+	DirectionOut Direction = "out" // 服务器 -> 客户端
+)
+
+// RedactedPassword 录制时替换登录与建号请求中密码的占位值，重放时以该密码初始化账号
+const RedactedPassword = "<redacted>"
+
+// Header 录制头
+type Header struct {
+	SessionId uint64    `json:"session_id"`
+	Account   string    `json:"account,omitempty"` // 按账号开启录制时的账号名
+	StartTime time.Time `json:"start_time"`
+}
+
+// Seed 录制开始时的账号数据，重放时写入内存数据库以复现登录
+// 账号密码已替换为RedactedPassword
+type Seed struct {
+	Account *models.Account  `json:"account"`
+	Players []*models.Player `json:"players,omitempty"`
+}
+
+// Record 一条录制的消息
+type Record struct {
+	Time    int64     `json:"t"` // 距录制开始的纳秒数
+	Dir     Direction `json:"dir"`
+	ProtoId int32     `json:"proto_id"`
+	Version int32     `json:"version,omitempty"`
+	Data    []byte    `json:"data,omitempty"`
+	Seed    *Seed     `json:"seed,omitempty"` // 账号数据（仅登录时写入一次，此时其他字段为空）
+}
+
+// Capture 已加载的录制文件
+type Capture struct {
+	Header  Header
+	Records []Record
+}
+
+// Writer 录制文件写入器（并发安全）
+type Writer struct {
+	mu     sync.Mutex
+	file   *os.File
+	buf    *bufio.Writer
+	enc    *json.Encoder
+	header Header
+	path   string
+	closed bool
+}
+
+// NewWriter 创建录制文件并写入录制头
+// 参数:
+//   - dir: 录制文件目录（不存在时自动创建）
+//   - header: 录制头
+//
+// 返回:
+//   - *Writer: 录制文件写入器
+//   - error: 创建错误
+func NewWriter(dir string, header Header) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	label := header.Account
+	if label == "" {
+		label = "session"
+	}
+	name := fmt.Sprintf("%s-%d-%s.jsonl", label, header.SessionId, header.StartTime.Format("20060102-150405"))
+	path := filepath.Join(dir, filepath.Base(name))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Writer{
+		file:   file,
+		buf:    bufio.NewWriter(file),
+		header: header,
+		path:   path,
+	}
+	w.enc = json.NewEncoder(w.buf)
+	if err := w.enc.Encode(&header); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Path 录制文件路径
+func (w *Writer) Path() string {
+	return w.path
+}
+
+// Account 按账号开启录制时的账号名
+func (w *Writer) Account() string {
+	return w.header.Account
+}
+
+// Write 写入一条消息
+// 每条消息写入后立即刷新，服务器异常退出时录制内容不丢失
+func (w *Writer) Write(dir Direction, protoId int32, version int32, data []byte) error {
+	return w.write(&Record{
+		Time:    time.Since(w.header.StartTime).Nanoseconds(),
+		Dir:     dir,
+		ProtoId: protoId,
+		Version: version,
+		Data:    data,
+	})
+}
+
+// WriteSeed 写入账号数据
+func (w *Writer) WriteSeed(seed *Seed) error {
+	return w.write(&Record{
+		Time: time.Since(w.header.StartTime).Nanoseconds(),
+		Seed: seed,
+	})
+}
+
+// write 编码并刷新一条记录
+func (w *Writer) write(record *Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if err := w.enc.Encode(record); err != nil {
+		return err
+	}
+	return w.buf.Flush()
+}
+
+// Close 关闭录制文件
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+// ErrWriterClosed 录制文件已关闭
+var ErrWriterClosed = errors.New("capture writer closed")
+
+// Load 加载录制文件
+// 参数:
+//   - path: 录制文件路径
+//
+// 返回:
+//   - *Capture: 录制内容
+//   - error: 读取或解析错误
+func Load(path string) (*Capture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(file))
+	c := &Capture{}
+	if err := dec.Decode(&c.Header); err != nil {
+		return nil, fmt.Errorf("read capture header: %w", err)
+	}
+	for dec.More() {
+		var record Record
+		if err := dec.Decode(&record); err != nil {
+			return nil, fmt.Errorf("read capture record %d: %w", len(c.Records)+1, err)
+		}
+		c.Records = append(c.Records, record)
+	}
+	return c, nil
+}
+
+// Seed 获取录制中的账号数据（未录制登录时返回nil）
+func (c *Capture) Seed() *Seed {
+	for _, record := range c.Records {
+		if record.Seed != nil {
+			return record.Seed
+		}
+	}
+	return nil
+}
+
+// LoadInbound 加载目录下所有录制文件（*.jsonl）中的上行消息
+// 模糊测试以此作为种子语料，目录不存在时返回空列表
+// 参数:
+//   - dir: 录制文件目录
+//
+// 返回:
+//   - []Record: 按文件名与录制顺序排列的上行消息
+//   - error: 读取或解析错误
+func LoadInbound(dir string) ([]Record, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, path := range paths {
+		c, err := Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for _, record := range c.Records {
+			if record.Dir == DirectionIn {
+				records = append(records, record)
+			}
+		}
+	}
+	return records, nil
+}
+
+// 按账号开启的录制（账号登录时开始录制其会话）
+var (
+	accounts   = make(map[string]bool)
+	accountsMu sync.RWMutex
+)
+
+// SetAccountEnabled 开启或关闭账号的录制
+func SetAccountEnabled(account string, enable bool) {
+	accountsMu.Lock()
+	defer accountsMu.Unlock()
+	if enable {
+		accounts[account] = true
+	} else {
+		delete(accounts, account)
+	}
+}
+
+// IsAccountEnabled 账号是否开启了录制
+func IsAccountEnabled(account string) bool {
+	accountsMu.RLock()
+	defer accountsMu.RUnlock()
+	return accounts[account]
+}
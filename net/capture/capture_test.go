@@ -0,0 +1,48 @@
+package capture
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pzqf/zGameServer/db/models"
+)
+
+// 测试录制文件写入后按原顺序加载
+func TestWriterLoadRoundTrip(t *testing.T) {
+	header := Header{SessionId: 42, Account: "tester", StartTime: time.Now()}
+	w, err := NewWriter(t.TempDir(), header)
+	if err != nil {
+		t.Fatalf("new writer: %v", err)
+	}
+
+	w.Write(DirectionIn, 1002, 1, []byte{0, 0, 0, 1, 0xAA})
+	w.WriteSeed(&Seed{Account: &models.Account{AccountID: 7, AccountName: "tester"}})
+	w.Write(DirectionOut, 1002, 1, []byte{0xBB})
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if err := w.Write(DirectionIn, 1, 1, nil); err != ErrWriterClosed {
+		t.Fatalf("expected ErrWriterClosed, got %v", err)
+	}
+
+	c, err := Load(w.Path())
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if c.Header.SessionId != 42 || c.Header.Account != "tester" {
+		t.Fatalf("unexpected header: %+v", c.Header)
+	}
+	if len(c.Records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(c.Records))
+	}
+	if c.Records[0].Dir != DirectionIn || !bytes.Equal(c.Records[0].Data, []byte{0, 0, 0, 1, 0xAA}) {
+		t.Fatalf("unexpected inbound record: %+v", c.Records[0])
+	}
+	if c.Records[2].Dir != DirectionOut || c.Records[2].Time < c.Records[0].Time {
+		t.Fatalf("unexpected outbound record: %+v", c.Records[2])
+	}
+	if seed := c.Seed(); seed == nil || seed.Account.AccountID != 7 {
+		t.Fatalf("unexpected seed: %+v", seed)
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// registerCaptureRedactors 录制时将登录与建号请求中的密码替换为占位值，并清除断线重连请求中的恢复令牌
+func registerCaptureRedactors(packetRouter *router.PacketRouter) {
+	p := packetRouter.GetProtocol()
+	protolayer.SetCaptureRedactor(int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN), func(version int32, data []byte) []byte {
+		req := &protocol.AccountLoginRequest{}
+		return redactRequest(p, version, data, req, func() { req.Password = capture.RedactedPassword })
+	})
+	protolayer.SetCaptureRedactor(int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_CREATE), func(version int32, data []byte) []byte {
+		req := &protocol.AccountCreateRequest{}
+		return redactRequest(p, version, data, req, func() { req.Password = capture.RedactedPassword })
+	})
+	protolayer.SetCaptureRedactor(int32(protocol.PlayerMsgId_MSG_PLAYER_RECONNECT), func(version int32, data []byte) []byte {
+		req := &protocol.PlayerReconnectRequest{}
+		return redactRequest(p, version, data, req, func() { req.ResumeToken = "" })
+	})
+}
+
+// registerCaptureSeeder 管理接口在会话中途开启录制时，按会话登录的账号补写账号数据
+func registerCaptureSeeder(h *PlayerHandler) {
+	protolayer.SetCaptureSeeder(h.captureSeed)
+}
+
+// captureSeed 获取会话登录账号的账号与角色数据，会话未登录账号或查询失败时返回nil
+func (h *PlayerHandler) captureSeed(sessionId zNet.SessionIdType) *capture.Seed {
+	accountName, ok := h.getSessionAccount(sessionId)
+	if !ok {
+		return nil
+	}
+	account, err := db.GetMgr().AccountRepository.GetByName(accountName)
+	if err != nil || account == nil {
+		zLog.Warn("Failed to load account for capture seed", zap.String("account", accountName), zap.Error(err))
+		return nil
+	}
+	players, err := db.GetMgr().PlayerRepository.GetByAccountID(account.AccountID)
+	if err != nil {
+		zLog.Warn("Failed to load players for capture seed", zap.String("account", accountName), zap.Error(err))
+		players = nil
+	}
+
+	seedAccount := *account
+	seedAccount.Password = ""
+	return &capture.Seed{Account: &seedAccount, Players: players}
+}
+
+// redactRequest 解码请求消息体（保留请求序号前缀），修改后重新编码
+// 无法解码时只保留请求序号，避免敏感内容写入录制文件
+func redactRequest(p protolayer.Protocol, version int32, data []byte, req interface{}, redact func()) []byte {
+	prefix, body, ok := protolayer.SplitRequest(version, data)
+	if !ok {
+		return nil
+	}
+	if err := p.Unmarshal(body, req); err != nil {
+		return prefix
+	}
+	redact()
+	body, err := p.Marshal(req)
+	if err != nil {
+		return prefix
+	}
+	redacted := make([]byte, 0, len(prefix)+len(body))
+	redacted = append(redacted, prefix...)
+	return append(redacted, body...)
+}
+
+// captureLogin 登录成功时处理会话录制
+// 账号开启了录制时开始录制并补录登录请求；正在录制的会话写入账号数据，供重放时初始化数据库
+// 注意: 需在回复登录响应之前调用，保证录制顺序与实际顺序一致
+func captureLogin(ctx *router.Context, account *models.Account, players []*models.Player) {
+	layered, ok := ctx.Session.(*protolayer.LayeredSession)
+	if !ok {
+		return
+	}
+
+	if !layered.IsCapturing() && capture.IsAccountEnabled(account.AccountName) {
+		if _, err := layered.StartCapture(account.AccountName); err != nil {
+			zLog.Warn("Failed to start account capture", zap.String("account", account.AccountName), zap.Error(err))
+			return
+		}
+		layered.RecordInbound(ctx.Packet)
+	}
+	if !layered.IsCapturing() {
+		return
+	}
+
+	seedAccount := *account
+	seedAccount.Password = ""
+	layered.RecordSeed(&capture.Seed{Account: &seedAccount, Players: players})
+}
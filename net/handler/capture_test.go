@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/router"
+)
+
+func TestCaptureSeedForLoggedInSession(t *testing.T) {
+	db.InitMemoryDBManager()
+	h := NewPlayerNetHandler(router.NewPacketRouter(), player.NewPlayerService(), nil)
+
+	const accountID, playerID = 6121, 6221
+	if _, err := db.GetMgr().AccountRepository.Create(&models.Account{AccountID: accountID, AccountName: "capture_owner", Password: "hash"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetMgr().PlayerRepository.Create(&models.Player{PlayerID: playerID, AccountID: accountID, PlayerName: "Recorded"}); err != nil {
+		t.Fatal(err)
+	}
+
+	session := &recordSession{fuzzSession: fuzzSession{sid: zNet.SessionIdType(6321)}}
+	if seed := h.captureSeed(session.GetSid()); seed != nil {
+		t.Fatal("expected no seed before account login")
+	}
+
+	h.sessionAccount[session.GetSid()] = "capture_owner"
+	seed := h.captureSeed(session.GetSid())
+	if seed == nil || seed.Account == nil || seed.Account.AccountID != accountID {
+		t.Fatalf("unexpected seed %+v", seed)
+	}
+	if seed.Account.Password != "" {
+		t.Fatal("seed must not contain the password hash")
+	}
+	if len(seed.Players) != 1 || seed.Players[0].PlayerID != playerID {
+		t.Fatalf("unexpected seed players %+v", seed.Players)
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/game/chardelete"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// handlePlayerDelete 申请删除角色，角色进入删除冷却期
+// 在线（含断线保留中）的角色不能删除
+func (h *PlayerHandler) handlePlayerDelete(ctx *router.Context, req *protocol.PlayerDeleteRequest) error {
+	accountID, code := h.sessionAccountID(ctx)
+	if code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
+	}
+	// 在线或排队登录中的角色不能删除，避免排队放行后登录待删除的角色
+	playerId := common.PlayerIdType(req.PlayerId)
+	if h.playerService.GetPlayerActor(playerId) != nil || h.playerService.GetLoginQueue().ContainsPlayer(playerId) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_ALREADY_LOGGED_IN)
+	}
+
+	pl, err := h.charDelete.RequestDelete(accountID, req.PlayerId, time.Now())
+	if err != nil {
+		code := chardelete.ErrorCode(err)
+		if code == protocol.ErrorCode_ERR_SERVER {
+			zLog.Error("Failed to delete player", zap.Int64("playerId", req.PlayerId), zap.Error(err))
+		}
+		return ctx.ReplyError(code)
+	}
+
+	resp := protocol.PlayerDeleteResponse{
+		Success:  true,
+		PlayerId: pl.PlayerID,
+		DeleteAt: pl.DeleteAt.Unix(),
+	}
+	return ctx.Reply(&resp)
+}
+
+// handlePlayerRestore 恢复删除冷却期内的角色
+func (h *PlayerHandler) handlePlayerRestore(ctx *router.Context, req *protocol.PlayerRestoreRequest) error {
+	accountID, code := h.sessionAccountID(ctx)
+	if code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
+	}
+
+	pl, err := h.charDelete.Restore(accountID, req.PlayerId, time.Now())
+	if err != nil {
+		code := chardelete.ErrorCode(err)
+		if code == protocol.ErrorCode_ERR_SERVER {
+			zLog.Error("Failed to restore player", zap.Int64("playerId", req.PlayerId), zap.Error(err))
+		}
+		return ctx.ReplyError(code)
+	}
+
+	resp := protocol.PlayerRestoreResponse{
+		Success: true,
+		Player:  newPlayerInfo(pl),
+	}
+	return ctx.Reply(&resp)
+}
+
+// sessionAccountID 获取会话已登录账号的账号ID
+// 返回: 账号ID；未登录或查询失败时返回对应的错误码
+func (h *PlayerHandler) sessionAccountID(ctx *router.Context) (int64, protocol.ErrorCode) {
+	account, ok := h.getSessionAccount(ctx.Session.GetSid())
+	if !ok {
+		return 0, protocol.ErrorCode_ERR_ACCOUNT_NOT_LOGGED_IN
+	}
+	accountObj, err := db.GetMgr().AccountRepository.GetByName(account)
+	if err != nil || accountObj == nil {
+		zLog.Error("Failed to get account", zap.String("account", account), zap.Error(err))
+		return 0, protocol.ErrorCode_ERR_SERVER
+	}
+	return accountObj.AccountID, protocol.ErrorCode_ERR_OK
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/game/auction"
+	"github.com/pzqf/zGameServer/game/chardelete"
+	"github.com/pzqf/zGameServer/game/guild"
+	"github.com/pzqf/zGameServer/game/maps"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+)
+
+// fuzzSession 丢弃下行消息的测试会话
+type fuzzSession struct {
+	sid zNet.SessionIdType
+}
+
+func (s *fuzzSession) GetSid() zNet.SessionIdType            { return s.sid }
+func (s *fuzzSession) Send(protoId int32, data []byte) error { return nil }
+func (s *fuzzSession) Close()                                {}
+
+var (
+	fuzzRouterOnce sync.Once
+	fuzzRouter     *router.PacketRouter
+	fuzzMsgIds     []int32 // 已注册处理函数的请求消息ID
+	fuzzSessionId  atomic.Uint64
+)
+
+// setupFuzzRouter 以内存数据库创建注册了全部处理器的路由
+// 只挂载解包中间件：不含Recovery，处理函数中的panic直接暴露给模糊测试；不含状态校验，未登录时也执行全部处理函数
+func setupFuzzRouter() {
+	fuzzRouterOnce.Do(func() {
+		db.InitMemoryDBManager()
+		fuzzRouter = router.NewPacketRouter()
+		fuzzRouter.Use(router.PacketCodec(fuzzRouter, protolayer.GetSecurityLayer(), int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE), metrics.GetNetworkMetrics()))
+		guildService, auctionService := guild.NewGuildService(), auction.NewAuctionService()
+		Init(fuzzRouter, player.NewPlayerService(), guildService, auctionService, maps.NewMapService(), chardelete.NewCharDeleteService(guildService, auctionService))
+		fuzzRouter.RegisterSessionCloseHandler(protolayer.RemoveSession)
+
+		for _, info := range msgreg.All() {
+			if info.IsRequest() && fuzzRouter.HasHandler(info.Id) {
+				fuzzMsgIds = append(fuzzMsgIds, info.Id)
+			}
+		}
+	})
+}
+
+// 路由任意请求：每次在新会话上处理一条消息，处理函数不应panic
+// 登录后才会处理的玩家消息由game/player的FuzzPlayerMsgHandlers覆盖
+func FuzzHandlers(f *testing.F) {
+	setupFuzzRouter()
+
+	records, err := capture.LoadInbound("../../testdata/captures")
+	if err != nil {
+		f.Fatalf("load captures: %v", err)
+	}
+	for _, record := range records {
+		f.Add(record.ProtoId, record.Version, record.Data)
+	}
+
+	f.Fuzz(func(t *testing.T, protoId int32, version int32, data []byte) {
+		// 未注册的消息ID映射到已注册的请求，集中覆盖处理函数
+		if !fuzzRouter.HasHandler(protoId) {
+			index := int(uint32(protoId) % uint32(len(fuzzMsgIds)))
+			protoId = fuzzMsgIds[index]
+		}
+
+		session := protolayer.WrapSession(&fuzzSession{sid: zNet.SessionIdType(fuzzSessionId.Add(1))})
+		defer fuzzRouter.OnSessionClose(session.GetSid())
+
+		packet := &zNet.NetPacket{ProtoId: protoId, Version: version, DataSize: int32(len(data)), Data: data}
+		fuzzRouter.Route(session, packet)
+	})
+}
@@ -3,28 +3,88 @@ package handler
 import (
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zGameServer/game/auction"
+	"github.com/pzqf/zGameServer/game/chardelete"
 	"github.com/pzqf/zGameServer/game/guild"
 	"github.com/pzqf/zGameServer/game/maps"
 	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
 	"github.com/pzqf/zGameServer/net/router"
 )
 
+//go:generate go run ../../tools/msggen -handlers msg_handlers.gen.go -external MSG_SYSTEM_UDP_BIND
+
+// msgHandlerSet 网络层直接处理的请求消息的处理器集合，需实现生成的msgHandlers接口
+type msgHandlerSet struct {
+	*SystemHandler
+	*PlayerHandler
+	*MapHandler
+}
+
+// msgAllowedStates 网络层直接处理的请求消息允许的会话状态，未声明的消息仅游戏中可用
+var msgAllowedStates = map[int32][]router.SessionState{
+	int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE): {router.SessionStateConnected},
+	int32(protocol.SystemMsgId_MSG_SYSTEM_PING):      {router.SessionStateConnected, router.SessionStateAuthenticated, router.SessionStateInGame},
+	int32(protocol.SystemMsgId_MSG_SYSTEM_PING_ACK):  {router.SessionStateConnected, router.SessionStateAuthenticated, router.SessionStateInGame},
+
+	int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_CREATE): {router.SessionStateConnected},
+	int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN):  {router.SessionStateConnected, router.SessionStateAuthenticated},
+	int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE):  {router.SessionStateAuthenticated},
+	// 已登录角色的会话重复发送登录当前角色时直接回复登录结果
+	int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN): {router.SessionStateAuthenticated, router.SessionStateInGame},
+	int32(protocol.PlayerMsgId_MSG_PLAYER_RECONNECT):    {router.SessionStateConnected},
+	// 删除与恢复角色仅在选择角色界面进行
+	int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_DELETE):  {router.SessionStateAuthenticated},
+	int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_RESTORE): {router.SessionStateAuthenticated},
+}
+
 // Init 初始化所有处理器
 func Init(router *router.PacketRouter,
 	playerService *player.PlayerService,
 	guildService *guild.GuildService,
 	auctionService *auction.AuctionService,
-	mapService *maps.MapService) {
+	mapService *maps.MapService,
+	charDeleteService *chardelete.CharDeleteService) {
 
 	zLog.Info("Initializing handlers...")
 
-	// 注册玩家网络处理器
-	RegisterPlayerNetHandlers(router, playerService)
+	playerHandler := NewPlayerNetHandler(router, playerService, charDeleteService)
+
+	// 注册网络层直接处理的请求消息（处理接口由game.proto生成）
+	registerMsgHandlers(router, &msgHandlerSet{
+		SystemHandler: NewSystemNetHandler(protolayer.GetSecurityLayer()),
+		PlayerHandler: playerHandler,
+		MapHandler:    NewMapNetHandler(mapService),
+	})
 
-	// 注册其他模块的处理器（根据需要添加）
+	// 注册玩家会话关闭处理与转发给玩家Actor的消息
+	RegisterPlayerNetHandlers(router, playerHandler)
+
+	// 声明下行消息的投递方式与发送优先级
+	registerDeliveryModes(router)
+	registerSendPriorities()
+
+	// 流量录制时对敏感字段脱敏，中途开启录制时补写账号数据
+	registerCaptureRedactors(router)
+	registerCaptureSeeder(playerHandler)
+
+	// 公会与拍卖行消息尚未定义请求类型，定义后需在此加入对应的处理器
 	// RegisterGuildHandlers(router, guildService)
 	// RegisterAuctionHandlers(router, auctionService)
-	// RegisterMapHandlers(router, mapService)
 
 	zLog.Info("All handlers initialized")
 }
+
+// registerDeliveryModes 声明下行消息的投递方式，未声明的消息可靠有序投递
+func registerDeliveryModes(packetRouter *router.PacketRouter) {
+	// 地图对象同步只需最新状态，绑定UDP通道后允许丢包以避免队头阻塞
+	packetRouter.SetDeliveryMode(int32(protocol.MapMsgId_MSG_MAP_SYNC_OBJECTS), router.DeliveryUnreliableSequenced)
+}
+
+// registerSendPriorities 声明下行消息的发送优先级，未声明的消息为普通优先级
+func registerSendPriorities() {
+	protolayer.SetSendPriority(int32(protocol.SystemMsgId_MSG_SYSTEM_KICK), protolayer.SendPriorityControl)
+	protolayer.SetSendPriority(int32(protocol.SystemMsgId_MSG_SYSTEM_PING), protolayer.SendPriorityControl)
+	// 地图对象同步频繁且可由后续同步覆盖，积压时最先丢弃
+	protolayer.SetSendPriority(int32(protocol.MapMsgId_MSG_MAP_SYNC_OBJECTS), protolayer.SendPriorityBulk)
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+// loginSession 会话的登录信息，登出、断开与踢下线时据此计算会话时长
+type loginSession struct {
+	accountID     int64
+	ip            string
+	deviceID      string
+	deviceType    int32
+	loginAt       time.Time // 账号登录时间
+	playerID      int64
+	playerName    string
+	playerLoginAt time.Time // 角色登录时间，未登录角色时为零值
+}
+
+// loginHistory 登录历史记录器
+// 记录账号登录、角色登录、登出、断开与踢下线到登录日志，踢下线的会话随后断开时不再重复记录
+type loginHistory struct {
+	mu       sync.Mutex
+	sessions map[zNet.SessionIdType]*loginSession
+}
+
+// newLoginHistory 创建登录历史记录器
+func newLoginHistory() *loginHistory {
+	return &loginHistory{sessions: make(map[zNet.SessionIdType]*loginSession)}
+}
+
+// AccountLogin 记录账号登录（含创建账号后直接登录）
+// 参数:
+//   - session: 登录的会话
+//   - accountID: 账号ID
+//   - deviceID: 客户端上报的设备ID
+//   - deviceType: 客户端上报的设备类型
+//   - reason: 补充说明
+func (lh *loginHistory) AccountLogin(session zNet.Session, accountID int64, deviceID string, deviceType int32, reason string) {
+	now := time.Now()
+	ls := &loginSession{
+		accountID:  accountID,
+		ip:         sessionRemoteIP(session),
+		deviceID:   deviceID,
+		deviceType: deviceType,
+		loginAt:    now,
+	}
+
+	lh.mu.Lock()
+	lh.sessions[session.GetSid()] = ls
+	lh.mu.Unlock()
+
+	lh.write(ls, models.LoginOpAccountLogin, now, 0, reason)
+}
+
+// PlayerLogin 记录角色登录
+// 断线重连的新会话没有账号登录记录，按角色所属账号补建，设备信息沿用同账号旧会话
+// 参数:
+//   - session: 登录的会话
+//   - pl: 登录的角色
+//   - reason: 补充说明
+func (lh *loginHistory) PlayerLogin(session zNet.Session, pl *models.Player, reason string) {
+	now := time.Now()
+
+	lh.mu.Lock()
+	ls, ok := lh.sessions[session.GetSid()]
+	if !ok {
+		ls = &loginSession{accountID: pl.AccountID, ip: sessionRemoteIP(session), loginAt: now}
+		for _, other := range lh.sessions {
+			if other.accountID == pl.AccountID {
+				ls.deviceID, ls.deviceType = other.deviceID, other.deviceType
+				break
+			}
+		}
+		lh.sessions[session.GetSid()] = ls
+	}
+	ls.playerID = pl.PlayerID
+	ls.playerName = pl.PlayerName
+	ls.playerLoginAt = now
+	entry := *ls
+	lh.mu.Unlock()
+
+	lh.write(&entry, models.LoginOpPlayerLogin, now, 0, reason)
+}
+
+// PlayerLogout 记录角色登出，会话保留账号登录状态
+func (lh *loginHistory) PlayerLogout(sessionId zNet.SessionIdType) {
+	now := time.Now()
+
+	lh.mu.Lock()
+	ls, ok := lh.sessions[sessionId]
+	if !ok || ls.playerID == 0 {
+		lh.mu.Unlock()
+		return
+	}
+	entry := *ls
+	ls.playerID, ls.playerName, ls.playerLoginAt = 0, "", time.Time{}
+	lh.mu.Unlock()
+
+	lh.write(&entry, models.LoginOpLogout, now, now.Sub(entry.playerLoginAt), "")
+}
+
+// Kick 记录踢下线
+// 参数:
+//   - sessionId: 被踢的会话ID
+//   - reason: 踢下线原因
+//   - message: 推送给客户端的提示
+func (lh *loginHistory) Kick(sessionId zNet.SessionIdType, reason protocol.KickReason, message string) {
+	if ls, ok := lh.remove(sessionId); ok {
+		now := time.Now()
+		lh.write(ls, models.LoginOpKick, now, ls.duration(now), reason.String()+": "+message)
+	}
+}
+
+// Disconnect 记录连接断开，未登录账号或已记录踢下线的会话不记录
+func (lh *loginHistory) Disconnect(sessionId zNet.SessionIdType) {
+	if ls, ok := lh.remove(sessionId); ok {
+		now := time.Now()
+		lh.write(ls, models.LoginOpDisconnect, now, ls.duration(now), "")
+	}
+}
+
+// remove 移除会话的登录信息
+func (lh *loginHistory) remove(sessionId zNet.SessionIdType) (*loginSession, bool) {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+
+	ls, ok := lh.sessions[sessionId]
+	if ok {
+		delete(lh.sessions, sessionId)
+	}
+	return ls, ok
+}
+
+// duration 会话时长：已登录角色时从角色登录起算，否则从账号登录起算
+func (ls *loginSession) duration(now time.Time) time.Duration {
+	if !ls.playerLoginAt.IsZero() {
+		return now.Sub(ls.playerLoginAt)
+	}
+	return now.Sub(ls.loginAt)
+}
+
+// write 异步写入登录日志，未启用或数据仓库未初始化时跳过
+func (lh *loginHistory) write(ls *loginSession, opType int32, now time.Time, duration time.Duration, reason string) {
+	if !config.GetLoginLogConfig().Enabled || db.GetMgr() == nil || db.GetMgr().LoginLogRepository == nil {
+		return
+	}
+
+	loginLog := &models.LoginLog{
+		PlayerID:   ls.playerID,
+		PlayerName: ls.playerName,
+		OpType:     opType,
+		IP:         ls.ip,
+		Device:     ls.deviceID,
+		CreatedAt:  now,
+		AccountID:  ls.accountID,
+		DeviceType: ls.deviceType,
+		Duration:   int64(duration / time.Second),
+		Reason:     reason,
+	}
+	db.GetMgr().LoginLogRepository.CreateAsync(loginLog, func(_ int64, err error) {
+		if err != nil {
+			zLog.Warn("Failed to write login log",
+				zap.Int64("accountId", loginLog.AccountID),
+				zap.Int32("opType", opType),
+				zap.Error(err))
+		}
+	})
+}
+
+// sessionRemoteIP 获取会话的客户端IP，会话不提供地址时返回空字符串
+func sessionRemoteIP(session zNet.Session) string {
+	if s, ok := session.(interface{ RemoteIP() string }); ok {
+		return s.RemoteIP()
+	}
+	return ""
+}
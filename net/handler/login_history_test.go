@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// ipSession 带客户端IP的测试会话
+type ipSession struct {
+	fuzzSession
+	ip string
+}
+
+func (s *ipSession) RemoteIP() string { return s.ip }
+
+func TestLoginHistory(t *testing.T) {
+	db.InitMemoryDBManager()
+	lh := newLoginHistory()
+	// 内存数据仓库为全局实例，只查询本次写入的日志
+	start := time.Now()
+
+	const accountID = 7001
+	first := &ipSession{fuzzSession: fuzzSession{sid: 9001}, ip: "10.0.0.1"}
+	pl := &models.Player{PlayerID: 8001, AccountID: accountID, PlayerName: "Archer"}
+
+	lh.AccountLogin(first, accountID, "dev-1", 2, "")
+	lh.PlayerLogin(first, pl, "")
+	lh.PlayerLogout(first.GetSid())
+	lh.Kick(first.GetSid(), protocol.KickReason_KICK_REASON_BANNED, "banned")
+	// 踢下线后连接断开不再重复记录
+	lh.Disconnect(first.GetSid())
+
+	// 重连的新会话沿用同账号旧会话的设备信息（旧会话已移除时为空）
+	second := &ipSession{fuzzSession: fuzzSession{sid: 9002}, ip: "10.0.0.2"}
+	lh.PlayerLogin(second, pl, "reconnect")
+	lh.Disconnect(second.GetSid())
+
+	logs, err := db.GetMgr().LoginLogRepository.Query(&models.LoginLogFilter{AccountID: accountID, Start: start})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int32{
+		models.LoginOpDisconnect,
+		models.LoginOpPlayerLogin,
+		models.LoginOpKick,
+		models.LoginOpLogout,
+		models.LoginOpPlayerLogin,
+		models.LoginOpAccountLogin,
+	}
+	if len(logs) != len(want) {
+		t.Fatalf("expected %d logs, got %d", len(want), len(logs))
+	}
+	for i, op := range want {
+		if logs[i].OpType != op {
+			t.Fatalf("log %d: expected op %d, got %d", i, op, logs[i].OpType)
+		}
+	}
+
+	if l := logs[5]; l.IP != "10.0.0.1" || l.Device != "dev-1" || l.DeviceType != 2 {
+		t.Fatalf("unexpected account login log %+v", l)
+	}
+	if l := logs[3]; l.PlayerID != pl.PlayerID || l.PlayerName != pl.PlayerName {
+		t.Fatalf("expected logout to record the player, got %+v", l)
+	}
+	if l := logs[2]; l.PlayerID != 0 || l.Reason == "" {
+		t.Fatalf("expected kick after logout without player and with reason, got %+v", l)
+	}
+	if l := logs[1]; l.IP != "10.0.0.2" || l.Reason != "reconnect" {
+		t.Fatalf("unexpected reconnect log %+v", l)
+	}
+
+	byIP, err := db.GetMgr().LoginLogRepository.Query(&models.LoginLogFilter{IP: "10.0.0.1", Start: start, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byIP) != 2 || byIP[0].OpType != models.LoginOpKick {
+		t.Fatalf("unexpected ip query result %+v", byIP)
+	}
+}
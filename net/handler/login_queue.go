@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// queuedLogin 排队中的角色登录请求，放行后以原请求的上下文回复登录结果
+type queuedLogin struct {
+	ctx    *router.Context
+	player *models.Player
+}
+
+// enqueuePlayerLogin 服务器满员时将角色登录加入排队
+// 入队后立即推送排队进度，登录响应在放行或超时后回复
+// 参数:
+//   - ctx: 角色登录请求的消息处理上下文
+//   - pl: 登录的角色
+//   - isGM: 是否GM账号
+func (h *PlayerHandler) enqueuePlayerLogin(ctx *router.Context, pl *models.Player, isGM bool) error {
+	if !config.GetLoginQueueConfig().Enabled {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER_FULL)
+	}
+
+	entry := &player.LoginQueueEntry{
+		SessionId: ctx.Session.GetSid(),
+		PlayerId:  common.PlayerIdType(pl.PlayerID),
+		VipLevel:  pl.VipLevel,
+		IsGM:      isGM,
+		Data:      &queuedLogin{ctx: ctx, player: pl},
+	}
+	status, err := h.playerService.GetLoginQueue().Enqueue(entry, time.Now())
+	if player.IsLoginQueueDuplicate(err) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_LOGIN_QUEUED)
+	}
+	if err != nil {
+		zLog.Warn("Login queue full, player login rejected", zap.Int64("playerId", pl.PlayerID), zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER_FULL)
+	}
+
+	zLog.Info("Player login queued",
+		zap.Int64("playerId", pl.PlayerID),
+		zap.Uint64("sessionId", ctx.Session.GetSid()),
+		zap.Int("vipLevel", pl.VipLevel),
+		zap.Bool("gm", isGM),
+		zap.Int("position", status.Position))
+	h.onLoginQueueNotify(entry, status)
+	return nil
+}
+
+// onLoginQueueAdmit 排到后完成角色登录
+// 排队期间角色可能被申请删除或封禁，重新加载角色并再次检查后再登录
+func (h *PlayerHandler) onLoginQueueAdmit(entry *player.LoginQueueEntry) {
+	queued := entry.Data.(*queuedLogin)
+	if _, ok := h.getSessionAccount(entry.SessionId); !ok {
+		return
+	}
+
+	accountID, code := h.sessionAccountID(queued.ctx)
+	var pl *models.Player
+	if code == protocol.ErrorCode_ERR_OK {
+		var err error
+		pl, err = db.GetMgr().PlayerRepository.GetByID(queued.player.PlayerID)
+		if err != nil || pl == nil {
+			zLog.Error("Failed to reload queued player", zap.Int64("playerId", queued.player.PlayerID), zap.Error(err))
+			code = protocol.ErrorCode_ERR_PLAYER_NOT_FOUND
+		} else {
+			code = checkPlayerLogin(accountID, pl)
+		}
+	}
+	if code != protocol.ErrorCode_ERR_OK {
+		zLog.Info("Queued player login rejected on admit",
+			zap.Int64("playerId", queued.player.PlayerID),
+			zap.String("code", code.String()))
+		if err := queued.ctx.ReplyError(code); err != nil {
+			zLog.Warn("Failed to reply queued player login", zap.Uint64("sessionId", entry.SessionId), zap.Error(err))
+		}
+		return
+	}
+
+	zLog.Info("Player login admitted from queue",
+		zap.Int64("playerId", pl.PlayerID),
+		zap.Duration("waited", time.Since(entry.EnqueuedAt)))
+	if err := h.completePlayerLogin(queued.ctx, pl); err != nil {
+		zLog.Warn("Failed to complete queued player login", zap.Int64("playerId", queued.player.PlayerID), zap.Error(err))
+	}
+}
+
+// onLoginQueueNotify 推送排队进度
+func (h *PlayerHandler) onLoginQueueNotify(entry *player.LoginQueueEntry, status player.LoginQueueStatus) {
+	queued := entry.Data.(*queuedLogin)
+	notify := protocol.LoginQueueNotify{
+		Position:    int32(status.Position),
+		QueueLength: int32(status.Length),
+		EtaSeconds:  int32(status.ETA / time.Second),
+	}
+	if err := queued.ctx.Send(int32(protocol.PlayerMsgId_MSG_PLAYER_LOGIN_QUEUE), &notify); err != nil {
+		zLog.Warn("Failed to send login queue notify", zap.Uint64("sessionId", entry.SessionId), zap.Error(err))
+	}
+}
+
+// onLoginQueueExpire 排队超时，回复登录失败
+func (h *PlayerHandler) onLoginQueueExpire(entry *player.LoginQueueEntry) {
+	queued := entry.Data.(*queuedLogin)
+	zLog.Info("Player login queue timeout", zap.Int64("playerId", queued.player.PlayerID))
+	if err := queued.ctx.ReplyError(protocol.ErrorCode_ERR_LOGIN_QUEUE_TIMEOUT); err != nil {
+		zLog.Warn("Failed to reply login queue timeout", zap.Uint64("sessionId", entry.SessionId), zap.Error(err))
+	}
+}
+
+// isGMAccount 账号是否在登录排队配置的GM账号列表中
+func isGMAccount(account string) bool {
+	if account == "" {
+		return false
+	}
+	for _, name := range strings.Split(config.GetLoginQueueConfig().GMAccounts, ",") {
+		if strings.TrimSpace(name) == account {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/game/maps"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// MapHandler 地图消息处理器
+type MapHandler struct {
+	mapService *maps.MapService
+}
+
+// NewMapNetHandler 创建地图消息处理器
+func NewMapNetHandler(mapService *maps.MapService) *MapHandler {
+	return &MapHandler{
+		mapService: mapService,
+	}
+}
+
+// handleMapMove 处理地图移动
+// 玩家尚未加入地图实例，暂未开放
+func (h *MapHandler) handleMapMove(ctx *router.Context, req *protocol.MapMoveRequest) error {
+	zLog.Debug("Map move not implemented", zap.Uint64("sessionId", ctx.Session.GetSid()), zap.Int64("mapId", req.MapId))
+	return ctx.ReplyError(protocol.ErrorCode_ERR_NOT_IMPLEMENTED)
+}
+
+// handleMapGetPath 处理寻路请求
+// 地图尚无寻路数据，暂未开放
+func (h *MapHandler) handleMapGetPath(ctx *router.Context, req *protocol.MapGetPathRequest) error {
+	zLog.Debug("Map path finding not implemented", zap.Uint64("sessionId", ctx.Session.GetSid()), zap.Int64("mapId", req.MapId))
+	return ctx.ReplyError(protocol.ErrorCode_ERR_NOT_IMPLEMENTED)
+}
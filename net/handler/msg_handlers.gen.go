@@ -0,0 +1,43 @@
+// Code generated by msggen from resources/protocol/game.proto. DO NOT EDIT.
+
+package handler
+
+import (
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/router"
+)
+
+// msgHandlers 网络层直接处理的请求消息
+// 不含玩家Actor处理的消息与由其他模块处理的消息；新增请求消息后未实现对应方法时编译失败
+type msgHandlers interface {
+	handleHandshake(ctx *router.Context, req *protocol.HandshakeRequest) error
+	handlePing(ctx *router.Context, req *protocol.PingRequest) error
+	handlePingAck(ctx *router.Context, req *protocol.PingAckRequest) error
+	handleAccountCreate(ctx *router.Context, req *protocol.AccountCreateRequest) error
+	handleAccountLogin(ctx *router.Context, req *protocol.AccountLoginRequest) error
+	handlePlayerCreate(ctx *router.Context, req *protocol.PlayerCreateRequest) error
+	handlePlayerLogin(ctx *router.Context, req *protocol.PlayerLoginRequest) error
+	handlePlayerLogout(ctx *router.Context, req *protocol.PlayerLogoutRequest) error
+	handlePlayerReconnect(ctx *router.Context, req *protocol.PlayerReconnectRequest) error
+	handlePlayerDelete(ctx *router.Context, req *protocol.PlayerDeleteRequest) error
+	handlePlayerRestore(ctx *router.Context, req *protocol.PlayerRestoreRequest) error
+	handleMapMove(ctx *router.Context, req *protocol.MapMoveRequest) error
+	handleMapGetPath(ctx *router.Context, req *protocol.MapGetPathRequest) error
+}
+
+// registerMsgHandlers 注册网络层直接处理的请求消息，允许的会话状态取自msgAllowedStates
+func registerMsgHandlers(packetRouter *router.PacketRouter, h msgHandlers) {
+	router.RegisterTypedHandler(packetRouter, int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE), h.handleHandshake, msgAllowedStates[int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.SystemMsgId_MSG_SYSTEM_PING), h.handlePing, msgAllowedStates[int32(protocol.SystemMsgId_MSG_SYSTEM_PING)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.SystemMsgId_MSG_SYSTEM_PING_ACK), h.handlePingAck, msgAllowedStates[int32(protocol.SystemMsgId_MSG_SYSTEM_PING_ACK)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_CREATE), h.handleAccountCreate, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_CREATE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN), h.handleAccountLogin, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE), h.handlePlayerCreate, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN), h.handlePlayerLogin, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGOUT), h.handlePlayerLogout, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGOUT)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_RECONNECT), h.handlePlayerReconnect, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_RECONNECT)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_DELETE), h.handlePlayerDelete, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_DELETE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_RESTORE), h.handlePlayerRestore, msgAllowedStates[int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_RESTORE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.MapMsgId_MSG_MAP_MOVE), h.handleMapMove, msgAllowedStates[int32(protocol.MapMsgId_MSG_MAP_MOVE)]...)
+	router.RegisterTypedHandler(packetRouter, int32(protocol.MapMsgId_MSG_MAP_GET_PATH), h.handleMapGetPath, msgAllowedStates[int32(protocol.MapMsgId_MSG_MAP_GET_PATH)]...)
+}
@@ -1,57 +1,67 @@
 package handler
 
 import (
+	"sync"
 	"time"
 
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zNet"
 	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
 	"github.com/pzqf/zGameServer/db"
 	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/chardelete"
+	"github.com/pzqf/zGameServer/game/naming"
 	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/game/sanction"
 	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
 	"github.com/pzqf/zGameServer/net/router"
+	"github.com/pzqf/zGameServer/util"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/proto"
 )
 
 type PlayerHandler struct {
 	playerService  *player.PlayerService
-	accountSession map[string]zNet.SessionIdType
-	sessionAccount map[zNet.SessionIdType]string
-	playerSession  map[common.PlayerIdType]zNet.SessionIdType
-	sessionPlayer  map[zNet.SessionIdType]common.PlayerIdType
+	charDelete     *chardelete.CharDeleteService              // 角色删除服务
+	loginHistory   *loginHistory                              // 登录历史记录器
+	packetRouter   *router.PacketRouter                       // 数据包路由器（维护会话状态）
+	mu             sync.Mutex                                 // 保护以下会话映射表
+	accountSession map[string]zNet.Session                    // 账号 -> 当前登录的会话
+	sessionAccount map[zNet.SessionIdType]string              // 会话ID -> 账号
+	playerSession  map[common.PlayerIdType]zNet.SessionIdType // 玩家ID -> 会话ID
+	sessionPlayer  map[zNet.SessionIdType]common.PlayerIdType // 会话ID -> 玩家ID
 }
 
-func NewPlayerNetHandler(playerService *player.PlayerService) *PlayerHandler {
+func NewPlayerNetHandler(packetRouter *router.PacketRouter, playerService *player.PlayerService, charDelete *chardelete.CharDeleteService) *PlayerHandler {
 	return &PlayerHandler{
 		playerService:  playerService,
-		accountSession: make(map[string]zNet.SessionIdType),
+		charDelete:     charDelete,
+		loginHistory:   newLoginHistory(),
+		packetRouter:   packetRouter,
+		accountSession: make(map[string]zNet.Session),
 		sessionAccount: make(map[zNet.SessionIdType]string),
 		playerSession:  make(map[common.PlayerIdType]zNet.SessionIdType),
 		sessionPlayer:  make(map[zNet.SessionIdType]common.PlayerIdType),
 	}
 }
 
-func RegisterPlayerNetHandlers(router *router.PacketRouter, playerService *player.PlayerService) {
-	// 创建player_handler
+// RegisterPlayerNetHandlers 注册玩家会话关闭处理以及转发给玩家Actor的消息
+// 网络层直接处理的玩家消息由registerMsgHandlers统一注册
+func RegisterPlayerNetHandlers(packetRouter *router.PacketRouter, handler *PlayerHandler) {
+	packetRouter.RegisterSessionCloseHandler(handler.onSessionClose)
+	sanction.GetManager().SetIssueHandler(handler.onSanctionIssued)
+	handler.playerService.GetLoginQueue().SetHandlers(handler.onLoginQueueAdmit, handler.onLoginQueueNotify, handler.onLoginQueueExpire)
 
-	handler := NewPlayerNetHandler(playerService)
-
-	router.RegisterHandler(1001, handler.handleAccountCreate)
-	router.RegisterHandler(1002, handler.handleAccountLogin)
-	router.RegisterHandler(1003, handler.handlePlayerCreate)
-	router.RegisterHandler(1004, handler.handlePlayerLogin)
-	router.RegisterHandler(1005, handler.handlePlayerLogout)
-
-	//todo 将来可优化，明确哪些消息需转发给玩家协程处理
-	for i := 1006; i <= 2000; i++ {
-		router.RegisterHandler(int32(i), handler.handlePlayerMessage)
+	// 转发给玩家协程处理的消息，以玩家Actor注册的分发表为准
+	for _, msgId := range player.GetNetworkMsgIds() {
+		packetRouter.RegisterContextHandler(msgId, handler.handlePlayerMessage)
 	}
 }
 
-func (h *PlayerHandler) handlePlayerMessage(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
-	playerId, ok := h.sessionPlayer[session.GetSid()]
+func (h *PlayerHandler) handlePlayerMessage(ctx *router.Context) error {
+	session := ctx.Session
+	playerId, ok := h.getSessionPlayer(session.GetSid())
 	if !ok {
 		zLog.Warn("Player not found for session", zap.Uint64("sessionId", session.GetSid()))
 		return nil
@@ -63,66 +73,47 @@ func (h *PlayerHandler) handlePlayerMessage(session *zNet.TcpServerSession, pack
 		return nil
 	}
 
-	msg := player.NewPlayerActorNetworkMessage(int64(playerId), packet)
+	msg := player.NewPlayerActorNetworkMessage(int64(playerId), ctx.Packet, ctx.Protocol)
 	playerActor.SendMessage(msg)
 	return nil
 }
 
-func (h *PlayerHandler) handleAccountCreate(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+func (h *PlayerHandler) handleAccountCreate(ctx *router.Context, req *protocol.AccountCreateRequest) error {
+	session := ctx.Session
 	zLog.Debug("Received account create request", zap.Uint64("sessionId", session.GetSid()))
 
-	var req protocol.AccountCreateRequest
-	if err := proto.Unmarshal(packet.Data, &req); err != nil {
-		zLog.Error("Failed to unmarshal account create request", zap.Error(err))
-		return err
-	}
-
 	if req.Account == "" || req.Password == "" {
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "账号或密码不能为空",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_EMPTY)
 	}
 
 	account, err := db.GetMgr().AccountRepository.GetByName(req.Account)
 	if err != nil {
 		zLog.Error("Failed to check account existence", zap.Error(err))
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
 	if account != nil {
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "账号已存在",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_EXISTS)
 	}
 
 	accountID, err := common.GenerateAccountID()
 	if err != nil {
 		zLog.Error("Failed to generate account ID", zap.Error(err))
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+
+	passwordHash, err := util.HashPassword(req.Password)
+	if err != nil {
+		zLog.Error("Failed to hash password", zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
 	now := time.Now()
 	newAccount := &models.Account{
 		AccountID:   int64(accountID),
 		AccountName: req.Account,
-		Password:    req.Password,
-		Status:      1,
+		Password:    passwordHash,
+		Status:      models.AccountStatusNormal,
 		CreatedAt:   now,
 		LastLoginAt: now,
 	}
@@ -130,79 +121,81 @@ func (h *PlayerHandler) handleAccountCreate(session *zNet.TcpServerSession, pack
 	id, err := db.GetMgr().AccountRepository.Create(newAccount)
 	if err != nil {
 		zLog.Error("Failed to create account", zap.Error(err))
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
 	if id <= 0 {
-		resp := protocol.AccountCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1001, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
-	h.accountSession[req.Account] = session.GetSid()
-	h.sessionAccount[session.GetSid()] = req.Account
+	if !h.bindAccountSession(ctx, req.Account) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_LOGGED_ELSEWHERE)
+	}
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateAuthenticated)
+	h.loginHistory.AccountLogin(session, newAccount.AccountID, req.DeviceId, req.DeviceType, "account created")
 
 	resp := protocol.AccountCreateResponse{
 		Success:  true,
 		ErrorMsg: "",
 	}
-	respData, _ := proto.Marshal(&resp)
-	return session.Send(1001, respData)
+	return ctx.Reply(&resp)
 }
 
-func (h *PlayerHandler) handleAccountLogin(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+func (h *PlayerHandler) handleAccountLogin(ctx *router.Context, req *protocol.AccountLoginRequest) error {
+	session := ctx.Session
 	zLog.Info("Received account login request", zap.Int64("sessionId", int64(session.GetSid())))
 
-	var req protocol.AccountLoginRequest
-	if err := proto.Unmarshal(packet.Data, &req); err != nil {
-		zLog.Error("Failed to unmarshal account login request", zap.Error(err))
-		return err
+	version, ok := h.negotiateProtocolVersion(ctx)
+	if !ok {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_CLIENT_VERSION_TOO_OLD)
 	}
 
 	if req.Account == "" || req.Password == "" {
-		resp := protocol.AccountLoginResponse{
-			Success:  false,
-			ErrorMsg: "账号或密码不能为空",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1002, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_EMPTY)
 	}
 
 	account, err := db.GetMgr().AccountRepository.GetByName(req.Account)
 	if err != nil {
 		zLog.Error("Failed to get account", zap.Error(err))
-		resp := protocol.AccountLoginResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1002, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
 	if account == nil {
-		resp := protocol.AccountLoginResponse{
-			Success:  false,
-			ErrorMsg: "账号不存在，请创建账号",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1002, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_NOT_FOUND)
+	}
+
+	match, needsRehash, err := util.VerifyPassword(req.Password, account.Password)
+	if err != nil {
+		zLog.Error("Failed to verify password", zap.String("account", req.Account), zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
-	if account.Password != req.Password {
-		resp := protocol.AccountLoginResponse{
-			Success:  false,
-			ErrorMsg: "账号或密码错误",
+	if !match {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_PASSWORD)
+	}
+
+	if h.isRateLimitBanned(req.Account) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_BANNED)
+	}
+	if code := h.checkAccountSanction(account); code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
+	}
+
+	if !h.bindAccountSession(ctx, req.Account) {
+		zLog.Info("Duplicate account login rejected", zap.String("account", req.Account), zap.Uint64("sessionId", session.GetSid()))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_LOGGED_ELSEWHERE)
+	}
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateAuthenticated)
+	h.loginHistory.AccountLogin(session, account.AccountID, req.DeviceId, req.DeviceType, "")
+
+	// 存量明文密码或旧参数哈希，登录成功后升级为当前参数的哈希
+	if needsRehash {
+		if passwordHash, err := util.HashPassword(req.Password); err != nil {
+			zLog.Error("Failed to rehash password", zap.String("account", req.Account), zap.Error(err))
+		} else {
+			account.Password = passwordHash
+			zLog.Info("Account password rehashed", zap.String("account", req.Account))
 		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1002, respData)
 	}
 
 	account.LastLoginAt = time.Now()
@@ -211,9 +204,6 @@ func (h *PlayerHandler) handleAccountLogin(session *zNet.TcpServerSession, packe
 		zLog.Error("Failed to update last login time", zap.Error(err))
 	}
 
-	h.accountSession[req.Account] = session.GetSid()
-	h.sessionAccount[session.GetSid()] = req.Account
-
 	players, err := db.GetMgr().PlayerRepository.GetByAccountID(account.AccountID)
 	if err != nil {
 		zLog.Error("Failed to get players", zap.Error(err))
@@ -224,73 +214,82 @@ func (h *PlayerHandler) handleAccountLogin(session *zNet.TcpServerSession, packe
 
 	var playerInfos []*protocol.PlayerInfo
 	for _, p := range players {
-		playerInfos = append(playerInfos, &protocol.PlayerInfo{
-			PlayerId: p.PlayerID,
-			Name:     p.PlayerName,
-			Level:    int32(p.Level),
-			Sex:      int32(p.Sex),
-			Age:      int32(p.Age),
-		})
+		playerInfos = append(playerInfos, newPlayerInfo(p))
+	}
+
+	captureLogin(ctx, account, players)
+
+	// 协商压缩与合并写出：响应本身不压缩也不合并，发送后该连接双向都可按包头压缩标志发送压缩消息
+	layered, _ := session.(*protolayer.LayeredSession)
+	compression := ""
+	batch := false
+	if layered != nil {
+		if layered.IsCompressionEnabled() {
+			compression = protolayer.CompressionSnappy
+		} else {
+			compression = protolayer.SelectCompression(layered, req.Compressions)
+		}
+		// 合并写出同样需要客户端声明支持，否则客户端无法拆分MSG_SYSTEM_BATCH
+		batch = layered.IsBatchingEnabled() || protolayer.SelectBatching(req.Batch)
 	}
 
 	resp := protocol.AccountLoginResponse{
-		Success:  true,
-		ErrorMsg: "",
-		Players:  playerInfos,
+		Success:         true,
+		ErrorMsg:        "",
+		Players:         playerInfos,
+		Compression:     compression,
+		ProtocolVersion: version,
+		Batch:           batch,
+	}
+	zLog.Info("Sending account login response",
+		zap.Int("playerCount", len(playerInfos)),
+		zap.String("compression", compression),
+		zap.Bool("batch", batch),
+		zap.Int32("protocolVersion", version))
+	if err := ctx.Reply(&resp); err != nil {
+		return err
+	}
+	if compression != "" {
+		layered.EnableCompression()
 	}
-	respData, _ := proto.Marshal(&resp)
-	zLog.Info("Sending account login response", zap.Int("playerCount", len(playerInfos)), zap.Int("dataSize", len(respData)))
-	return session.Send(1002, respData)
+	if batch {
+		layered.EnableBatching()
+	}
+	return nil
 }
 
-func (h *PlayerHandler) handlePlayerCreate(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+func (h *PlayerHandler) handlePlayerCreate(ctx *router.Context, req *protocol.PlayerCreateRequest) error {
+	session := ctx.Session
 	zLog.Debug("Received player create request", zap.Int64("sessionId", int64(session.GetSid())))
 
-	account, ok := h.sessionAccount[session.GetSid()]
+	account, ok := h.getSessionAccount(session.GetSid())
 	if !ok {
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "请先登录账号",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
-	}
-
-	var req protocol.PlayerCreateRequest
-	if err := proto.Unmarshal(packet.Data, &req); err != nil {
-		zLog.Error("Failed to unmarshal player create request", zap.Error(err))
-		return err
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_NOT_LOGGED_IN)
 	}
 
 	if req.Name == "" {
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "玩家名称不能为空",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_NAME_EMPTY)
 	}
 
 	accountObj, err := db.GetMgr().AccountRepository.GetByName(account)
 	if err != nil || accountObj == nil {
 		zLog.Error("Failed to get account", zap.Error(err))
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
 	playerID, err := common.GeneratePlayerID()
 	if err != nil {
 		zLog.Error("Failed to generate player ID", zap.Error(err))
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+
+	// 名称需符合命名规则且全服唯一（不区分大小写），创建失败时释放占用
+	if err := naming.GetService().Reserve(models.NameKindPlayer, req.Name, int64(playerID)); err != nil {
+		code := naming.ErrorCode(err)
+		if code == protocol.ErrorCode_ERR_SERVER {
+			zLog.Error("Failed to reserve player name", zap.Error(err))
 		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
+		return ctx.ReplyError(code)
 	}
 
 	now := time.Now()
@@ -306,123 +305,160 @@ func (h *PlayerHandler) handlePlayerCreate(session *zNet.TcpServerSession, packe
 	}
 
 	id, err := db.GetMgr().PlayerRepository.Create(newPlayer)
-	if err != nil {
+	if err != nil || id <= 0 {
 		zLog.Error("Failed to create player", zap.Error(err))
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
-	}
-
-	if id <= 0 {
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
-	}
-
-	_, err = h.playerService.CreatePlayerActor(session, common.PlayerIdType(newPlayer.PlayerID), newPlayer.PlayerName)
-	if err != nil {
-		zLog.Error("Failed to create player actor", zap.Error(err))
-		resp := protocol.PlayerCreateResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
+		if err := naming.GetService().Release(models.NameKindPlayer, req.Name, newPlayer.PlayerID); err != nil {
+			zLog.Warn("Failed to release player name", zap.String("name", req.Name), zap.Error(err))
 		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1003, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
 	}
 
-	h.playerSession[common.PlayerIdType(newPlayer.PlayerID)] = session.GetSid()
-	h.sessionPlayer[session.GetSid()] = common.PlayerIdType(newPlayer.PlayerID)
-
+	// 创建角色不进入游戏，客户端随后发送玩家登录，按登录排队与GM预留名额占用在线名额
 	resp := protocol.PlayerCreateResponse{
 		Success:  true,
 		ErrorMsg: "",
-		Player: &protocol.PlayerInfo{
-			PlayerId: newPlayer.PlayerID,
-			Name:     newPlayer.PlayerName,
-			Level:    int32(newPlayer.Level),
-			Sex:      int32(newPlayer.Sex),
-			Age:      int32(newPlayer.Age),
-		},
-	}
-	respData, _ := proto.Marshal(&resp)
-	return session.Send(1003, respData)
+		Player:   newPlayerInfo(newPlayer),
+	}
+	return ctx.Reply(&resp)
 }
 
-func (h *PlayerHandler) handlePlayerLogin(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+func (h *PlayerHandler) handlePlayerLogin(ctx *router.Context, req *protocol.PlayerLoginRequest) error {
+	session := ctx.Session
 	zLog.Debug("Received player login request", zap.Int64("sessionId", int64(session.GetSid())))
 
-	_, ok := h.sessionAccount[session.GetSid()]
-	if !ok {
-		resp := protocol.PlayerLoginResponse{
-			Success:  false,
-			ErrorMsg: "请先登录账号",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1004, respData)
+	accountID, code := h.sessionAccountID(ctx)
+	if code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
 	}
 
-	var req protocol.PlayerLoginRequest
-	if err := proto.Unmarshal(packet.Data, &req); err != nil {
-		zLog.Error("Failed to unmarshal player login request", zap.Error(err))
-		return err
+	if playerId, ok := h.getSessionPlayer(session.GetSid()); ok && playerId != common.PlayerIdType(req.PlayerId) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_LOGOUT_REQUIRED)
 	}
 
 	pl, err := db.GetMgr().PlayerRepository.GetByID(req.PlayerId)
 	if err != nil || pl == nil {
 		zLog.Error("Failed to get player", zap.Error(err))
-		resp := protocol.PlayerLoginResponse{
-			Success:  false,
-			ErrorMsg: "玩家不存在",
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_NOT_FOUND)
+	}
+
+	if code := checkPlayerLogin(accountID, pl); code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
+	}
+
+	// 需要新占用在线名额且服务器满员时进入登录排队，排到后再回复本次登录请求
+	if h.playerService.GetPlayerActor(common.PlayerIdType(pl.PlayerID)) == nil {
+		account, _ := h.getSessionAccount(session.GetSid())
+		isGM := isGMAccount(account)
+		if h.playerService.GetLoginQueue().MustWait(isGM) {
+			return h.enqueuePlayerLogin(ctx, pl, isGM)
 		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1004, respData)
+	}
+	return h.completePlayerLogin(ctx, pl)
+}
+
+// checkPlayerLogin 检查角色能否登录
+// 排队放行时重新加载角色后再次检查，排队期间的删除申请与封禁同样生效
+// 参数:
+//   - accountID: 会话已登录的账号ID
+//   - pl: 登录的角色
+//
+// 返回: 允许登录时返回ERR_OK，否则返回拒绝的错误码
+func checkPlayerLogin(accountID int64, pl *models.Player) protocol.ErrorCode {
+	// 只能登录本账号的角色，否则可借重复登录策略踢掉并接管其他账号的在线角色
+	if pl.AccountID != accountID {
+		zLog.Warn("Player login rejected, player belongs to another account",
+			zap.Int64("playerId", pl.PlayerID),
+			zap.Int64("accountId", accountID))
+		return protocol.ErrorCode_ERR_PLAYER_NOT_FOUND
 	}
 
-	_, err = h.playerService.CreatePlayerActor(session, common.PlayerIdType(pl.PlayerID), pl.PlayerName)
+	// 待删除的角色需先恢复才能登录
+	if !pl.DeleteAt.IsZero() {
+		return protocol.ErrorCode_ERR_PLAYER_PENDING_DELETE
+	}
+
+	// 账号级封禁在账号登录时已检查，此处检查角色封禁（含账号登录后签发的封禁）
+	if sanction.GetManager().Find(pl.AccountID, pl.PlayerID, models.SanctionTypeLoginBan, time.Now()) != nil {
+		return protocol.ErrorCode_ERR_PLAYER_BANNED
+	}
+	return protocol.ErrorCode_ERR_OK
+}
+
+// completePlayerLogin 创建玩家Actor并完成角色登录（直接登录或排队放行后调用）
+// 参数:
+//   - ctx: 角色登录请求的消息处理上下文
+//   - pl: 登录的角色
+func (h *PlayerHandler) completePlayerLogin(ctx *router.Context, pl *models.Player) error {
+	session := ctx.Session
+	playerId := common.PlayerIdType(pl.PlayerID)
+	_, err := h.playerService.CreatePlayerActor(session, playerId, pl.PlayerName)
+	if player.IsPlayerAlreadyExists(err) {
+		playerActor := h.playerService.GetPlayerActor(playerId)
+		if playerActor != nil && playerActor.Player.GetSession() == session {
+			// 当前会话已登录该角色（客户端重复发送登录请求）
+			err = nil
+		} else if config.GetLoginConfig().DuplicatePolicy == config.DuplicateLoginRejectNew {
+			// 角色仍被其他会话占用（如旧连接尚未清理），按重复登录策略处理
+			return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_LOGGED_ELSEWHERE)
+		} else {
+			if playerActor != nil {
+				if oldSession := playerActor.Player.GetSession(); oldSession != nil {
+					h.unbindSession(oldSession.GetSid())
+					h.kickSession(oldSession, protocol.KickReason_KICK_REASON_DUPLICATE_LOGIN, "账号在其他地方登录")
+				} else {
+					h.playerService.RemovePlayer(playerId)
+				}
+			}
+			_, err = h.playerService.CreatePlayerActor(session, playerId, pl.PlayerName)
+		}
+	}
+	if player.IsTooManyPlayers(err) {
+		zLog.Warn("Server full, player login rejected", zap.Int64("playerId", pl.PlayerID))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER_FULL)
+	}
 	if err != nil {
 		zLog.Error("Failed to create player", zap.Error(err))
-		resp := protocol.PlayerLoginResponse{
-			Success:  false,
-			ErrorMsg: "服务器错误",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1004, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+	if playerActor := h.playerService.GetPlayerActor(playerId); playerActor != nil {
+		playerActor.Player.SetVIPLevel(pl.VipLevel)
 	}
 
-	h.playerSession[common.PlayerIdType(req.PlayerId)] = session.GetSid()
-	h.sessionPlayer[session.GetSid()] = common.PlayerIdType(req.PlayerId)
+	h.bindPlayerSession(session, playerId)
+	sanction.GetManager().BindPlayer(pl.PlayerID, pl.AccountID)
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateInGame)
+	h.loginHistory.PlayerLogin(session, pl, "")
 
-	resp := protocol.PlayerLoginResponse{
-		Success:  true,
-		ErrorMsg: "",
-		PlayerId: pl.PlayerID,
-		Name:     pl.PlayerName,
-		Level:    int32(pl.Level),
-		Gold:     1000,
+	resumeToken, err := h.playerService.IssueResumeToken(playerId)
+	if err != nil {
+		zLog.Error("Failed to issue resume token", zap.Int64("playerId", int64(playerId)), zap.Error(err))
 	}
-	respData, _ := proto.Marshal(&resp)
-	return session.Send(1004, respData)
+
+	resp := protocol.PlayerLoginResponse{
+		Success:     true,
+		ErrorMsg:    "",
+		PlayerId:    pl.PlayerID,
+		Name:        pl.PlayerName,
+		Level:       int32(pl.Level),
+		Gold:        1000,
+		ResumeToken: resumeToken,
+	}
+	return ctx.Reply(&resp)
 }
 
-func (h *PlayerHandler) handlePlayerLogout(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+func (h *PlayerHandler) handlePlayerLogout(ctx *router.Context, req *protocol.PlayerLogoutRequest) error {
+	session := ctx.Session
 	zLog.Debug("Received player logout request", zap.Int64("sessionId", int64(session.GetSid())))
 
-	playerId, ok := h.sessionPlayer[session.GetSid()]
+	playerId, ok := h.getSessionPlayer(session.GetSid())
 	if !ok {
-		resp := protocol.PlayerLogoutResponse{
-			Success:  false,
-			ErrorMsg: "玩家未登录",
-		}
-		respData, _ := proto.Marshal(&resp)
-		return session.Send(1005, respData)
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_NOT_LOGGED_IN)
 	}
 
+	// 登出过程中不再接受该会话的其他消息
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateLoggingOut)
+	h.loginHistory.PlayerLogout(session.GetSid())
+
 	playerActor := h.playerService.GetPlayerActor(common.PlayerIdType(playerId))
 	if playerActor != nil {
 		disconnectMsg := player.NewPlayerActorMessage(int64(playerId), "disconnect", nil)
@@ -432,13 +468,336 @@ func (h *PlayerHandler) handlePlayerLogout(session *zNet.TcpServerSession, packe
 		zLog.Info("Player logged out", zap.Int64("playerId", int64(playerId)), zap.String("name", playerActor.Player.GetName()))
 	}
 
-	delete(h.playerSession, common.PlayerIdType(playerId))
+	h.mu.Lock()
+	delete(h.playerSession, playerId)
 	delete(h.sessionPlayer, session.GetSid())
+	h.mu.Unlock()
 
 	resp := protocol.PlayerLogoutResponse{
 		Success:  true,
 		ErrorMsg: "",
 	}
-	respData, _ := proto.Marshal(&resp)
-	return session.Send(1005, respData)
+	return ctx.Reply(&resp)
+}
+
+func (h *PlayerHandler) handlePlayerReconnect(ctx *router.Context, req *protocol.PlayerReconnectRequest) error {
+	session := ctx.Session
+	zLog.Debug("Received player reconnect request", zap.Int64("sessionId", int64(session.GetSid())))
+
+	if _, ok := h.getSessionPlayer(session.GetSid()); ok {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_ALREADY_LOGGED_IN)
+	}
+
+	// 重连使用新连接，需重新协商协议版本
+	if _, ok := h.negotiateProtocolVersion(ctx); !ok {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_CLIENT_VERSION_TOO_OLD)
+	}
+
+	playerId, ok := h.playerService.GetResumeTokenPlayer(req.ResumeToken)
+	if !ok {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_RECONNECT_TOKEN_INVALID)
+	}
+
+	pl, err := db.GetMgr().PlayerRepository.GetByID(int64(playerId))
+	if err != nil || pl == nil {
+		zLog.Error("Failed to get player", zap.Int64("playerId", int64(playerId)), zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+
+	account, err := db.GetMgr().AccountRepository.GetByID(pl.AccountID)
+	if err != nil || account == nil {
+		zLog.Error("Failed to get account", zap.Int64("accountId", pl.AccountID), zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+
+	if h.isRateLimitBanned(account.AccountName) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_BANNED)
+	}
+	if code := h.checkAccountSanction(account); code != protocol.ErrorCode_ERR_OK {
+		return ctx.ReplyError(code)
+	}
+	if sanction.GetManager().Find(account.AccountID, pl.PlayerID, models.SanctionTypeLoginBan, time.Now()) != nil {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_PLAYER_BANNED)
+	}
+
+	// 服务器尚未感知旧连接断开时，旧会话仍绑定着账号，由本会话直接接管而不按重复登录处理
+	h.mu.Lock()
+	if oldSessionId, ok := h.playerSession[playerId]; ok {
+		h.unbindSessionUnsafe(oldSessionId)
+	}
+	h.mu.Unlock()
+
+	if !h.bindAccountSession(ctx, account.AccountName) {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_ACCOUNT_LOGGED_ELSEWHERE)
+	}
+
+	playerActor, resumeToken, err := h.playerService.ResumeSession(req.ResumeToken, session)
+	if err != nil {
+		h.unbindSession(session.GetSid())
+		if player.IsResumeTokenInvalid(err) {
+			return ctx.ReplyError(protocol.ErrorCode_ERR_RECONNECT_TOKEN_INVALID)
+		}
+		zLog.Error("Failed to resume player session", zap.Int64("playerId", int64(playerId)), zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+
+	h.bindPlayerSession(session, playerId)
+	sanction.GetManager().BindPlayer(pl.PlayerID, pl.AccountID)
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateInGame)
+	h.loginHistory.PlayerLogin(session, pl, "reconnect")
+
+	// 由玩家Actor切换会话后回复重连结果并补发消息，保证与其它下行消息的顺序
+	msg := player.NewPlayerActorResumeMessage(int64(playerId), session, ctx.Packet, ctx.Protocol, resumeToken, req.LastRecvSeq)
+	playerActor.SendMessage(msg)
+	return nil
+}
+
+// onSessionClose 会话关闭处理
+// 清理会话的账号与玩家映射，并通知玩家服务（断线重连启用时玩家Actor会保留一段时间）
+func (h *PlayerHandler) onSessionClose(sessionId zNet.SessionIdType) {
+	h.mu.Lock()
+	playerId, ok := h.sessionPlayer[sessionId]
+	owned := ok && h.playerSession[playerId] == sessionId
+	h.unbindSessionUnsafe(sessionId)
+	h.mu.Unlock()
+
+	h.playerService.GetLoginQueue().Remove(sessionId)
+	h.loginHistory.Disconnect(sessionId)
+
+	// 角色已被新会话接管（重复登录踢掉旧会话）时，保留新会话的处罚检查
+	if owned {
+		sanction.GetManager().UnbindPlayer(int64(playerId))
+	}
+	h.playerService.OnSessionClose(sessionId)
+}
+
+// negotiateProtocolVersion 按数据包头中的客户端协议版本协商，成功后记录到会话
+// 参数:
+//   - ctx: 当前会话的消息处理上下文
+//
+// 返回:
+//   - int32: 协商的协议版本
+//   - bool: 是否协商成功（false表示客户端版本过低，需强制更新）
+func (h *PlayerHandler) negotiateProtocolVersion(ctx *router.Context) (int32, bool) {
+	minVersion := int32(config.GetLoginConfig().MinProtocolVersion)
+	version, ok := protolayer.NegotiateVersion(ctx.Packet.Version, minVersion)
+	if !ok {
+		zLog.Info("Client protocol version too old, force update required",
+			zap.Uint64("sessionId", ctx.Session.GetSid()),
+			zap.Int32("clientVersion", ctx.Packet.Version),
+			zap.Int32("minVersion", minVersion))
+		return 0, false
+	}
+
+	if layered, ok := ctx.Session.(*protolayer.LayeredSession); ok {
+		layered.SetProtocolVersion(version)
+	}
+	return version, true
+}
+
+// bindAccountSession 将账号绑定到当前会话
+// 账号已在其他会话登录时按配置的重复登录策略处理：
+// kick_old 通知并断开旧会话，清理其玩家Actor后由当前会话接管；reject_new 拒绝当前会话登录
+// 参数:
+//   - ctx: 当前会话的消息处理上下文
+//   - account: 账号名
+//
+// 返回:
+//   - bool: 是否绑定成功（false表示按策略拒绝了本次登录）
+func (h *PlayerHandler) bindAccountSession(ctx *router.Context, account string) bool {
+	session := ctx.Session
+
+	h.mu.Lock()
+	oldSession, exists := h.accountSession[account]
+	if exists && oldSession.GetSid() != session.GetSid() {
+		if config.GetLoginConfig().DuplicatePolicy == config.DuplicateLoginRejectNew {
+			h.mu.Unlock()
+			return false
+		}
+		h.unbindSessionUnsafe(oldSession.GetSid())
+	} else {
+		oldSession = nil
+	}
+
+	// 当前会话此前登录的其他账号不再归属该会话
+	if prevAccount, ok := h.sessionAccount[session.GetSid()]; ok && prevAccount != account {
+		delete(h.accountSession, prevAccount)
+	}
+	h.accountSession[account] = session
+	h.sessionAccount[session.GetSid()] = account
+	h.mu.Unlock()
+
+	// 此后该会话因限流被断开时计入账号，反复超限的账号会被临时封禁
+	if limiter := h.packetRouter.GetRateLimiter(); limiter != nil {
+		limiter.BindIdentity(session.GetSid(), account)
+	}
+
+	if oldSession != nil {
+		zLog.Info("Duplicate account login, kicking old session",
+			zap.String("account", account),
+			zap.Uint64("oldSessionId", oldSession.GetSid()),
+			zap.Uint64("newSessionId", session.GetSid()))
+		h.kickSession(oldSession, protocol.KickReason_KICK_REASON_DUPLICATE_LOGIN, "账号在其他地方登录")
+	}
+	return true
+}
+
+// isRateLimitBanned 账号是否因反复请求过于频繁处于临时封禁中
+func (h *PlayerHandler) isRateLimitBanned(account string) bool {
+	limiter := h.packetRouter.GetRateLimiter()
+	if limiter == nil {
+		return false
+	}
+	until, banned := limiter.BannedUntil(account, time.Now())
+	if banned {
+		zLog.Info("Rate limit banned account login rejected", zap.String("account", account), zap.Time("until", until))
+	}
+	return banned
+}
+
+// checkAccountSanction 检查账号是否可以登录
+// 以数据库为准刷新账号生效中的处罚，冻结或被封禁登录的账号不可登录
+// 返回: 错误码，可以登录时返回ERR_OK
+func (h *PlayerHandler) checkAccountSanction(account *models.Account) protocol.ErrorCode {
+	if account.Status == models.AccountStatusFrozen {
+		zLog.Info("Frozen account login rejected", zap.String("account", account.AccountName))
+		return protocol.ErrorCode_ERR_ACCOUNT_BANNED
+	}
+
+	now := time.Now()
+	manager := sanction.GetManager()
+	if err := manager.Load(account.AccountID, now); err != nil {
+		zLog.Error("Failed to load account sanctions", zap.Int64("accountId", account.AccountID), zap.Error(err))
+		return protocol.ErrorCode_ERR_SERVER
+	}
+	if ban := manager.Find(account.AccountID, 0, models.SanctionTypeLoginBan, now); ban != nil {
+		zLog.Info("Banned account login rejected",
+			zap.String("account", account.AccountName),
+			zap.Int64("sanctionId", ban.SanctionID),
+			zap.Time("expireAt", ban.ExpireAt))
+		return protocol.ErrorCode_ERR_ACCOUNT_BANNED
+	}
+	return protocol.ErrorCode_ERR_OK
+}
+
+// onSanctionIssued 签发登录封禁后将在线的账号或角色踢下线，禁言与冻结交易即时生效无需处理
+func (h *PlayerHandler) onSanctionIssued(issued *models.AccountSanction) {
+	if issued.Type != models.SanctionTypeLoginBan {
+		return
+	}
+
+	var session zNet.Session
+	if issued.PlayerID != 0 {
+		if playerActor := h.playerService.GetPlayerActor(common.PlayerIdType(issued.PlayerID)); playerActor != nil {
+			session = playerActor.Player.GetSession()
+		}
+	} else {
+		account, err := db.GetMgr().AccountRepository.GetByID(issued.AccountID)
+		if err != nil || account == nil {
+			zLog.Error("Failed to get banned account", zap.Int64("accountId", issued.AccountID), zap.Error(err))
+			return
+		}
+		h.mu.Lock()
+		session = h.accountSession[account.AccountName]
+		h.mu.Unlock()
+	}
+
+	if session != nil {
+		zLog.Info("Kicking banned session", zap.Int64("sanctionId", issued.SanctionID), zap.Uint64("sessionId", session.GetSid()))
+		h.kickSession(session, protocol.KickReason_KICK_REASON_BANNED, "账号已被封禁")
+	}
+}
+
+// kickSession 踢掉会话
+// 先推送踢下线通知，再停止并移除该会话上的玩家Actor，最后断开连接
+// 参数:
+//   - session: 被踢的会话
+//   - reason: 踢下线原因
+//   - message: 推送给客户端的提示
+func (h *PlayerHandler) kickSession(session zNet.Session, reason protocol.KickReason, message string) {
+	h.packetRouter.SetSessionState(session.GetSid(), router.SessionStateLoggingOut)
+	h.loginHistory.Kick(session.GetSid(), reason, message)
+
+	notify := protocol.KickNotify{
+		Reason:  reason,
+		Message: message,
+	}
+	kickCtx := router.NewContext(session, nil, h.packetRouter.GetProtocol())
+	if err := kickCtx.Send(int32(protocol.SystemMsgId_MSG_SYSTEM_KICK), &notify); err != nil {
+		zLog.Warn("Failed to send kick notify", zap.Uint64("sessionId", session.GetSid()), zap.Error(err))
+	}
+
+	// 停止玩家Actor时会一并关闭其会话
+	if playerActor := h.playerService.GetPlayerActorBySession(session.GetSid()); playerActor != nil {
+		h.playerService.RemovePlayer(playerActor.Player.GetPlayerId())
+	} else {
+		session.Close()
+	}
+}
+
+// bindPlayerSession 将玩家绑定到会话
+func (h *PlayerHandler) bindPlayerSession(session zNet.Session, playerId common.PlayerIdType) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.playerSession[playerId] = session.GetSid()
+	h.sessionPlayer[session.GetSid()] = playerId
+}
+
+// unbindSession 清理会话的账号与玩家映射
+func (h *PlayerHandler) unbindSession(sessionId zNet.SessionIdType) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.unbindSessionUnsafe(sessionId)
+}
+
+// unbindSessionUnsafe 清理会话的账号与玩家映射
+// 注意: 调用前必须持有锁
+func (h *PlayerHandler) unbindSessionUnsafe(sessionId zNet.SessionIdType) {
+	if account, ok := h.sessionAccount[sessionId]; ok {
+		if session, exists := h.accountSession[account]; exists && session.GetSid() == sessionId {
+			delete(h.accountSession, account)
+		}
+		delete(h.sessionAccount, sessionId)
+	}
+	if playerId, ok := h.sessionPlayer[sessionId]; ok {
+		if sid, exists := h.playerSession[playerId]; exists && sid == sessionId {
+			delete(h.playerSession, playerId)
+		}
+		delete(h.sessionPlayer, sessionId)
+	}
+}
+
+// getSessionAccount 获取会话登录的账号
+func (h *PlayerHandler) getSessionAccount(sessionId zNet.SessionIdType) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	account, ok := h.sessionAccount[sessionId]
+	return account, ok
+}
+
+// getSessionPlayer 获取会话登录的玩家ID
+func (h *PlayerHandler) getSessionPlayer(sessionId zNet.SessionIdType) (common.PlayerIdType, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	playerId, ok := h.sessionPlayer[sessionId]
+	return playerId, ok
+}
+
+// newPlayerInfo 转换为选择角色界面的角色信息
+func newPlayerInfo(p *models.Player) *protocol.PlayerInfo {
+	info := &protocol.PlayerInfo{
+		PlayerId: p.PlayerID,
+		Name:     p.PlayerName,
+		Level:    int32(p.Level),
+		Sex:      int32(p.Sex),
+		Age:      int32(p.Age),
+	}
+	if !p.DeleteAt.IsZero() {
+		info.DeleteAt = p.DeleteAt.Unix()
+	}
+	return info
 }
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+)
+
+// recordSession 记录下行消息的测试会话
+type recordSession struct {
+	fuzzSession
+	sent [][]byte
+}
+
+func (s *recordSession) Send(protoId int32, data []byte) error {
+	s.sent = append(s.sent, data)
+	return nil
+}
+
+// lastResult 解码会话收到的最后一条Response的错误码
+func (s *recordSession) lastResult(t *testing.T, p protolayer.Protocol) protocol.ErrorCode {
+	t.Helper()
+	if len(s.sent) == 0 {
+		t.Fatal("no response sent")
+	}
+	var resp protocol.Response
+	if err := p.Unmarshal(s.sent[len(s.sent)-1], &resp); err != nil {
+		t.Fatal(err)
+	}
+	return protocol.ErrorCode(resp.Result)
+}
+
+func TestPlayerLoginRejectsForeignPlayer(t *testing.T) {
+	db.InitMemoryDBManager()
+	packetRouter := router.NewPacketRouter()
+	h := NewPlayerNetHandler(packetRouter, player.NewPlayerService(), nil)
+
+	const ownerAccountID, otherAccountID, playerID = 6101, 6102, 6201
+	for _, account := range []*models.Account{
+		{AccountID: ownerAccountID, AccountName: "login_owner"},
+		{AccountID: otherAccountID, AccountName: "login_other"},
+	} {
+		if _, err := db.GetMgr().AccountRepository.Create(account); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.GetMgr().PlayerRepository.Create(&models.Player{PlayerID: playerID, AccountID: ownerAccountID, PlayerName: "Owned"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 其他账号已登录的会话
+	session := &recordSession{fuzzSession: fuzzSession{sid: zNet.SessionIdType(6301)}}
+	h.accountSession["login_other"] = session
+	h.sessionAccount[session.GetSid()] = "login_other"
+
+	ctx := router.NewContext(session, &zNet.NetPacket{ProtoId: int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN)}, packetRouter.GetProtocol())
+	if err := h.handlePlayerLogin(ctx, &protocol.PlayerLoginRequest{PlayerId: playerID}); err != nil {
+		t.Fatal(err)
+	}
+	if code := session.lastResult(t, packetRouter.GetProtocol()); code != protocol.ErrorCode_ERR_PLAYER_NOT_FOUND {
+		t.Fatalf("expected ERR_PLAYER_NOT_FOUND, got %v", code)
+	}
+	if h.playerService.GetPlayerActor(common.PlayerIdType(playerID)) != nil {
+		t.Fatal("foreign player actor must not be created")
+	}
+	if _, ok := h.getSessionPlayer(session.GetSid()); ok {
+		t.Fatal("foreign player must not be bound to the session")
+	}
+}
+
+func TestQueuedLoginRecheckedOnAdmit(t *testing.T) {
+	db.InitMemoryDBManager()
+	packetRouter := router.NewPacketRouter()
+	h := NewPlayerNetHandler(packetRouter, player.NewPlayerService(), nil)
+
+	const accountID, playerID = 6111, 6211
+	if _, err := db.GetMgr().AccountRepository.Create(&models.Account{AccountID: accountID, AccountName: "queue_owner"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetMgr().PlayerRepository.Create(&models.Player{PlayerID: playerID, AccountID: accountID, PlayerName: "Queued"}); err != nil {
+		t.Fatal(err)
+	}
+
+	session := &recordSession{fuzzSession: fuzzSession{sid: zNet.SessionIdType(6311)}}
+	h.accountSession["queue_owner"] = session
+	h.sessionAccount[session.GetSid()] = "queue_owner"
+
+	// 入队时的角色数据，放行时已过期
+	stale, _ := db.GetMgr().PlayerRepository.GetByID(playerID)
+	staleCopy := *stale
+	ctx := router.NewContext(session, &zNet.NetPacket{ProtoId: int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN)}, packetRouter.GetProtocol())
+	entry := &player.LoginQueueEntry{
+		SessionId: session.GetSid(),
+		PlayerId:  common.PlayerIdType(playerID),
+		Data:      &queuedLogin{ctx: ctx, player: &staleCopy},
+	}
+	if _, err := h.playerService.GetLoginQueue().Enqueue(entry, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 排队中的角色不能申请删除
+	deleteCtx := router.NewContext(session, &zNet.NetPacket{ProtoId: int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_DELETE)}, packetRouter.GetProtocol())
+	if err := h.handlePlayerDelete(deleteCtx, &protocol.PlayerDeleteRequest{PlayerId: playerID}); err != nil {
+		t.Fatal(err)
+	}
+	if code := session.lastResult(t, packetRouter.GetProtocol()); code != protocol.ErrorCode_ERR_PLAYER_ALREADY_LOGGED_IN {
+		t.Fatalf("expected ERR_PLAYER_ALREADY_LOGGED_IN, got %v", code)
+	}
+
+	// 排队期间角色被标记删除，放行时按重新加载的数据拒绝登录
+	updated := staleCopy
+	updated.DeleteAt = time.Now().Add(time.Hour)
+	if _, err := db.GetMgr().PlayerRepository.Update(&updated); err != nil {
+		t.Fatal(err)
+	}
+	h.playerService.GetLoginQueue().Remove(session.GetSid())
+	h.onLoginQueueAdmit(entry)
+	if code := session.lastResult(t, packetRouter.GetProtocol()); code != protocol.ErrorCode_ERR_PLAYER_PENDING_DELETE {
+		t.Fatalf("expected ERR_PLAYER_PENDING_DELETE, got %v", code)
+	}
+	if h.playerService.GetPlayerActor(common.PlayerIdType(playerID)) != nil {
+		t.Fatal("player pending deletion must not be admitted")
+	}
+}
+
+func TestPlayerCreateDoesNotEnterGame(t *testing.T) {
+	db.InitMemoryDBManager()
+	if err := common.InitIDGenerator(1, 1); err != nil {
+		t.Fatal(err)
+	}
+	packetRouter := router.NewPacketRouter()
+	h := NewPlayerNetHandler(packetRouter, player.NewPlayerService(), nil)
+
+	const accountID = 6131
+	if _, err := db.GetMgr().AccountRepository.Create(&models.Account{AccountID: accountID, AccountName: "create_owner"}); err != nil {
+		t.Fatal(err)
+	}
+	session := &recordSession{fuzzSession: fuzzSession{sid: zNet.SessionIdType(6331)}}
+	h.accountSession["create_owner"] = session
+	h.sessionAccount[session.GetSid()] = "create_owner"
+
+	// 创建角色不占用在线名额，需再发送玩家登录（经登录排队）进入游戏
+	ctx := router.NewContext(session, &zNet.NetPacket{ProtoId: int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE)}, packetRouter.GetProtocol())
+	if err := h.handlePlayerCreate(ctx, &protocol.PlayerCreateRequest{Name: "Newcomer"}); err != nil {
+		t.Fatal(err)
+	}
+	if code := session.lastResult(t, packetRouter.GetProtocol()); code != protocol.ErrorCode_ERR_OK {
+		t.Fatalf("expected ERR_OK, got %v", code)
+	}
+	if _, ok := h.getSessionPlayer(session.GetSid()); ok {
+		t.Fatal("created player must not be bound to the session before login")
+	}
+	if packetRouter.GetSessionState(session.GetSid()) == router.SessionStateInGame {
+		t.Fatal("session must stay on the character screen after creating a player")
+	}
+}
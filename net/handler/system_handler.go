@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+	"go.uber.org/zap"
+)
+
+// SystemHandler 系统消息处理器（加密握手等与业务无关的连接级消息）
+type SystemHandler struct {
+	securityLayer *protolayer.SecurityLayer
+}
+
+// NewSystemNetHandler 创建系统消息处理器
+func NewSystemNetHandler(securityLayer *protolayer.SecurityLayer) *SystemHandler {
+	return &SystemHandler{
+		securityLayer: securityLayer,
+	}
+}
+
+// handleHandshake 处理加密握手
+// 响应以明文发送，发送完成后该连接的双向消息体开始加密
+func (h *SystemHandler) handleHandshake(ctx *router.Context, req *protocol.HandshakeRequest) error {
+	session := ctx.Session
+	zLog.Debug("Received handshake request", zap.Uint64("sessionId", session.GetSid()))
+
+	layered, ok := session.(*protolayer.LayeredSession)
+	if !ok || !h.securityLayer.IsEnabled() {
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SECURITY_DISABLED)
+	}
+
+	result, activate, err := h.securityLayer.Handshake(layered, req.PublicKey, req.Ciphers)
+	if err != nil {
+		zLog.Warn("Handshake failed", zap.Uint64("sessionId", session.GetSid()), zap.Error(err))
+		code := protocol.ErrorCode_ERR_HANDSHAKE_FAILED
+		switch err {
+		case protolayer.ErrHandshakeDone:
+			code = protocol.ErrorCode_ERR_HANDSHAKE_DONE
+		case protolayer.ErrUnsupportedCipher:
+			code = protocol.ErrorCode_ERR_UNSUPPORTED_CIPHER
+		}
+		return ctx.ReplyError(code)
+	}
+
+	resp := protocol.HandshakeResponse{
+		Success:   true,
+		PublicKey: result.PublicKey,
+		Cipher:    result.Cipher,
+		Signature: result.Signature,
+	}
+	if err := ctx.Reply(&resp); err != nil {
+		return err
+	}
+	activate()
+
+	zLog.Debug("Handshake completed", zap.Uint64("sessionId", session.GetSid()), zap.String("cipher", result.Cipher))
+	return nil
+}
+
+// handlePing 处理网络探测
+// 响应附带服务器发起的探测序号，客户端立即回应后由服务器测量往返时延与丢包率，用于评估网络质量
+func (h *SystemHandler) handlePing(ctx *router.Context, req *protocol.PingRequest) error {
+	resp := protocol.PingResponse{
+		ClientTime: req.ClientTime,
+		ServerTime: time.Now().UnixMilli(),
+	}
+	if layered, ok := ctx.Session.(*protolayer.LayeredSession); ok {
+		resp.ProbeSeq = layered.StartPingProbe()
+	}
+	return ctx.Reply(&resp)
+}
+
+// handlePingAck 处理客户端对网络探测的回应
+// 网络质量越差压缩越积极；过期或不匹配的探测序号直接忽略
+func (h *SystemHandler) handlePingAck(ctx *router.Context, req *protocol.PingAckRequest) error {
+	if layered, ok := ctx.Session.(*protolayer.LayeredSession); ok {
+		layered.AckPingProbe(req.ProbeSeq)
+	}
+	return nil
+}
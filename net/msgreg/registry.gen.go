@@ -0,0 +1,347 @@
+// Code generated by msggen from resources/protocol/game.proto. DO NOT EDIT.
+
+package msgreg
+
+import (
+	"github.com/pzqf/zGameServer/net/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+var msgInfos = []*MsgInfo{
+	{
+		Id:   int32(protocol.SystemMsgId_MSG_SYSTEM_KICK),
+		Name: "MSG_SYSTEM_KICK",
+		Push: func() proto.Message { return new(protocol.KickNotify) },
+	},
+	{
+		Id:       int32(protocol.SystemMsgId_MSG_SYSTEM_UDP_BIND),
+		Name:     "MSG_SYSTEM_UDP_BIND",
+		Request:  func() proto.Message { return new(protocol.UdpBindRequest) },
+		Response: func() proto.Message { return new(protocol.UdpBindResponse) },
+	},
+	{
+		Id:       int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE),
+		Name:     "MSG_SYSTEM_HANDSHAKE",
+		Request:  func() proto.Message { return new(protocol.HandshakeRequest) },
+		Response: func() proto.Message { return new(protocol.HandshakeResponse) },
+	},
+	{
+		Id:       int32(protocol.SystemMsgId_MSG_SYSTEM_PING),
+		Name:     "MSG_SYSTEM_PING",
+		Request:  func() proto.Message { return new(protocol.PingRequest) },
+		Response: func() proto.Message { return new(protocol.PingResponse) },
+	},
+	{
+		Id:   int32(protocol.SystemMsgId_MSG_SYSTEM_BATCH),
+		Name: "MSG_SYSTEM_BATCH",
+	},
+	{
+		Id:      int32(protocol.SystemMsgId_MSG_SYSTEM_PING_ACK),
+		Name:    "MSG_SYSTEM_PING_ACK",
+		Request: func() proto.Message { return new(protocol.PingAckRequest) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_CREATE),
+		Name:     "MSG_PLAYER_ACCOUNT_CREATE",
+		Request:  func() proto.Message { return new(protocol.AccountCreateRequest) },
+		Response: func() proto.Message { return new(protocol.AccountCreateResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN),
+		Name:     "MSG_PLAYER_ACCOUNT_LOGIN",
+		Request:  func() proto.Message { return new(protocol.AccountLoginRequest) },
+		Response: func() proto.Message { return new(protocol.AccountLoginResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_CREATE),
+		Name:     "MSG_PLAYER_PLAYER_CREATE",
+		Request:  func() proto.Message { return new(protocol.PlayerCreateRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerCreateResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGIN),
+		Name:     "MSG_PLAYER_PLAYER_LOGIN",
+		Request:  func() proto.Message { return new(protocol.PlayerLoginRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerLoginResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_LOGOUT),
+		Name:     "MSG_PLAYER_PLAYER_LOGOUT",
+		Request:  func() proto.Message { return new(protocol.PlayerLogoutRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerLogoutResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_GET_INFO),
+		Name:     "MSG_PLAYER_GET_INFO",
+		Request:  func() proto.Message { return new(protocol.PlayerGetInfoRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerGetInfoResponse) },
+	},
+	{
+		Id:   int32(protocol.PlayerMsgId_MSG_PLAYER_UPDATE_INFO),
+		Name: "MSG_PLAYER_UPDATE_INFO",
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_RECONNECT),
+		Name:     "MSG_PLAYER_RECONNECT",
+		Request:  func() proto.Message { return new(protocol.PlayerReconnectRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerReconnectResponse) },
+	},
+	{
+		Id:   int32(protocol.PlayerMsgId_MSG_PLAYER_LOGIN_QUEUE),
+		Name: "MSG_PLAYER_LOGIN_QUEUE",
+		Push: func() proto.Message { return new(protocol.LoginQueueNotify) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_GET),
+		Name:     "MSG_PLAYER_INVENTORY_GET",
+		Request:  func() proto.Message { return new(protocol.InventoryGetRequest) },
+		Response: func() proto.Message { return new(protocol.InventoryGetResponse) },
+	},
+	{
+		Id:   int32(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_ADD),
+		Name: "MSG_PLAYER_INVENTORY_ADD",
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_REMOVE),
+		Name:     "MSG_PLAYER_INVENTORY_REMOVE",
+		Request:  func() proto.Message { return new(protocol.InventoryRemoveRequest) },
+		Response: func() proto.Message { return new(protocol.InventoryRemoveResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_USE),
+		Name:     "MSG_PLAYER_INVENTORY_USE",
+		Request:  func() proto.Message { return new(protocol.InventoryUseRequest) },
+		Response: func() proto.Message { return new(protocol.InventoryUseResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_INVENTORY_SORT),
+		Name:     "MSG_PLAYER_INVENTORY_SORT",
+		Request:  func() proto.Message { return new(protocol.InventorySortRequest) },
+		Response: func() proto.Message { return new(protocol.InventorySortResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_GET),
+		Name:     "MSG_PLAYER_EQUIPMENT_GET",
+		Request:  func() proto.Message { return new(protocol.EquipmentGetRequest) },
+		Response: func() proto.Message { return new(protocol.EquipmentGetResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_EQUIP),
+		Name:     "MSG_PLAYER_EQUIPMENT_EQUIP",
+		Request:  func() proto.Message { return new(protocol.EquipmentEquipRequest) },
+		Response: func() proto.Message { return new(protocol.EquipmentEquipResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_UNEQUIP),
+		Name:     "MSG_PLAYER_EQUIPMENT_UNEQUIP",
+		Request:  func() proto.Message { return new(protocol.EquipmentUnequipRequest) },
+		Response: func() proto.Message { return new(protocol.EquipmentUnequipResponse) },
+	},
+	{
+		Id:   int32(protocol.PlayerMsgId_MSG_PLAYER_EQUIPMENT_UPGRADE),
+		Name: "MSG_PLAYER_EQUIPMENT_UPGRADE",
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_MAIL_GET_LIST),
+		Name:     "MSG_PLAYER_MAIL_GET_LIST",
+		Request:  func() proto.Message { return new(protocol.MailGetListRequest) },
+		Response: func() proto.Message { return new(protocol.MailGetListResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_MAIL_GET_DETAIL),
+		Name:     "MSG_PLAYER_MAIL_GET_DETAIL",
+		Request:  func() proto.Message { return new(protocol.MailGetDetailRequest) },
+		Response: func() proto.Message { return new(protocol.MailGetDetailResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_MAIL_SEND),
+		Name:     "MSG_PLAYER_MAIL_SEND",
+		Request:  func() proto.Message { return new(protocol.MailSendRequest) },
+		Response: func() proto.Message { return new(protocol.MailSendResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_MAIL_DELETE),
+		Name:     "MSG_PLAYER_MAIL_DELETE",
+		Request:  func() proto.Message { return new(protocol.MailDeleteRequest) },
+		Response: func() proto.Message { return new(protocol.MailDeleteResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_MAIL_RECEIVE),
+		Name:     "MSG_PLAYER_MAIL_RECEIVE",
+		Request:  func() proto.Message { return new(protocol.MailReceiveRequest) },
+		Response: func() proto.Message { return new(protocol.MailReceiveResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_TASK_GET_LIST),
+		Name:     "MSG_PLAYER_TASK_GET_LIST",
+		Request:  func() proto.Message { return new(protocol.TaskGetListRequest) },
+		Response: func() proto.Message { return new(protocol.TaskGetListResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_TASK_GET_DETAIL),
+		Name:     "MSG_PLAYER_TASK_GET_DETAIL",
+		Request:  func() proto.Message { return new(protocol.TaskGetDetailRequest) },
+		Response: func() proto.Message { return new(protocol.TaskGetDetailResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_TASK_ACCEPT),
+		Name:     "MSG_PLAYER_TASK_ACCEPT",
+		Request:  func() proto.Message { return new(protocol.TaskAcceptRequest) },
+		Response: func() proto.Message { return new(protocol.TaskAcceptResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_TASK_SUBMIT),
+		Name:     "MSG_PLAYER_TASK_SUBMIT",
+		Request:  func() proto.Message { return new(protocol.TaskSubmitRequest) },
+		Response: func() proto.Message { return new(protocol.TaskSubmitResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_TASK_CANCEL),
+		Name:     "MSG_PLAYER_TASK_CANCEL",
+		Request:  func() proto.Message { return new(protocol.TaskCancelRequest) },
+		Response: func() proto.Message { return new(protocol.TaskCancelResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_SKILL_GET_LIST),
+		Name:     "MSG_PLAYER_SKILL_GET_LIST",
+		Request:  func() proto.Message { return new(protocol.SkillGetListRequest) },
+		Response: func() proto.Message { return new(protocol.SkillGetListResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_SKILL_LEARN),
+		Name:     "MSG_PLAYER_SKILL_LEARN",
+		Request:  func() proto.Message { return new(protocol.SkillLearnRequest) },
+		Response: func() proto.Message { return new(protocol.SkillLearnResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_SKILL_UPGRADE),
+		Name:     "MSG_PLAYER_SKILL_UPGRADE",
+		Request:  func() proto.Message { return new(protocol.SkillUpgradeRequest) },
+		Response: func() proto.Message { return new(protocol.SkillUpgradeResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_SKILL_USE),
+		Name:     "MSG_PLAYER_SKILL_USE",
+		Request:  func() proto.Message { return new(protocol.SkillUseRequest) },
+		Response: func() proto.Message { return new(protocol.SkillUseResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_RENAME),
+		Name:     "MSG_PLAYER_RENAME",
+		Request:  func() proto.Message { return new(protocol.PlayerRenameRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerRenameResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_DELETE),
+		Name:     "MSG_PLAYER_PLAYER_DELETE",
+		Request:  func() proto.Message { return new(protocol.PlayerDeleteRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerDeleteResponse) },
+	},
+	{
+		Id:       int32(protocol.PlayerMsgId_MSG_PLAYER_PLAYER_RESTORE),
+		Name:     "MSG_PLAYER_PLAYER_RESTORE",
+		Request:  func() proto.Message { return new(protocol.PlayerRestoreRequest) },
+		Response: func() proto.Message { return new(protocol.PlayerRestoreResponse) },
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_CREATE),
+		Name: "MSG_GUILD_CREATE",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_JOIN),
+		Name: "MSG_GUILD_JOIN",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_LEAVE),
+		Name: "MSG_GUILD_LEAVE",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_DISBAND),
+		Name: "MSG_GUILD_DISBAND",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_GET_INFO),
+		Name: "MSG_GUILD_GET_INFO",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_GET_MEMBERS),
+		Name: "MSG_GUILD_GET_MEMBERS",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_APPLY),
+		Name: "MSG_GUILD_APPLY",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_PROCESS_APPLY),
+		Name: "MSG_GUILD_PROCESS_APPLY",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_UPDATE_NOTICE),
+		Name: "MSG_GUILD_UPDATE_NOTICE",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_PROMOTE_MEMBER),
+		Name: "MSG_GUILD_PROMOTE_MEMBER",
+	},
+	{
+		Id:   int32(protocol.GuildMsgId_MSG_GUILD_KICK_MEMBER),
+		Name: "MSG_GUILD_KICK_MEMBER",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_CREATE),
+		Name: "MSG_AUCTION_CREATE",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_PLACE_BID),
+		Name: "MSG_AUCTION_PLACE_BID",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_BUYOUT),
+		Name: "MSG_AUCTION_BUYOUT",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_CANCEL),
+		Name: "MSG_AUCTION_CANCEL",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_GET_LIST),
+		Name: "MSG_AUCTION_GET_LIST",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_GET_DETAIL),
+		Name: "MSG_AUCTION_GET_DETAIL",
+	},
+	{
+		Id:   int32(protocol.AuctionMsgId_MSG_AUCTION_GET_MY_AUCTIONS),
+		Name: "MSG_AUCTION_GET_MY_AUCTIONS",
+	},
+	{
+		Id:   int32(protocol.MapMsgId_MSG_MAP_ENTER),
+		Name: "MSG_MAP_ENTER",
+	},
+	{
+		Id:   int32(protocol.MapMsgId_MSG_MAP_LEAVE),
+		Name: "MSG_MAP_LEAVE",
+	},
+	{
+		Id:       int32(protocol.MapMsgId_MSG_MAP_MOVE),
+		Name:     "MSG_MAP_MOVE",
+		Request:  func() proto.Message { return new(protocol.MapMoveRequest) },
+		Response: func() proto.Message { return new(protocol.MapMoveResponse) },
+	},
+	{
+		Id:       int32(protocol.MapMsgId_MSG_MAP_GET_PATH),
+		Name:     "MSG_MAP_GET_PATH",
+		Request:  func() proto.Message { return new(protocol.MapGetPathRequest) },
+		Response: func() proto.Message { return new(protocol.MapGetPathResponse) },
+	},
+	{
+		Id:   int32(protocol.MapMsgId_MSG_MAP_GET_OBJECTS),
+		Name: "MSG_MAP_GET_OBJECTS",
+	},
+	{
+		Id:   int32(protocol.MapMsgId_MSG_MAP_SYNC_OBJECTS),
+		Name: "MSG_MAP_SYNC_OBJECTS",
+		Push: func() proto.Message { return new(protocol.MapSyncObjects) },
+	},
+}
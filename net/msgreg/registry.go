@@ -0,0 +1,48 @@
+// Package msgreg 消息注册表
+// 由game.proto的消息ID枚举生成，记录每个消息ID对应的请求、响应与推送消息类型
+package msgreg
+
+//go:generate go run ../../tools/msggen -registry registry.gen.go
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// MsgInfo 消息ID及其对应的消息类型
+type MsgInfo struct {
+	Id       int32
+	Name     string               // 消息ID枚举取值名
+	Request  func() proto.Message // 创建请求消息（客户端请求的消息ID不为空）
+	Response func() proto.Message // 创建业务响应消息（封装在Response的data中）
+	Push     func() proto.Message // 创建推送消息（服务器推送的消息ID不为空）
+}
+
+// msgIndex 消息ID索引
+var msgIndex = func() map[int32]*MsgInfo {
+	index := make(map[int32]*MsgInfo, len(msgInfos))
+	for _, info := range msgInfos {
+		index[info.Id] = info
+	}
+	return index
+}()
+
+// Get 获取消息ID对应的消息类型
+// 返回: 消息信息，未定义的消息ID返回nil
+func Get(msgId int32) *MsgInfo {
+	return msgIndex[msgId]
+}
+
+// All 获取所有消息ID（按消息ID升序）
+func All() []*MsgInfo {
+	return msgInfos
+}
+
+// IsRequest 是否为客户端请求的消息ID
+func (m *MsgInfo) IsRequest() bool {
+	return m.Request != nil
+}
+
+// IsPush 是否为服务器推送的消息ID
+func (m *MsgInfo) IsPush() bool {
+	return m.Push != nil
+}
@@ -0,0 +1,39 @@
+package msgreg
+
+import (
+	"testing"
+
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// 测试消息ID与消息类型的对应关系
+func TestRegistryLookup(t *testing.T) {
+	info := Get(int32(protocol.PlayerMsgId_MSG_PLAYER_ACCOUNT_LOGIN))
+	if info == nil || !info.IsRequest() {
+		t.Fatalf("expected account login to be a request, got %+v", info)
+	}
+	if _, ok := info.Request().(*protocol.AccountLoginRequest); !ok {
+		t.Fatalf("unexpected request type %T", info.Request())
+	}
+	if _, ok := info.Response().(*protocol.AccountLoginResponse); !ok {
+		t.Fatalf("unexpected response type %T", info.Response())
+	}
+
+	if info := Get(int32(protocol.SystemMsgId_MSG_SYSTEM_KICK)); info == nil || !info.IsPush() || info.IsRequest() {
+		t.Fatalf("expected kick to be a push, got %+v", info)
+	}
+	if info := Get(int32(protocol.MapMsgId_MSG_MAP_SYNC_OBJECTS)); info == nil || !info.IsPush() {
+		t.Fatalf("expected map sync to be a push, got %+v", info)
+	}
+	if Get(int32(protocol.PlayerMsgId_MSG_PLAYER_INVALID)) != nil {
+		t.Fatalf("expected invalid message id to be absent")
+	}
+
+	var last int32
+	for _, info := range All() {
+		if info.Id <= last {
+			t.Fatalf("message ids not sorted or duplicated at %d", info.Id)
+		}
+		last = info.Id
+	}
+}
@@ -84,16 +84,34 @@ func (MessageType) EnumDescriptor() ([]byte, []int) {
 type SystemMsgId int32
 
 const (
-	SystemMsgId_MSG_SYSTEM_INVALID SystemMsgId = 0
+	SystemMsgId_MSG_SYSTEM_INVALID   SystemMsgId = 0
+	SystemMsgId_MSG_SYSTEM_KICK      SystemMsgId = 1
+	SystemMsgId_MSG_SYSTEM_UDP_BIND  SystemMsgId = 2
+	SystemMsgId_MSG_SYSTEM_HANDSHAKE SystemMsgId = 3
+	SystemMsgId_MSG_SYSTEM_PING      SystemMsgId = 4
+	SystemMsgId_MSG_SYSTEM_BATCH     SystemMsgId = 5 // 下行合并消息，消息体由若干 消息ID(4字节) + 长度(4字节) + 消息体 依次组成（大端）
+	SystemMsgId_MSG_SYSTEM_PING_ACK  SystemMsgId = 6 // 客户端收到网络探测响应后立即回应，无响应
 )
 
 // Enum value maps for SystemMsgId.
 var (
 	SystemMsgId_name = map[int32]string{
 		0: "MSG_SYSTEM_INVALID",
+		1: "MSG_SYSTEM_KICK",
+		2: "MSG_SYSTEM_UDP_BIND",
+		3: "MSG_SYSTEM_HANDSHAKE",
+		4: "MSG_SYSTEM_PING",
+		5: "MSG_SYSTEM_BATCH",
+		6: "MSG_SYSTEM_PING_ACK",
 	}
 	SystemMsgId_value = map[string]int32{
-		"MSG_SYSTEM_INVALID": 0,
+		"MSG_SYSTEM_INVALID":   0,
+		"MSG_SYSTEM_KICK":      1,
+		"MSG_SYSTEM_UDP_BIND":  2,
+		"MSG_SYSTEM_HANDSHAKE": 3,
+		"MSG_SYSTEM_PING":      4,
+		"MSG_SYSTEM_BATCH":     5,
+		"MSG_SYSTEM_PING_ACK":  6,
 	}
 )
 
@@ -124,6 +142,59 @@ func (SystemMsgId) EnumDescriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{1}
 }
 
+// 踢下线原因
+type KickReason int32
+
+const (
+	KickReason_KICK_REASON_UNKNOWN         KickReason = 0
+	KickReason_KICK_REASON_DUPLICATE_LOGIN KickReason = 1
+	KickReason_KICK_REASON_RATE_LIMITED    KickReason = 2 // 请求过于频繁
+	KickReason_KICK_REASON_BANNED          KickReason = 3 // 账号或角色被封禁
+)
+
+// Enum value maps for KickReason.
+var (
+	KickReason_name = map[int32]string{
+		0: "KICK_REASON_UNKNOWN",
+		1: "KICK_REASON_DUPLICATE_LOGIN",
+		2: "KICK_REASON_RATE_LIMITED",
+		3: "KICK_REASON_BANNED",
+	}
+	KickReason_value = map[string]int32{
+		"KICK_REASON_UNKNOWN":         0,
+		"KICK_REASON_DUPLICATE_LOGIN": 1,
+		"KICK_REASON_RATE_LIMITED":    2,
+		"KICK_REASON_BANNED":          3,
+	}
+)
+
+func (x KickReason) Enum() *KickReason {
+	p := new(KickReason)
+	*p = x
+	return p
+}
+
+func (x KickReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KickReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_protocol_game_proto_enumTypes[2].Descriptor()
+}
+
+func (KickReason) Type() protoreflect.EnumType {
+	return &file_resources_protocol_game_proto_enumTypes[2]
+}
+
+func (x KickReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KickReason.Descriptor instead.
+func (KickReason) EnumDescriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{2}
+}
+
 // 玩家相关消息ID
 type PlayerMsgId int32
 
@@ -138,6 +209,8 @@ const (
 	// 基础信息
 	PlayerMsgId_MSG_PLAYER_GET_INFO    PlayerMsgId = 1006
 	PlayerMsgId_MSG_PLAYER_UPDATE_INFO PlayerMsgId = 1007
+	PlayerMsgId_MSG_PLAYER_RECONNECT   PlayerMsgId = 1008
+	PlayerMsgId_MSG_PLAYER_LOGIN_QUEUE PlayerMsgId = 1009 // 登录排队进度推送
 	// 背包相关
 	PlayerMsgId_MSG_PLAYER_INVENTORY_GET    PlayerMsgId = 1010
 	PlayerMsgId_MSG_PLAYER_INVENTORY_ADD    PlayerMsgId = 1011
@@ -166,6 +239,11 @@ const (
 	PlayerMsgId_MSG_PLAYER_SKILL_LEARN    PlayerMsgId = 1051
 	PlayerMsgId_MSG_PLAYER_SKILL_UPGRADE  PlayerMsgId = 1052
 	PlayerMsgId_MSG_PLAYER_SKILL_USE      PlayerMsgId = 1053
+	// 改名
+	PlayerMsgId_MSG_PLAYER_RENAME PlayerMsgId = 1060
+	// 角色删除与恢复（选择角色界面）
+	PlayerMsgId_MSG_PLAYER_PLAYER_DELETE  PlayerMsgId = 1070
+	PlayerMsgId_MSG_PLAYER_PLAYER_RESTORE PlayerMsgId = 1071
 )
 
 // Enum value maps for PlayerMsgId.
@@ -179,6 +257,8 @@ var (
 		1005: "MSG_PLAYER_PLAYER_LOGOUT",
 		1006: "MSG_PLAYER_GET_INFO",
 		1007: "MSG_PLAYER_UPDATE_INFO",
+		1008: "MSG_PLAYER_RECONNECT",
+		1009: "MSG_PLAYER_LOGIN_QUEUE",
 		1010: "MSG_PLAYER_INVENTORY_GET",
 		1011: "MSG_PLAYER_INVENTORY_ADD",
 		1012: "MSG_PLAYER_INVENTORY_REMOVE",
@@ -202,6 +282,9 @@ var (
 		1051: "MSG_PLAYER_SKILL_LEARN",
 		1052: "MSG_PLAYER_SKILL_UPGRADE",
 		1053: "MSG_PLAYER_SKILL_USE",
+		1060: "MSG_PLAYER_RENAME",
+		1070: "MSG_PLAYER_PLAYER_DELETE",
+		1071: "MSG_PLAYER_PLAYER_RESTORE",
 	}
 	PlayerMsgId_value = map[string]int32{
 		"MSG_PLAYER_INVALID":           0,
@@ -212,6 +295,8 @@ var (
 		"MSG_PLAYER_PLAYER_LOGOUT":     1005,
 		"MSG_PLAYER_GET_INFO":          1006,
 		"MSG_PLAYER_UPDATE_INFO":       1007,
+		"MSG_PLAYER_RECONNECT":         1008,
+		"MSG_PLAYER_LOGIN_QUEUE":       1009,
 		"MSG_PLAYER_INVENTORY_GET":     1010,
 		"MSG_PLAYER_INVENTORY_ADD":     1011,
 		"MSG_PLAYER_INVENTORY_REMOVE":  1012,
@@ -235,6 +320,9 @@ var (
 		"MSG_PLAYER_SKILL_LEARN":       1051,
 		"MSG_PLAYER_SKILL_UPGRADE":     1052,
 		"MSG_PLAYER_SKILL_USE":         1053,
+		"MSG_PLAYER_RENAME":            1060,
+		"MSG_PLAYER_PLAYER_DELETE":     1070,
+		"MSG_PLAYER_PLAYER_RESTORE":    1071,
 	}
 )
 
@@ -249,11 +337,11 @@ func (x PlayerMsgId) String() string {
 }
 
 func (PlayerMsgId) Descriptor() protoreflect.EnumDescriptor {
-	return file_resources_protocol_game_proto_enumTypes[2].Descriptor()
+	return file_resources_protocol_game_proto_enumTypes[3].Descriptor()
 }
 
 func (PlayerMsgId) Type() protoreflect.EnumType {
-	return &file_resources_protocol_game_proto_enumTypes[2]
+	return &file_resources_protocol_game_proto_enumTypes[3]
 }
 
 func (x PlayerMsgId) Number() protoreflect.EnumNumber {
@@ -262,7 +350,7 @@ func (x PlayerMsgId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayerMsgId.Descriptor instead.
 func (PlayerMsgId) EnumDescriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{2}
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{3}
 }
 
 // 公会相关消息ID
@@ -326,11 +414,11 @@ func (x GuildMsgId) String() string {
 }
 
 func (GuildMsgId) Descriptor() protoreflect.EnumDescriptor {
-	return file_resources_protocol_game_proto_enumTypes[3].Descriptor()
+	return file_resources_protocol_game_proto_enumTypes[4].Descriptor()
 }
 
 func (GuildMsgId) Type() protoreflect.EnumType {
-	return &file_resources_protocol_game_proto_enumTypes[3]
+	return &file_resources_protocol_game_proto_enumTypes[4]
 }
 
 func (x GuildMsgId) Number() protoreflect.EnumNumber {
@@ -339,7 +427,7 @@ func (x GuildMsgId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GuildMsgId.Descriptor instead.
 func (GuildMsgId) EnumDescriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{3}
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{4}
 }
 
 // 拍卖行相关消息ID
@@ -391,11 +479,11 @@ func (x AuctionMsgId) String() string {
 }
 
 func (AuctionMsgId) Descriptor() protoreflect.EnumDescriptor {
-	return file_resources_protocol_game_proto_enumTypes[4].Descriptor()
+	return file_resources_protocol_game_proto_enumTypes[5].Descriptor()
 }
 
 func (AuctionMsgId) Type() protoreflect.EnumType {
-	return &file_resources_protocol_game_proto_enumTypes[4]
+	return &file_resources_protocol_game_proto_enumTypes[5]
 }
 
 func (x AuctionMsgId) Number() protoreflect.EnumNumber {
@@ -404,7 +492,7 @@ func (x AuctionMsgId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuctionMsgId.Descriptor instead.
 func (AuctionMsgId) EnumDescriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{4}
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{5}
 }
 
 // 地图相关消息ID
@@ -453,11 +541,11 @@ func (x MapMsgId) String() string {
 }
 
 func (MapMsgId) Descriptor() protoreflect.EnumDescriptor {
-	return file_resources_protocol_game_proto_enumTypes[5].Descriptor()
+	return file_resources_protocol_game_proto_enumTypes[6].Descriptor()
 }
 
 func (MapMsgId) Type() protoreflect.EnumType {
-	return &file_resources_protocol_game_proto_enumTypes[5]
+	return &file_resources_protocol_game_proto_enumTypes[6]
 }
 
 func (x MapMsgId) Number() protoreflect.EnumNumber {
@@ -466,7 +554,256 @@ func (x MapMsgId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MapMsgId.Descriptor instead.
 func (MapMsgId) EnumDescriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{5}
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{6}
+}
+
+// 错误码
+type ErrorCode int32
+
+const (
+	ErrorCode_ERR_OK ErrorCode = 0
+	// 通用 1-99
+	ErrorCode_ERR_SERVER             ErrorCode = 1  // 服务器错误
+	ErrorCode_ERR_INVALID_REQUEST    ErrorCode = 2  // 请求格式错误
+	ErrorCode_ERR_STATE_NOT_ALLOWED  ErrorCode = 3  // 当前状态不允许该操作
+	ErrorCode_ERR_HANDSHAKE_REQUIRED ErrorCode = 4  // 请先完成加密握手
+	ErrorCode_ERR_SECURITY_DISABLED  ErrorCode = 5  // 服务器未启用加密
+	ErrorCode_ERR_HANDSHAKE_DONE     ErrorCode = 6  // 已完成握手
+	ErrorCode_ERR_UNSUPPORTED_CIPHER ErrorCode = 7  // 不支持的加密算法
+	ErrorCode_ERR_HANDSHAKE_FAILED   ErrorCode = 8  // 握手失败
+	ErrorCode_ERR_NOT_IMPLEMENTED    ErrorCode = 9  // 功能暂未开放
+	ErrorCode_ERR_RATE_LIMITED       ErrorCode = 10 // 操作过于频繁
+	ErrorCode_ERR_SERVER_FULL        ErrorCode = 11 // 服务器已满，请稍后再试
+	ErrorCode_ERR_NAME_LENGTH        ErrorCode = 12 // 名称长度不符合要求
+	ErrorCode_ERR_NAME_CHARSET       ErrorCode = 13 // 名称包含不允许的字符
+	ErrorCode_ERR_NAME_RESERVED      ErrorCode = 14 // 该名称为保留名称
+	ErrorCode_ERR_NAME_SENSITIVE     ErrorCode = 15 // 名称包含敏感词
+	ErrorCode_ERR_NAME_TAKEN         ErrorCode = 16 // 名称已被使用
+	// 账号 100-199
+	ErrorCode_ERR_ACCOUNT_EMPTY            ErrorCode = 100 // 账号或密码不能为空
+	ErrorCode_ERR_ACCOUNT_EXISTS           ErrorCode = 101 // 账号已存在
+	ErrorCode_ERR_ACCOUNT_NOT_FOUND        ErrorCode = 102 // 账号不存在
+	ErrorCode_ERR_ACCOUNT_PASSWORD         ErrorCode = 103 // 账号或密码错误
+	ErrorCode_ERR_ACCOUNT_LOGGED_ELSEWHERE ErrorCode = 104 // 账号已在其他地方登录
+	ErrorCode_ERR_ACCOUNT_NOT_LOGGED_IN    ErrorCode = 105 // 请先登录账号
+	ErrorCode_ERR_RECONNECT_TOKEN_INVALID  ErrorCode = 106 // 重连令牌无效或已过期
+	ErrorCode_ERR_CLIENT_VERSION_TOO_OLD   ErrorCode = 107 // 客户端协议版本过低，需强制更新
+	ErrorCode_ERR_ACCOUNT_BANNED           ErrorCode = 108 // 账号已被封禁
+	ErrorCode_ERR_LOGIN_QUEUE_TIMEOUT      ErrorCode = 109 // 排队超时，请重新登录
+	ErrorCode_ERR_LOGIN_QUEUED             ErrorCode = 110 // 正在排队中
+	// 角色 200-299
+	ErrorCode_ERR_PLAYER_NAME_EMPTY         ErrorCode = 200 // 玩家名称不能为空
+	ErrorCode_ERR_PLAYER_NOT_FOUND          ErrorCode = 201 // 玩家不存在
+	ErrorCode_ERR_PLAYER_NOT_LOGGED_IN      ErrorCode = 202 // 玩家未登录
+	ErrorCode_ERR_PLAYER_ALREADY_LOGGED_IN  ErrorCode = 203 // 角色已登录
+	ErrorCode_ERR_PLAYER_LOGGED_ELSEWHERE   ErrorCode = 204 // 角色已在其他地方登录
+	ErrorCode_ERR_PLAYER_LOGOUT_REQUIRED    ErrorCode = 205 // 请先登出当前角色
+	ErrorCode_ERR_PLAYER_OFFLINE            ErrorCode = 206 // 玩家不在线
+	ErrorCode_ERR_LEVEL_TOO_LOW             ErrorCode = 207 // 等级不足
+	ErrorCode_ERR_PLAYER_BANNED             ErrorCode = 208 // 角色已被封禁
+	ErrorCode_ERR_PLAYER_MUTED              ErrorCode = 209 // 已被禁言
+	ErrorCode_ERR_PLAYER_TRADE_LOCKED       ErrorCode = 210 // 交易已被冻结
+	ErrorCode_ERR_RENAME_CARD_REQUIRED      ErrorCode = 211 // 需要使用改名卡
+	ErrorCode_ERR_PLAYER_GUILD_LEADER       ErrorCode = 212 // 公会会长不能删除角色
+	ErrorCode_ERR_PLAYER_ACTIVE_AUCTION     ErrorCode = 213 // 有进行中的拍卖，不能删除角色
+	ErrorCode_ERR_PLAYER_PENDING_DELETE     ErrorCode = 214 // 角色待删除
+	ErrorCode_ERR_PLAYER_NOT_PENDING_DELETE ErrorCode = 215 // 角色未申请删除
+	ErrorCode_ERR_PLAYER_RESTORE_EXPIRED    ErrorCode = 216 // 已超过角色恢复期限
+	// 背包与装备 300-399
+	ErrorCode_ERR_ITEM_NOT_FOUND      ErrorCode = 300 // 物品不存在
+	ErrorCode_ERR_ITEM_COUNT_INVALID  ErrorCode = 301 // 数量错误
+	ErrorCode_ERR_INVENTORY_FULL      ErrorCode = 302 // 背包已满
+	ErrorCode_ERR_EQUIP_SLOT_INVALID  ErrorCode = 310 // 装备位置错误
+	ErrorCode_ERR_EQUIP_SLOT_MISMATCH ErrorCode = 311 // 该物品无法装备到此位置
+	ErrorCode_ERR_EQUIP_SLOT_EMPTY    ErrorCode = 312 // 该位置没有装备
+	// 邮件 400-499
+	ErrorCode_ERR_MAIL_NOT_FOUND        ErrorCode = 400 // 邮件不存在
+	ErrorCode_ERR_MAIL_NO_ATTACHMENT    ErrorCode = 401 // 邮件没有附件
+	ErrorCode_ERR_MAIL_TITLE_EMPTY      ErrorCode = 402 // 邮件标题不能为空
+	ErrorCode_ERR_MAIL_RECEIVER_OFFLINE ErrorCode = 403 // 收件人不在线
+	// 任务 500-599
+	ErrorCode_ERR_TASK_NOT_FOUND     ErrorCode = 500 // 任务不存在
+	ErrorCode_ERR_TASK_NOT_COMPLETED ErrorCode = 501 // 任务未完成
+	ErrorCode_ERR_TASK_LIMIT         ErrorCode = 502 // 任务数量已达上限
+	ErrorCode_ERR_TASK_ACCEPTED      ErrorCode = 503 // 任务已接受
+	ErrorCode_ERR_TASK_CANNOT_CANCEL ErrorCode = 504 // 任务不存在或无法放弃
+	// 技能 600-699
+	ErrorCode_ERR_SKILL_NOT_FOUND         ErrorCode = 600 // 技能不存在
+	ErrorCode_ERR_SKILL_LOCKED            ErrorCode = 601 // 技能未解锁
+	ErrorCode_ERR_SKILL_LEARNED           ErrorCode = 602 // 技能已学习
+	ErrorCode_ERR_SKILL_LIMIT             ErrorCode = 603 // 技能数量已达上限
+	ErrorCode_ERR_SKILL_COOLDOWN          ErrorCode = 604 // 技能冷却中
+	ErrorCode_ERR_SKILL_PASSIVE           ErrorCode = 605 // 被动技能无法使用
+	ErrorCode_ERR_SKILL_UPGRADE_CONDITION ErrorCode = 606 // 不满足升级条件
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:   "ERR_OK",
+		1:   "ERR_SERVER",
+		2:   "ERR_INVALID_REQUEST",
+		3:   "ERR_STATE_NOT_ALLOWED",
+		4:   "ERR_HANDSHAKE_REQUIRED",
+		5:   "ERR_SECURITY_DISABLED",
+		6:   "ERR_HANDSHAKE_DONE",
+		7:   "ERR_UNSUPPORTED_CIPHER",
+		8:   "ERR_HANDSHAKE_FAILED",
+		9:   "ERR_NOT_IMPLEMENTED",
+		10:  "ERR_RATE_LIMITED",
+		11:  "ERR_SERVER_FULL",
+		12:  "ERR_NAME_LENGTH",
+		13:  "ERR_NAME_CHARSET",
+		14:  "ERR_NAME_RESERVED",
+		15:  "ERR_NAME_SENSITIVE",
+		16:  "ERR_NAME_TAKEN",
+		100: "ERR_ACCOUNT_EMPTY",
+		101: "ERR_ACCOUNT_EXISTS",
+		102: "ERR_ACCOUNT_NOT_FOUND",
+		103: "ERR_ACCOUNT_PASSWORD",
+		104: "ERR_ACCOUNT_LOGGED_ELSEWHERE",
+		105: "ERR_ACCOUNT_NOT_LOGGED_IN",
+		106: "ERR_RECONNECT_TOKEN_INVALID",
+		107: "ERR_CLIENT_VERSION_TOO_OLD",
+		108: "ERR_ACCOUNT_BANNED",
+		109: "ERR_LOGIN_QUEUE_TIMEOUT",
+		110: "ERR_LOGIN_QUEUED",
+		200: "ERR_PLAYER_NAME_EMPTY",
+		201: "ERR_PLAYER_NOT_FOUND",
+		202: "ERR_PLAYER_NOT_LOGGED_IN",
+		203: "ERR_PLAYER_ALREADY_LOGGED_IN",
+		204: "ERR_PLAYER_LOGGED_ELSEWHERE",
+		205: "ERR_PLAYER_LOGOUT_REQUIRED",
+		206: "ERR_PLAYER_OFFLINE",
+		207: "ERR_LEVEL_TOO_LOW",
+		208: "ERR_PLAYER_BANNED",
+		209: "ERR_PLAYER_MUTED",
+		210: "ERR_PLAYER_TRADE_LOCKED",
+		211: "ERR_RENAME_CARD_REQUIRED",
+		212: "ERR_PLAYER_GUILD_LEADER",
+		213: "ERR_PLAYER_ACTIVE_AUCTION",
+		214: "ERR_PLAYER_PENDING_DELETE",
+		215: "ERR_PLAYER_NOT_PENDING_DELETE",
+		216: "ERR_PLAYER_RESTORE_EXPIRED",
+		300: "ERR_ITEM_NOT_FOUND",
+		301: "ERR_ITEM_COUNT_INVALID",
+		302: "ERR_INVENTORY_FULL",
+		310: "ERR_EQUIP_SLOT_INVALID",
+		311: "ERR_EQUIP_SLOT_MISMATCH",
+		312: "ERR_EQUIP_SLOT_EMPTY",
+		400: "ERR_MAIL_NOT_FOUND",
+		401: "ERR_MAIL_NO_ATTACHMENT",
+		402: "ERR_MAIL_TITLE_EMPTY",
+		403: "ERR_MAIL_RECEIVER_OFFLINE",
+		500: "ERR_TASK_NOT_FOUND",
+		501: "ERR_TASK_NOT_COMPLETED",
+		502: "ERR_TASK_LIMIT",
+		503: "ERR_TASK_ACCEPTED",
+		504: "ERR_TASK_CANNOT_CANCEL",
+		600: "ERR_SKILL_NOT_FOUND",
+		601: "ERR_SKILL_LOCKED",
+		602: "ERR_SKILL_LEARNED",
+		603: "ERR_SKILL_LIMIT",
+		604: "ERR_SKILL_COOLDOWN",
+		605: "ERR_SKILL_PASSIVE",
+		606: "ERR_SKILL_UPGRADE_CONDITION",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERR_OK":                        0,
+		"ERR_SERVER":                    1,
+		"ERR_INVALID_REQUEST":           2,
+		"ERR_STATE_NOT_ALLOWED":         3,
+		"ERR_HANDSHAKE_REQUIRED":        4,
+		"ERR_SECURITY_DISABLED":         5,
+		"ERR_HANDSHAKE_DONE":            6,
+		"ERR_UNSUPPORTED_CIPHER":        7,
+		"ERR_HANDSHAKE_FAILED":          8,
+		"ERR_NOT_IMPLEMENTED":           9,
+		"ERR_RATE_LIMITED":              10,
+		"ERR_SERVER_FULL":               11,
+		"ERR_NAME_LENGTH":               12,
+		"ERR_NAME_CHARSET":              13,
+		"ERR_NAME_RESERVED":             14,
+		"ERR_NAME_SENSITIVE":            15,
+		"ERR_NAME_TAKEN":                16,
+		"ERR_ACCOUNT_EMPTY":             100,
+		"ERR_ACCOUNT_EXISTS":            101,
+		"ERR_ACCOUNT_NOT_FOUND":         102,
+		"ERR_ACCOUNT_PASSWORD":          103,
+		"ERR_ACCOUNT_LOGGED_ELSEWHERE":  104,
+		"ERR_ACCOUNT_NOT_LOGGED_IN":     105,
+		"ERR_RECONNECT_TOKEN_INVALID":   106,
+		"ERR_CLIENT_VERSION_TOO_OLD":    107,
+		"ERR_ACCOUNT_BANNED":            108,
+		"ERR_LOGIN_QUEUE_TIMEOUT":       109,
+		"ERR_LOGIN_QUEUED":              110,
+		"ERR_PLAYER_NAME_EMPTY":         200,
+		"ERR_PLAYER_NOT_FOUND":          201,
+		"ERR_PLAYER_NOT_LOGGED_IN":      202,
+		"ERR_PLAYER_ALREADY_LOGGED_IN":  203,
+		"ERR_PLAYER_LOGGED_ELSEWHERE":   204,
+		"ERR_PLAYER_LOGOUT_REQUIRED":    205,
+		"ERR_PLAYER_OFFLINE":            206,
+		"ERR_LEVEL_TOO_LOW":             207,
+		"ERR_PLAYER_BANNED":             208,
+		"ERR_PLAYER_MUTED":              209,
+		"ERR_PLAYER_TRADE_LOCKED":       210,
+		"ERR_RENAME_CARD_REQUIRED":      211,
+		"ERR_PLAYER_GUILD_LEADER":       212,
+		"ERR_PLAYER_ACTIVE_AUCTION":     213,
+		"ERR_PLAYER_PENDING_DELETE":     214,
+		"ERR_PLAYER_NOT_PENDING_DELETE": 215,
+		"ERR_PLAYER_RESTORE_EXPIRED":    216,
+		"ERR_ITEM_NOT_FOUND":            300,
+		"ERR_ITEM_COUNT_INVALID":        301,
+		"ERR_INVENTORY_FULL":            302,
+		"ERR_EQUIP_SLOT_INVALID":        310,
+		"ERR_EQUIP_SLOT_MISMATCH":       311,
+		"ERR_EQUIP_SLOT_EMPTY":          312,
+		"ERR_MAIL_NOT_FOUND":            400,
+		"ERR_MAIL_NO_ATTACHMENT":        401,
+		"ERR_MAIL_TITLE_EMPTY":          402,
+		"ERR_MAIL_RECEIVER_OFFLINE":     403,
+		"ERR_TASK_NOT_FOUND":            500,
+		"ERR_TASK_NOT_COMPLETED":        501,
+		"ERR_TASK_LIMIT":                502,
+		"ERR_TASK_ACCEPTED":             503,
+		"ERR_TASK_CANNOT_CANCEL":        504,
+		"ERR_SKILL_NOT_FOUND":           600,
+		"ERR_SKILL_LOCKED":              601,
+		"ERR_SKILL_LEARNED":             602,
+		"ERR_SKILL_LIMIT":               603,
+		"ERR_SKILL_COOLDOWN":            604,
+		"ERR_SKILL_PASSIVE":             605,
+		"ERR_SKILL_UPGRADE_CONDITION":   606,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_resources_protocol_game_proto_enumTypes[7].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_resources_protocol_game_proto_enumTypes[7]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{7}
 }
 
 // 基础消息结构
@@ -538,13 +875,16 @@ func (x *Message) GetData() []byte {
 	return nil
 }
 
-// 响应消息结构
+// 协议版本不低于1时，客户端请求的消息体前带4字节请求序号（大端），服务器对请求的回复统一封装为Response并原样带回序号（版本0不带序号，回复序号为0）；
+// 客户端请求的消息体前带4字节请求序号（大端），服务器对请求的回复统一封装为Response并原样带回序号；
+// result为ErrorCode，成功时data为该请求对应的业务响应，失败时data为空
 type Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MsgId         uint32                 `protobuf:"varint,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
 	Result        int32                  `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
-	ErrorMsg      string                 `protobuf:"bytes,3,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,3,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"` // 错误码的默认描述，客户端应按result本地化
 	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Seq           uint32                 `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"` // 请求序号
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -607,6 +947,13 @@ func (x *Response) GetData() []byte {
 	return nil
 }
 
+func (x *Response) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
 // 账号创建请求
 type AccountCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -745,6 +1092,8 @@ type AccountLoginRequest struct {
 	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	DeviceType    int32                  `protobuf:"varint,4,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
 	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Compressions  []string               `protobuf:"bytes,6,rep,name=compressions,proto3" json:"compressions,omitempty"` // 客户端支持的压缩算法（如snappy），为空表示不压缩
+	Batch         bool                   `protobuf:"varint,7,opt,name=batch,proto3" json:"batch,omitempty"`              // 客户端能否拆分下行合并消息（MSG_SYSTEM_BATCH）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -814,6 +1163,20 @@ func (x *AccountLoginRequest) GetVersion() string {
 	return ""
 }
 
+func (x *AccountLoginRequest) GetCompressions() []string {
+	if x != nil {
+		return x.Compressions
+	}
+	return nil
+}
+
+func (x *AccountLoginRequest) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
 // 玩家信息
 type PlayerInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -822,6 +1185,7 @@ type PlayerInfo struct {
 	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
 	Sex           int32                  `protobuf:"varint,4,opt,name=sex,proto3" json:"sex,omitempty"`
 	Age           int32                  `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	DeleteAt      int64                  `protobuf:"varint,6,opt,name=delete_at,json=deleteAt,proto3" json:"delete_at,omitempty"` // 计划删除时间（Unix秒），0表示未申请删除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -891,14 +1255,24 @@ func (x *PlayerInfo) GetAge() int32 {
 	return 0
 }
 
+func (x *PlayerInfo) GetDeleteAt() int64 {
+	if x != nil {
+		return x.DeleteAt
+	}
+	return 0
+}
+
 // 账号登录响应
 type AccountLoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	Players       []*PlayerInfo          `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg        string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Players         []*PlayerInfo          `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	Compression     string                 `protobuf:"bytes,4,opt,name=compression,proto3" json:"compression,omitempty"`                                 // 选定的压缩算法，为空表示不压缩；此响应之后双向消息可按包头压缩标志压缩
+	ProtocolVersion int32                  `protobuf:"varint,5,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // 协商的协议版本，不高于客户端数据包头中的版本
+	Batch           bool                   `protobuf:"varint,6,opt,name=batch,proto3" json:"batch,omitempty"`                                            // 此响应之后下行消息可能合并为MSG_SYSTEM_BATCH写出
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AccountLoginResponse) Reset() {
@@ -952,7 +1326,29 @@ func (x *AccountLoginResponse) GetPlayers() []*PlayerInfo {
 	return nil
 }
 
+func (x *AccountLoginResponse) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *AccountLoginResponse) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *AccountLoginResponse) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
 // 玩家创建请求
+// 创建成功后角色不进入游戏，客户端需再发送MSG_PLAYER_PLAYER_LOGIN登录该角色
 type PlayerCreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1128,6 +1524,7 @@ type PlayerLoginResponse struct {
 	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
 	Gold          int64                  `protobuf:"varint,6,opt,name=gold,proto3" json:"gold,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,7,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 断线重连令牌（未启用断线重连时为空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1204,28 +1601,38 @@ func (x *PlayerLoginResponse) GetGold() int64 {
 	return 0
 }
 
-// 玩家获取信息请求
-type PlayerGetInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+func (x *PlayerLoginResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 断线重连请求
+type PlayerReconnectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// 角色登录后已收到的游戏内玩家请求（背包、邮件、任务、技能等转发给玩家Actor处理的请求）的响应数量
+	// 广播推送、系统消息以及登录、登出、地图等网络层直接处理的请求的响应不计入序号，断线期间丢失的这类消息不会补发
+	LastRecvSeq   uint64 `protobuf:"varint,2,opt,name=last_recv_seq,json=lastRecvSeq,proto3" json:"last_recv_seq,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerGetInfoRequest) Reset() {
-	*x = PlayerGetInfoRequest{}
+func (x *PlayerReconnectRequest) Reset() {
+	*x = PlayerReconnectRequest{}
 	mi := &file_resources_protocol_game_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerGetInfoRequest) String() string {
+func (x *PlayerReconnectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerGetInfoRequest) ProtoMessage() {}
+func (*PlayerReconnectRequest) ProtoMessage() {}
 
-func (x *PlayerGetInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerReconnectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resources_protocol_game_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1237,42 +1644,53 @@ func (x *PlayerGetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerGetInfoRequest.ProtoReflect.Descriptor instead.
-func (*PlayerGetInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerReconnectRequest.ProtoReflect.Descriptor instead.
+func (*PlayerReconnectRequest) Descriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PlayerGetInfoRequest) GetPlayerId() int64 {
+func (x *PlayerReconnectRequest) GetResumeToken() string {
 	if x != nil {
-		return x.PlayerId
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *PlayerReconnectRequest) GetLastRecvSeq() uint64 {
+	if x != nil {
+		return x.LastRecvSeq
 	}
 	return 0
 }
 
-// 玩家获取信息响应
-type PlayerGetInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	PlayerInfo    *PlayerBasicInfo       `protobuf:"bytes,3,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 断线重连响应
+type PlayerReconnectResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg        string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PlayerId        int64                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ResumeToken     string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`              // 新的重连令牌，旧令牌失效
+	ServerSeq       uint64                 `protobuf:"varint,5,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`                   // 服务器已发送的游戏内玩家请求响应数量（计数范围同last_recv_seq）
+	FullResync      bool                   `protobuf:"varint,6,opt,name=full_resync,json=fullResync,proto3" json:"full_resync,omitempty"`                // 缺失的消息已无法补发，客户端需重新拉取完整数据
+	ProtocolVersion int32                  `protobuf:"varint,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // 新连接重新协商的协议版本
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PlayerGetInfoResponse) Reset() {
-	*x = PlayerGetInfoResponse{}
+func (x *PlayerReconnectResponse) Reset() {
+	*x = PlayerReconnectResponse{}
 	mi := &file_resources_protocol_game_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerGetInfoResponse) String() string {
+func (x *PlayerReconnectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerGetInfoResponse) ProtoMessage() {}
+func (*PlayerReconnectResponse) ProtoMessage() {}
 
-func (x *PlayerGetInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *PlayerReconnectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_resources_protocol_game_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1284,54 +1702,83 @@ func (x *PlayerGetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerGetInfoResponse.ProtoReflect.Descriptor instead.
-func (*PlayerGetInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerReconnectResponse.ProtoReflect.Descriptor instead.
+func (*PlayerReconnectResponse) Descriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PlayerGetInfoResponse) GetSuccess() bool {
+func (x *PlayerReconnectResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *PlayerGetInfoResponse) GetErrorMsg() string {
+func (x *PlayerReconnectResponse) GetErrorMsg() string {
 	if x != nil {
 		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *PlayerGetInfoResponse) GetPlayerInfo() *PlayerBasicInfo {
+func (x *PlayerReconnectResponse) GetPlayerId() int64 {
 	if x != nil {
-		return x.PlayerInfo
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-// 玩家登出请求
-type PlayerLogoutRequest struct {
+func (x *PlayerReconnectResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *PlayerReconnectResponse) GetServerSeq() uint64 {
+	if x != nil {
+		return x.ServerSeq
+	}
+	return 0
+}
+
+func (x *PlayerReconnectResponse) GetFullResync() bool {
+	if x != nil {
+		return x.FullResync
+	}
+	return false
+}
+
+func (x *PlayerReconnectResponse) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+// 角色改名请求（消耗背包中的一张改名卡）
+type PlayerRenameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // 改名卡所在背包位置
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`          // 新名称
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerLogoutRequest) Reset() {
-	*x = PlayerLogoutRequest{}
+func (x *PlayerRenameRequest) Reset() {
+	*x = PlayerRenameRequest{}
 	mi := &file_resources_protocol_game_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerLogoutRequest) String() string {
+func (x *PlayerRenameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerLogoutRequest) ProtoMessage() {}
+func (*PlayerRenameRequest) ProtoMessage() {}
 
-func (x *PlayerLogoutRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerRenameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resources_protocol_game_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,41 +1790,49 @@ func (x *PlayerLogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerLogoutRequest.ProtoReflect.Descriptor instead.
-func (*PlayerLogoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerRenameRequest.ProtoReflect.Descriptor instead.
+func (*PlayerRenameRequest) Descriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PlayerLogoutRequest) GetPlayerId() int64 {
+func (x *PlayerRenameRequest) GetPosition() int32 {
 	if x != nil {
-		return x.PlayerId
+		return x.Position
 	}
 	return 0
 }
 
-// 玩家登出响应
-type PlayerLogoutResponse struct {
+func (x *PlayerRenameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 角色改名响应
+type PlayerRenameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerLogoutResponse) Reset() {
-	*x = PlayerLogoutResponse{}
+func (x *PlayerRenameResponse) Reset() {
+	*x = PlayerRenameResponse{}
 	mi := &file_resources_protocol_game_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerLogoutResponse) String() string {
+func (x *PlayerRenameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerLogoutResponse) ProtoMessage() {}
+func (*PlayerRenameResponse) ProtoMessage() {}
 
-func (x *PlayerLogoutResponse) ProtoReflect() protoreflect.Message {
+func (x *PlayerRenameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_resources_protocol_game_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1389,54 +1844,54 @@ func (x *PlayerLogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerLogoutResponse.ProtoReflect.Descriptor instead.
-func (*PlayerLogoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerRenameResponse.ProtoReflect.Descriptor instead.
+func (*PlayerRenameResponse) Descriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PlayerLogoutResponse) GetSuccess() bool {
+func (x *PlayerRenameResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *PlayerLogoutResponse) GetErrorMsg() string {
+func (x *PlayerRenameResponse) GetErrorMsg() string {
 	if x != nil {
 		return x.ErrorMsg
 	}
 	return ""
 }
 
-// 玩家基础信息
-type PlayerBasicInfo struct {
+func (x *PlayerRenameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 角色删除请求：角色进入删除冷却期，期间可恢复
+type PlayerDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
-	Gold          int64                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
-	VipLevel      int32                  `protobuf:"varint,6,opt,name=vip_level,json=vipLevel,proto3" json:"vip_level,omitempty"`
-	ServerId      int32                  `protobuf:"varint,7,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerBasicInfo) Reset() {
-	*x = PlayerBasicInfo{}
+func (x *PlayerDeleteRequest) Reset() {
+	*x = PlayerDeleteRequest{}
 	mi := &file_resources_protocol_game_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerBasicInfo) String() string {
+func (x *PlayerDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerBasicInfo) ProtoMessage() {}
+func (*PlayerDeleteRequest) ProtoMessage() {}
 
-func (x *PlayerBasicInfo) ProtoReflect() protoreflect.Message {
+func (x *PlayerDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resources_protocol_game_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,97 +1903,110 @@ func (x *PlayerBasicInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerBasicInfo.ProtoReflect.Descriptor instead.
-func (*PlayerBasicInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PlayerDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_resources_protocol_game_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PlayerBasicInfo) GetPlayerId() int64 {
+func (x *PlayerDeleteRequest) GetPlayerId() int64 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *PlayerBasicInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// 角色删除响应
+type PlayerDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PlayerId      int64                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	DeleteAt      int64                  `protobuf:"varint,4,opt,name=delete_at,json=deleteAt,proto3" json:"delete_at,omitempty"` // 计划删除时间（Unix秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerBasicInfo) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
+func (x *PlayerDeleteResponse) Reset() {
+	*x = PlayerDeleteResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerBasicInfo) GetExp() int64 {
-	if x != nil {
-		return x.Exp
-	}
-	return 0
+func (x *PlayerDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PlayerBasicInfo) GetGold() int64 {
+func (*PlayerDeleteResponse) ProtoMessage() {}
+
+func (x *PlayerDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[16]
 	if x != nil {
-		return x.Gold
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerBasicInfo) GetVipLevel() int32 {
+// Deprecated: Use PlayerDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PlayerDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerDeleteResponse) GetSuccess() bool {
 	if x != nil {
-		return x.VipLevel
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *PlayerBasicInfo) GetServerId() int32 {
+func (x *PlayerDeleteResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.ServerId
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *PlayerDeleteResponse) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *PlayerBasicInfo) GetCreateTime() int64 {
+func (x *PlayerDeleteResponse) GetDeleteAt() int64 {
 	if x != nil {
-		return x.CreateTime
+		return x.DeleteAt
 	}
 	return 0
 }
 
-// 物品信息
-type ItemInfo struct {
+// 角色恢复请求：撤销删除冷却期内的角色删除
+type PlayerRestoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	ItemType      int32                  `protobuf:"varint,2,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
-	ItemName      string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	ItemCount     int32                  `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
-	ItemLevel     int32                  `protobuf:"varint,5,opt,name=item_level,json=itemLevel,proto3" json:"item_level,omitempty"`
-	ItemQuality   int32                  `protobuf:"varint,6,opt,name=item_quality,json=itemQuality,proto3" json:"item_quality,omitempty"`
-	BindType      int32                  `protobuf:"varint,7,opt,name=bind_type,json=bindType,proto3" json:"bind_type,omitempty"`
-	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ItemInfo) Reset() {
-	*x = ItemInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[16]
+func (x *PlayerRestoreRequest) Reset() {
+	*x = PlayerRestoreRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ItemInfo) String() string {
+func (x *PlayerRestoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ItemInfo) ProtoMessage() {}
+func (*PlayerRestoreRequest) ProtoMessage() {}
 
-func (x *ItemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[16]
+func (x *PlayerRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,98 +2017,3227 @@ func (x *ItemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ItemInfo.ProtoReflect.Descriptor instead.
-func (*ItemInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PlayerRestoreRequest.ProtoReflect.Descriptor instead.
+func (*PlayerRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ItemInfo) GetItemId() int64 {
+func (x *PlayerRestoreRequest) GetPlayerId() int64 {
 	if x != nil {
-		return x.ItemId
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ItemInfo) GetItemType() int32 {
+// 角色恢复响应
+type PlayerRestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Player        *PlayerInfo            `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRestoreResponse) Reset() {
+	*x = PlayerRestoreResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRestoreResponse) ProtoMessage() {}
+
+func (x *PlayerRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[18]
 	if x != nil {
-		return x.ItemType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRestoreResponse.ProtoReflect.Descriptor instead.
+func (*PlayerRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlayerRestoreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlayerRestoreResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *PlayerRestoreResponse) GetPlayer() *PlayerInfo {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// 玩家获取信息请求
+type PlayerGetInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerGetInfoRequest) Reset() {
+	*x = PlayerGetInfoRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGetInfoRequest) ProtoMessage() {}
+
+func (x *PlayerGetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*PlayerGetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlayerGetInfoRequest) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ItemInfo) GetItemName() string {
+// 玩家获取信息响应
+type PlayerGetInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PlayerInfo    *PlayerBasicInfo       `protobuf:"bytes,3,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerGetInfoResponse) Reset() {
+	*x = PlayerGetInfoResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGetInfoResponse) ProtoMessage() {}
+
+func (x *PlayerGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[20]
 	if x != nil {
-		return x.ItemName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*PlayerGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlayerGetInfoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlayerGetInfoResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *ItemInfo) GetItemCount() int32 {
+func (x *PlayerGetInfoResponse) GetPlayerInfo() *PlayerBasicInfo {
 	if x != nil {
-		return x.ItemCount
+		return x.PlayerInfo
+	}
+	return nil
+}
+
+// 玩家登出请求
+type PlayerLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLogoutRequest) Reset() {
+	*x = PlayerLogoutRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLogoutRequest) ProtoMessage() {}
+
+func (x *PlayerLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLogoutRequest.ProtoReflect.Descriptor instead.
+func (*PlayerLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlayerLogoutRequest) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ItemInfo) GetItemLevel() int32 {
+// 玩家登出响应
+type PlayerLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLogoutResponse) Reset() {
+	*x = PlayerLogoutResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLogoutResponse) ProtoMessage() {}
+
+func (x *PlayerLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLogoutResponse.ProtoReflect.Descriptor instead.
+func (*PlayerLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlayerLogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlayerLogoutResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+// 登录排队通知（服务器满员时角色登录进入排队，位置变化或定期推送；
+// 排到后服务器回复原角色登录请求，排队超时回复ERR_LOGIN_QUEUE_TIMEOUT）
+type LoginQueueNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`                          // 当前排队位置（从1开始）
+	QueueLength   int32                  `protobuf:"varint,2,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"` // 排队总人数
+	EtaSeconds    int32                  `protobuf:"varint,3,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`    // 预计等待时间（秒），0表示暂无法估计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginQueueNotify) Reset() {
+	*x = LoginQueueNotify{}
+	mi := &file_resources_protocol_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginQueueNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginQueueNotify) ProtoMessage() {}
+
+func (x *LoginQueueNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginQueueNotify.ProtoReflect.Descriptor instead.
+func (*LoginQueueNotify) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LoginQueueNotify) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *LoginQueueNotify) GetQueueLength() int32 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *LoginQueueNotify) GetEtaSeconds() int32 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+// 踢下线通知（服务器主动推送，随后断开连接）
+type KickNotify struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        KickReason             `protobuf:"varint,1,opt,name=reason,proto3,enum=protocol.KickReason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickNotify) Reset() {
+	*x = KickNotify{}
+	mi := &file_resources_protocol_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickNotify) ProtoMessage() {}
+
+func (x *KickNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickNotify.ProtoReflect.Descriptor instead.
+func (*KickNotify) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KickNotify) GetReason() KickReason {
+	if x != nil {
+		return x.Reason
+	}
+	return KickReason_KICK_REASON_UNKNOWN
+}
+
+func (x *KickNotify) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 加密握手请求（连接建立后的第一条消息）
+type HandshakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     []byte                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // 客户端X25519临时公钥
+	Ciphers       []string               `protobuf:"bytes,2,rep,name=ciphers,proto3" json:"ciphers,omitempty"`                      // 客户端支持的AEAD算法，为空表示接受服务器配置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandshakeRequest) Reset() {
+	*x = HandshakeRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandshakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeRequest) ProtoMessage() {}
+
+func (x *HandshakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeRequest.ProtoReflect.Descriptor instead.
+func (*HandshakeRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HandshakeRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *HandshakeRequest) GetCiphers() []string {
+	if x != nil {
+		return x.Ciphers
+	}
+	return nil
+}
+
+// 加密握手响应（明文发送，此后双向消息体均加密）
+// 客户端须以预置的服务器签名公钥校验signature，签名内容为
+// "zgameserver handshake v1" + 客户端公钥 + public_key + cipher，校验失败时断开连接
+type HandshakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // 服务器X25519临时公钥
+	Cipher        string                 `protobuf:"bytes,4,opt,name=cipher,proto3" json:"cipher,omitempty"`                        // 选定的AEAD算法
+	Signature     []byte                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`                  // 服务器静态Ed25519私钥对握手内容的签名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandshakeResponse) Reset() {
+	*x = HandshakeResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandshakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeResponse) ProtoMessage() {}
+
+func (x *HandshakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeResponse.ProtoReflect.Descriptor instead.
+func (*HandshakeResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HandshakeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandshakeResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *HandshakeResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *HandshakeResponse) GetCipher() string {
+	if x != nil {
+		return x.Cipher
+	}
+	return ""
+}
+
+func (x *HandshakeResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// 网络探测请求，客户端定期发送并上报本端测得的网络状况
+type PingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientTime    int64                  `protobuf:"varint,1,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"` // 客户端发送时间（毫秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PingRequest) GetClientTime() int64 {
+	if x != nil {
+		return x.ClientTime
+	}
+	return 0
+}
+
+// 网络探测响应
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientTime    int64                  `protobuf:"varint,1,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"` // 原样返回请求中的客户端时间
+	ServerTime    int64                  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 服务器时间（毫秒）
+	ProbeSeq      uint32                 `protobuf:"varint,3,opt,name=probe_seq,json=probeSeq,proto3" json:"probe_seq,omitempty"`       // 网络探测序号，客户端收到后立即以PingAck原样回应，服务器据此测量往返时延与丢包率
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PingResponse) GetClientTime() int64 {
+	if x != nil {
+		return x.ClientTime
+	}
+	return 0
+}
+
+func (x *PingResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *PingResponse) GetProbeSeq() uint32 {
+	if x != nil {
+		return x.ProbeSeq
+	}
+	return 0
+}
+
+// 网络探测回应（无响应）
+type PingAckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProbeSeq      uint32                 `protobuf:"varint,1,opt,name=probe_seq,json=probeSeq,proto3" json:"probe_seq,omitempty"` // PingResponse中的探测序号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingAckRequest) Reset() {
+	*x = PingAckRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingAckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingAckRequest) ProtoMessage() {}
+
+func (x *PingAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingAckRequest.ProtoReflect.Descriptor instead.
+func (*PingAckRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PingAckRequest) GetProbeSeq() uint32 {
+	if x != nil {
+		return x.ProbeSeq
+	}
+	return 0
+}
+
+// UDP通道绑定请求（经TCP发送，获取绑定令牌）
+type UdpBindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UdpBindRequest) Reset() {
+	*x = UdpBindRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UdpBindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpBindRequest) ProtoMessage() {}
+
+func (x *UdpBindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpBindRequest.ProtoReflect.Descriptor instead.
+func (*UdpBindRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{30}
+}
+
+// UDP通道绑定响应
+// 客户端随后向address发送携带token的UDP绑定报文，绑定成功后即可经UDP收发消息
+type UdpBindResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Mtu           int32                  `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UdpBindResponse) Reset() {
+	*x = UdpBindResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UdpBindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UdpBindResponse) ProtoMessage() {}
+
+func (x *UdpBindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UdpBindResponse.ProtoReflect.Descriptor instead.
+func (*UdpBindResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UdpBindResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UdpBindResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *UdpBindResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UdpBindResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UdpBindResponse) GetMtu() int32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+// 玩家基础信息
+type PlayerBasicInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	Gold          int64                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
+	VipLevel      int32                  `protobuf:"varint,6,opt,name=vip_level,json=vipLevel,proto3" json:"vip_level,omitempty"`
+	ServerId      int32                  `protobuf:"varint,7,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerBasicInfo) Reset() {
+	*x = PlayerBasicInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerBasicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerBasicInfo) ProtoMessage() {}
+
+func (x *PlayerBasicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerBasicInfo.ProtoReflect.Descriptor instead.
+func (*PlayerBasicInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlayerBasicInfo) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerBasicInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetGold() int64 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetVipLevel() int32 {
+	if x != nil {
+		return x.VipLevel
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetServerId() int32 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *PlayerBasicInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// 物品信息
+type ItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemType      int32                  `protobuf:"varint,2,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	ItemName      string                 `protobuf:"bytes,3,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	ItemLevel     int32                  `protobuf:"varint,5,opt,name=item_level,json=itemLevel,proto3" json:"item_level,omitempty"`
+	ItemQuality   int32                  `protobuf:"varint,6,opt,name=item_quality,json=itemQuality,proto3" json:"item_quality,omitempty"`
+	BindType      int32                  `protobuf:"varint,7,opt,name=bind_type,json=bindType,proto3" json:"bind_type,omitempty"`
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemInfo) Reset() {
+	*x = ItemInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemInfo) ProtoMessage() {}
+
+func (x *ItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemInfo.ProtoReflect.Descriptor instead.
+func (*ItemInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ItemInfo) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetItemType() int32 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *ItemInfo) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetItemLevel() int32 {
+	if x != nil {
+		return x.ItemLevel
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetItemQuality() int32 {
+	if x != nil {
+		return x.ItemQuality
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetBindType() int32 {
+	if x != nil {
+		return x.BindType
+	}
+	return 0
+}
+
+func (x *ItemInfo) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// 任务信息
+type TaskInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      int32                  `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	TaskName      string                 `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	TaskDesc      string                 `protobuf:"bytes,4,opt,name=task_desc,json=taskDesc,proto3" json:"task_desc,omitempty"`
+	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Progress      []int32                `protobuf:"varint,6,rep,packed,name=progress,proto3" json:"progress,omitempty"`
+	Rewards       []*ItemInfo            `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	AcceptTime    int64                  `protobuf:"varint,8,opt,name=accept_time,json=acceptTime,proto3" json:"accept_time,omitempty"`
+	CompleteTime  int64                  `protobuf:"varint,9,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskInfo) Reset() {
+	*x = TaskInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo) ProtoMessage() {}
+
+func (x *TaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TaskInfo) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetTaskType() int32 {
+	if x != nil {
+		return x.TaskType
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetTaskDesc() string {
+	if x != nil {
+		return x.TaskDesc
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetProgress() []int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetRewards() []*ItemInfo {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetAcceptTime() int64 {
+	if x != nil {
+		return x.AcceptTime
+	}
+	return 0
+}
+
+func (x *TaskInfo) GetCompleteTime() int64 {
+	if x != nil {
+		return x.CompleteTime
+	}
+	return 0
+}
+
+// 技能信息
+type SkillInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	SkillName     string                 `protobuf:"bytes,2,opt,name=skill_name,json=skillName,proto3" json:"skill_name,omitempty"`
+	SkillLevel    int32                  `protobuf:"varint,3,opt,name=skill_level,json=skillLevel,proto3" json:"skill_level,omitempty"`
+	SkillType     int32                  `protobuf:"varint,4,opt,name=skill_type,json=skillType,proto3" json:"skill_type,omitempty"`
+	SkillCoolTime int64                  `protobuf:"varint,5,opt,name=skill_cool_time,json=skillCoolTime,proto3" json:"skill_cool_time,omitempty"`
+	SkillDamage   int64                  `protobuf:"varint,6,opt,name=skill_damage,json=skillDamage,proto3" json:"skill_damage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillInfo) Reset() {
+	*x = SkillInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillInfo) ProtoMessage() {}
+
+func (x *SkillInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillInfo.ProtoReflect.Descriptor instead.
+func (*SkillInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SkillInfo) GetSkillId() int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *SkillInfo) GetSkillName() string {
+	if x != nil {
+		return x.SkillName
+	}
+	return ""
+}
+
+func (x *SkillInfo) GetSkillLevel() int32 {
+	if x != nil {
+		return x.SkillLevel
+	}
+	return 0
+}
+
+func (x *SkillInfo) GetSkillType() int32 {
+	if x != nil {
+		return x.SkillType
+	}
+	return 0
+}
+
+func (x *SkillInfo) GetSkillCoolTime() int64 {
+	if x != nil {
+		return x.SkillCoolTime
+	}
+	return 0
+}
+
+func (x *SkillInfo) GetSkillDamage() int64 {
+	if x != nil {
+		return x.SkillDamage
+	}
+	return 0
+}
+
+// 邮件信息
+type MailInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
+	SenderId      int64                  `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	ReceiverId    int64                  `protobuf:"varint,4,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	ReceiverName  string                 `protobuf:"bytes,5,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Items         []*ItemInfo            `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	Gold          int64                  `protobuf:"varint,9,opt,name=gold,proto3" json:"gold,omitempty"`
+	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	SendTime      int64                  `protobuf:"varint,11,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
+	ReadTime      int64                  `protobuf:"varint,12,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailInfo) Reset() {
+	*x = MailInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailInfo) ProtoMessage() {}
+
+func (x *MailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailInfo.ProtoReflect.Descriptor instead.
+func (*MailInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MailInfo) GetMailId() int64 {
+	if x != nil {
+		return x.MailId
+	}
+	return 0
+}
+
+func (x *MailInfo) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *MailInfo) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *MailInfo) GetReceiverId() int64 {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return 0
+}
+
+func (x *MailInfo) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
+}
+
+func (x *MailInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MailInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MailInfo) GetItems() []*ItemInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *MailInfo) GetGold() int64 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *MailInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MailInfo) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *MailInfo) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+// 公会信息
+type GuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       int64                  `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildName     string                 `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	LeaderId      int64                  `protobuf:"varint,5,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	LeaderName    string                 `protobuf:"bytes,6,opt,name=leader_name,json=leaderName,proto3" json:"leader_name,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	MaxMembers    int32                  `protobuf:"varint,8,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	Notice        string                 `protobuf:"bytes,9,opt,name=notice,proto3" json:"notice,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildInfo) Reset() {
+	*x = GuildInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildInfo) ProtoMessage() {}
+
+func (x *GuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildInfo.ProtoReflect.Descriptor instead.
+func (*GuildInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GuildInfo) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetLeaderId() int64 {
+	if x != nil {
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetLeaderName() string {
+	if x != nil {
+		return x.LeaderName
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *GuildInfo) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *GuildInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// 公会成员信息
+type GuildMemberInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Contribution  int64                  `protobuf:"varint,4,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	JoinTime      int64                  `protobuf:"varint,5,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	Online        bool                   `protobuf:"varint,6,opt,name=online,proto3" json:"online,omitempty"`
+	LastOnline    int64                  `protobuf:"varint,7,opt,name=last_online,json=lastOnline,proto3" json:"last_online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildMemberInfo) Reset() {
+	*x = GuildMemberInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildMemberInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildMemberInfo) ProtoMessage() {}
+
+func (x *GuildMemberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildMemberInfo.ProtoReflect.Descriptor instead.
+func (*GuildMemberInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GuildMemberInfo) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GuildMemberInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuildMemberInfo) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GuildMemberInfo) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *GuildMemberInfo) GetJoinTime() int64 {
+	if x != nil {
+		return x.JoinTime
+	}
+	return 0
+}
+
+func (x *GuildMemberInfo) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *GuildMemberInfo) GetLastOnline() int64 {
+	if x != nil {
+		return x.LastOnline
+	}
+	return 0
+}
+
+// 公会申请信息
+type GuildApplyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplyId       int64                  `protobuf:"varint,1,opt,name=apply_id,json=applyId,proto3" json:"apply_id,omitempty"`
+	PlayerId      int64                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	GuildId       int64                  `protobuf:"varint,4,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildName     string                 `protobuf:"bytes,5,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	ApplyTime     int64                  `protobuf:"varint,6,opt,name=apply_time,json=applyTime,proto3" json:"apply_time,omitempty"`
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Remark        string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildApplyInfo) Reset() {
+	*x = GuildApplyInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildApplyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildApplyInfo) ProtoMessage() {}
+
+func (x *GuildApplyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildApplyInfo.ProtoReflect.Descriptor instead.
+func (*GuildApplyInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GuildApplyInfo) GetApplyId() int64 {
+	if x != nil {
+		return x.ApplyId
+	}
+	return 0
+}
+
+func (x *GuildApplyInfo) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GuildApplyInfo) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *GuildApplyInfo) GetGuildId() int64 {
+	if x != nil {
+		return x.GuildId
+	}
+	return 0
+}
+
+func (x *GuildApplyInfo) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *GuildApplyInfo) GetApplyTime() int64 {
+	if x != nil {
+		return x.ApplyTime
+	}
+	return 0
+}
+
+func (x *GuildApplyInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GuildApplyInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// 拍卖物品信息
+type AuctionItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuctionId     int64                  `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	SellerId      int64                  `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SellerName    string                 `protobuf:"bytes,3,opt,name=seller_name,json=sellerName,proto3" json:"seller_name,omitempty"`
+	ItemId        int64                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemName      string                 `protobuf:"bytes,5,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	ItemType      int32                  `protobuf:"varint,6,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,7,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	AuctionType   int32                  `protobuf:"varint,8,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	StartingPrice int64                  `protobuf:"varint,9,opt,name=starting_price,json=startingPrice,proto3" json:"starting_price,omitempty"`
+	CurrentPrice  int64                  `protobuf:"varint,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	BuyoutPrice   int64                  `protobuf:"varint,11,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price,omitempty"`
+	BidIncrement  int64                  `protobuf:"varint,12,opt,name=bid_increment,json=bidIncrement,proto3" json:"bid_increment,omitempty"`
+	StartTime     int64                  `protobuf:"varint,13,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,14,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Status        int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentWinner int64                  `protobuf:"varint,16,opt,name=current_winner,json=currentWinner,proto3" json:"current_winner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuctionItemInfo) Reset() {
+	*x = AuctionItemInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionItemInfo) ProtoMessage() {}
+
+func (x *AuctionItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionItemInfo.ProtoReflect.Descriptor instead.
+func (*AuctionItemInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AuctionItemInfo) GetAuctionId() int64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetSellerName() string {
+	if x != nil {
+		return x.SellerName
+	}
+	return ""
+}
+
+func (x *AuctionItemInfo) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *AuctionItemInfo) GetItemType() int32 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetAuctionType() int32 {
+	if x != nil {
+		return x.AuctionType
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetStartingPrice() int64 {
+	if x != nil {
+		return x.StartingPrice
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetCurrentPrice() int64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetBuyoutPrice() int64 {
+	if x != nil {
+		return x.BuyoutPrice
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetBidIncrement() int64 {
+	if x != nil {
+		return x.BidIncrement
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuctionItemInfo) GetCurrentWinner() int64 {
+	if x != nil {
+		return x.CurrentWinner
+	}
+	return 0
+}
+
+// 拍卖竞拍信息
+type AuctionBidInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BidId         int64                  `protobuf:"varint,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+	PlayerId      int64                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	AuctionId     int64                  `protobuf:"varint,4,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	BidPrice      int64                  `protobuf:"varint,5,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	BidTime       int64                  `protobuf:"varint,6,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuctionBidInfo) Reset() {
+	*x = AuctionBidInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionBidInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionBidInfo) ProtoMessage() {}
+
+func (x *AuctionBidInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionBidInfo.ProtoReflect.Descriptor instead.
+func (*AuctionBidInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AuctionBidInfo) GetBidId() int64 {
+	if x != nil {
+		return x.BidId
+	}
+	return 0
+}
+
+func (x *AuctionBidInfo) GetPlayerId() int64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *AuctionBidInfo) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *AuctionBidInfo) GetAuctionId() int64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *AuctionBidInfo) GetBidPrice() int64 {
+	if x != nil {
+		return x.BidPrice
+	}
+	return 0
+}
+
+func (x *AuctionBidInfo) GetBidTime() int64 {
+	if x != nil {
+		return x.BidTime
+	}
+	return 0
+}
+
+// 地图对象信息
+type MapObjectInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectId      int64                  `protobuf:"varint,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	ObjectType    int32                  `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	MapId         int64                  `protobuf:"varint,3,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	X             float32                `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
+	Orientation   float32                `protobuf:"fixed32,7,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	MoveSpeed     float32                `protobuf:"fixed32,8,opt,name=move_speed,json=moveSpeed,proto3" json:"move_speed,omitempty"`
+	IsMoving      bool                   `protobuf:"varint,9,opt,name=is_moving,json=isMoving,proto3" json:"is_moving,omitempty"`
+	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapObjectInfo) Reset() {
+	*x = MapObjectInfo{}
+	mi := &file_resources_protocol_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapObjectInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapObjectInfo) ProtoMessage() {}
+
+func (x *MapObjectInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapObjectInfo.ProtoReflect.Descriptor instead.
+func (*MapObjectInfo) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MapObjectInfo) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetObjectType() int32 {
+	if x != nil {
+		return x.ObjectType
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetMapId() int64 {
+	if x != nil {
+		return x.MapId
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetOrientation() float32 {
+	if x != nil {
+		return x.Orientation
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetMoveSpeed() float32 {
+	if x != nil {
+		return x.MoveSpeed
+	}
+	return 0
+}
+
+func (x *MapObjectInfo) GetIsMoving() bool {
+	if x != nil {
+		return x.IsMoving
+	}
+	return false
+}
+
+func (x *MapObjectInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 地图移动请求
+type MapMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	X             float32                `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,4,opt,name=z,proto3" json:"z,omitempty"`
+	Orientation   float32                `protobuf:"fixed32,5,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapMoveRequest) Reset() {
+	*x = MapMoveRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapMoveRequest) ProtoMessage() {}
+
+func (x *MapMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapMoveRequest.ProtoReflect.Descriptor instead.
+func (*MapMoveRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MapMoveRequest) GetMapId() int64 {
+	if x != nil {
+		return x.MapId
+	}
+	return 0
+}
+
+func (x *MapMoveRequest) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MapMoveRequest) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *MapMoveRequest) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *MapMoveRequest) GetOrientation() float32 {
+	if x != nil {
+		return x.Orientation
+	}
+	return 0
+}
+
+// 地图移动响应
+type MapMoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	ObjectId      int64                  `protobuf:"varint,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	X             float32                `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
+	Orientation   float32                `protobuf:"fixed32,7,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	Time          int64                  `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapMoveResponse) Reset() {
+	*x = MapMoveResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapMoveResponse) ProtoMessage() {}
+
+func (x *MapMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapMoveResponse.ProtoReflect.Descriptor instead.
+func (*MapMoveResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MapMoveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MapMoveResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *MapMoveResponse) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *MapMoveResponse) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MapMoveResponse) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *MapMoveResponse) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *MapMoveResponse) GetOrientation() float32 {
+	if x != nil {
+		return x.Orientation
+	}
+	return 0
+}
+
+func (x *MapMoveResponse) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 路径请求
+type MapGetPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	StartX        float32                `protobuf:"fixed32,2,opt,name=start_x,json=startX,proto3" json:"start_x,omitempty"`
+	StartY        float32                `protobuf:"fixed32,3,opt,name=start_y,json=startY,proto3" json:"start_y,omitempty"`
+	EndX          float32                `protobuf:"fixed32,4,opt,name=end_x,json=endX,proto3" json:"end_x,omitempty"`
+	EndY          float32                `protobuf:"fixed32,5,opt,name=end_y,json=endY,proto3" json:"end_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapGetPathRequest) Reset() {
+	*x = MapGetPathRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapGetPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapGetPathRequest) ProtoMessage() {}
+
+func (x *MapGetPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapGetPathRequest.ProtoReflect.Descriptor instead.
+func (*MapGetPathRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MapGetPathRequest) GetMapId() int64 {
+	if x != nil {
+		return x.MapId
+	}
+	return 0
+}
+
+func (x *MapGetPathRequest) GetStartX() float32 {
+	if x != nil {
+		return x.StartX
+	}
+	return 0
+}
+
+func (x *MapGetPathRequest) GetStartY() float32 {
+	if x != nil {
+		return x.StartY
+	}
+	return 0
+}
+
+func (x *MapGetPathRequest) GetEndX() float32 {
+	if x != nil {
+		return x.EndX
+	}
+	return 0
+}
+
+func (x *MapGetPathRequest) GetEndY() float32 {
+	if x != nil {
+		return x.EndY
+	}
+	return 0
+}
+
+// 路径响应
+type MapGetPathResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                      `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Path          []*MapGetPathResponse_Point `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapGetPathResponse) Reset() {
+	*x = MapGetPathResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapGetPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapGetPathResponse) ProtoMessage() {}
+
+func (x *MapGetPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapGetPathResponse.ProtoReflect.Descriptor instead.
+func (*MapGetPathResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MapGetPathResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MapGetPathResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *MapGetPathResponse) GetPath() []*MapGetPathResponse_Point {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// 地图对象同步
+type MapSyncObjects struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	Objects       []*MapObjectInfo       `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	SyncTime      int64                  `protobuf:"varint,3,opt,name=sync_time,json=syncTime,proto3" json:"sync_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapSyncObjects) Reset() {
+	*x = MapSyncObjects{}
+	mi := &file_resources_protocol_game_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapSyncObjects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapSyncObjects) ProtoMessage() {}
+
+func (x *MapSyncObjects) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapSyncObjects.ProtoReflect.Descriptor instead.
+func (*MapSyncObjects) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MapSyncObjects) GetMapId() int64 {
+	if x != nil {
+		return x.MapId
+	}
+	return 0
+}
+
+func (x *MapSyncObjects) GetObjects() []*MapObjectInfo {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *MapSyncObjects) GetSyncTime() int64 {
+	if x != nil {
+		return x.SyncTime
+	}
+	return 0
+}
+
+// 背包获取请求
+type InventoryGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryGetRequest) Reset() {
+	*x = InventoryGetRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryGetRequest) ProtoMessage() {}
+
+func (x *InventoryGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryGetRequest.ProtoReflect.Descriptor instead.
+func (*InventoryGetRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{48}
+}
+
+// 背包获取响应
+type InventoryGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Items         []*ItemInfo            `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryGetResponse) Reset() {
+	*x = InventoryGetResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryGetResponse) ProtoMessage() {}
+
+func (x *InventoryGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryGetResponse.ProtoReflect.Descriptor instead.
+func (*InventoryGetResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *InventoryGetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InventoryGetResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *InventoryGetResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InventoryGetResponse) GetItems() []*ItemInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 背包物品移除请求
+type InventoryRemoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryRemoveRequest) Reset() {
+	*x = InventoryRemoveRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryRemoveRequest) ProtoMessage() {}
+
+func (x *InventoryRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryRemoveRequest.ProtoReflect.Descriptor instead.
+func (*InventoryRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InventoryRemoveRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *InventoryRemoveRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 背包物品移除响应
+type InventoryRemoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryRemoveResponse) Reset() {
+	*x = InventoryRemoveResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryRemoveResponse) ProtoMessage() {}
+
+func (x *InventoryRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryRemoveResponse.ProtoReflect.Descriptor instead.
+func (*InventoryRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InventoryRemoveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InventoryRemoveResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+// 背包物品使用请求
+type InventoryUseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryUseRequest) Reset() {
+	*x = InventoryUseRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryUseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryUseRequest) ProtoMessage() {}
+
+func (x *InventoryUseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryUseRequest.ProtoReflect.Descriptor instead.
+func (*InventoryUseRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InventoryUseRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// 背包物品使用响应
+type InventoryUseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryUseResponse) Reset() {
+	*x = InventoryUseResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryUseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryUseResponse) ProtoMessage() {}
+
+func (x *InventoryUseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryUseResponse.ProtoReflect.Descriptor instead.
+func (*InventoryUseResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InventoryUseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InventoryUseResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+// 背包整理请求
+type InventorySortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventorySortRequest) Reset() {
+	*x = InventorySortRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySortRequest) ProtoMessage() {}
+
+func (x *InventorySortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySortRequest.ProtoReflect.Descriptor instead.
+func (*InventorySortRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{54}
+}
+
+// 背包整理响应
+type InventorySortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Items         []*ItemInfo            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventorySortResponse) Reset() {
+	*x = InventorySortResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySortResponse) ProtoMessage() {}
+
+func (x *InventorySortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySortResponse.ProtoReflect.Descriptor instead.
+func (*InventorySortResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InventorySortResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InventorySortResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *InventorySortResponse) GetItems() []*ItemInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 装备获取请求
+type EquipmentGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentGetRequest) Reset() {
+	*x = EquipmentGetRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentGetRequest) ProtoMessage() {}
+
+func (x *EquipmentGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentGetRequest.ProtoReflect.Descriptor instead.
+func (*EquipmentGetRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{56}
+}
+
+// 装备获取响应
+type EquipmentGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Equipments    []*ItemInfo            `protobuf:"bytes,3,rep,name=equipments,proto3" json:"equipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentGetResponse) Reset() {
+	*x = EquipmentGetResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentGetResponse) ProtoMessage() {}
+
+func (x *EquipmentGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentGetResponse.ProtoReflect.Descriptor instead.
+func (*EquipmentGetResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EquipmentGetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EquipmentGetResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *EquipmentGetResponse) GetEquipments() []*ItemInfo {
+	if x != nil {
+		return x.Equipments
+	}
+	return nil
+}
+
+// 穿戴装备请求
+type EquipmentEquipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`                 // 背包槽位
+	EquipPos      int32                  `protobuf:"varint,2,opt,name=equip_pos,json=equipPos,proto3" json:"equip_pos,omitempty"` // 装备位置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentEquipRequest) Reset() {
+	*x = EquipmentEquipRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentEquipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentEquipRequest) ProtoMessage() {}
+
+func (x *EquipmentEquipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentEquipRequest.ProtoReflect.Descriptor instead.
+func (*EquipmentEquipRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EquipmentEquipRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *EquipmentEquipRequest) GetEquipPos() int32 {
+	if x != nil {
+		return x.EquipPos
+	}
+	return 0
+}
+
+// 穿戴装备响应
+type EquipmentEquipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Equipment     *ItemInfo              `protobuf:"bytes,3,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentEquipResponse) Reset() {
+	*x = EquipmentEquipResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentEquipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentEquipResponse) ProtoMessage() {}
+
+func (x *EquipmentEquipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentEquipResponse.ProtoReflect.Descriptor instead.
+func (*EquipmentEquipResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EquipmentEquipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EquipmentEquipResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *EquipmentEquipResponse) GetEquipment() *ItemInfo {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+// 卸下装备请求
+type EquipmentUnequipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EquipPos      int32                  `protobuf:"varint,1,opt,name=equip_pos,json=equipPos,proto3" json:"equip_pos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentUnequipRequest) Reset() {
+	*x = EquipmentUnequipRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentUnequipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentUnequipRequest) ProtoMessage() {}
+
+func (x *EquipmentUnequipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentUnequipRequest.ProtoReflect.Descriptor instead.
+func (*EquipmentUnequipRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EquipmentUnequipRequest) GetEquipPos() int32 {
+	if x != nil {
+		return x.EquipPos
+	}
+	return 0
+}
+
+// 卸下装备响应
+type EquipmentUnequipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // 放入的背包槽位
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentUnequipResponse) Reset() {
+	*x = EquipmentUnequipResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentUnequipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentUnequipResponse) ProtoMessage() {}
+
+func (x *EquipmentUnequipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentUnequipResponse.ProtoReflect.Descriptor instead.
+func (*EquipmentUnequipResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *EquipmentUnequipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EquipmentUnequipResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *EquipmentUnequipResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// 邮件列表请求
+type MailGetListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailGetListRequest) Reset() {
+	*x = MailGetListRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailGetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailGetListRequest) ProtoMessage() {}
+
+func (x *MailGetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailGetListRequest.ProtoReflect.Descriptor instead.
+func (*MailGetListRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{62}
+}
+
+// 邮件列表响应
+type MailGetListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Mails         []*MailInfo            `protobuf:"bytes,3,rep,name=mails,proto3" json:"mails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailGetListResponse) Reset() {
+	*x = MailGetListResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailGetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailGetListResponse) ProtoMessage() {}
+
+func (x *MailGetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[63]
 	if x != nil {
-		return x.ItemLevel
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ItemInfo) GetItemQuality() int32 {
+// Deprecated: Use MailGetListResponse.ProtoReflect.Descriptor instead.
+func (*MailGetListResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MailGetListResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ItemQuality
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ItemInfo) GetBindType() int32 {
+func (x *MailGetListResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.BindType
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *ItemInfo) GetPosition() int32 {
+func (x *MailGetListResponse) GetMails() []*MailInfo {
 	if x != nil {
-		return x.Position
+		return x.Mails
 	}
-	return 0
+	return nil
 }
 
-// 任务信息
-type TaskInfo struct {
+// 邮件详情请求
+type MailGetDetailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      int32                  `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
-	TaskName      string                 `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
-	TaskDesc      string                 `protobuf:"bytes,4,opt,name=task_desc,json=taskDesc,proto3" json:"task_desc,omitempty"`
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
-	Progress      []int32                `protobuf:"varint,6,rep,packed,name=progress,proto3" json:"progress,omitempty"`
-	Rewards       []*ItemInfo            `protobuf:"bytes,7,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	AcceptTime    int64                  `protobuf:"varint,8,opt,name=accept_time,json=acceptTime,proto3" json:"accept_time,omitempty"`
-	CompleteTime  int64                  `protobuf:"varint,9,opt,name=complete_time,json=completeTime,proto3" json:"complete_time,omitempty"`
+	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskInfo) Reset() {
-	*x = TaskInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[17]
+func (x *MailGetDetailRequest) Reset() {
+	*x = MailGetDetailRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskInfo) String() string {
+func (x *MailGetDetailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskInfo) ProtoMessage() {}
+func (*MailGetDetailRequest) ProtoMessage() {}
 
-func (x *TaskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[17]
+func (x *MailGetDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,102 +5248,104 @@ func (x *TaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
-func (*TaskInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MailGetDetailRequest.ProtoReflect.Descriptor instead.
+func (*MailGetDetailRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *TaskInfo) GetTaskId() int64 {
+func (x *MailGetDetailRequest) GetMailId() int64 {
 	if x != nil {
-		return x.TaskId
+		return x.MailId
 	}
 	return 0
 }
 
-func (x *TaskInfo) GetTaskType() int32 {
-	if x != nil {
-		return x.TaskType
-	}
-	return 0
+// 邮件详情响应
+type MailGetDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Mail          *MailInfo              `protobuf:"bytes,3,opt,name=mail,proto3" json:"mail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskInfo) GetTaskName() string {
-	if x != nil {
-		return x.TaskName
-	}
-	return ""
+func (x *MailGetDetailResponse) Reset() {
+	*x = MailGetDetailResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskInfo) GetTaskDesc() string {
-	if x != nil {
-		return x.TaskDesc
-	}
-	return ""
+func (x *MailGetDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TaskInfo) GetStatus() int32 {
+func (*MailGetDetailResponse) ProtoMessage() {}
+
+func (x *MailGetDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[65]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TaskInfo) GetProgress() []int32 {
-	if x != nil {
-		return x.Progress
-	}
-	return nil
+// Deprecated: Use MailGetDetailResponse.ProtoReflect.Descriptor instead.
+func (*MailGetDetailResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *TaskInfo) GetRewards() []*ItemInfo {
+func (x *MailGetDetailResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Rewards
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *TaskInfo) GetAcceptTime() int64 {
+func (x *MailGetDetailResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.AcceptTime
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskInfo) GetCompleteTime() int64 {
+func (x *MailGetDetailResponse) GetMail() *MailInfo {
 	if x != nil {
-		return x.CompleteTime
+		return x.Mail
 	}
-	return 0
+	return nil
 }
 
-// 技能信息
-type SkillInfo struct {
+// 发送邮件请求
+type MailSendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
-	SkillName     string                 `protobuf:"bytes,2,opt,name=skill_name,json=skillName,proto3" json:"skill_name,omitempty"`
-	SkillLevel    int32                  `protobuf:"varint,3,opt,name=skill_level,json=skillLevel,proto3" json:"skill_level,omitempty"`
-	SkillType     int32                  `protobuf:"varint,4,opt,name=skill_type,json=skillType,proto3" json:"skill_type,omitempty"`
-	SkillCoolTime int64                  `protobuf:"varint,5,opt,name=skill_cool_time,json=skillCoolTime,proto3" json:"skill_cool_time,omitempty"`
-	SkillDamage   int64                  `protobuf:"varint,6,opt,name=skill_damage,json=skillDamage,proto3" json:"skill_damage,omitempty"`
+	ReceiverId    int64                  `protobuf:"varint,1,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SkillInfo) Reset() {
-	*x = SkillInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[18]
+func (x *MailSendRequest) Reset() {
+	*x = MailSendRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SkillInfo) String() string {
+func (x *MailSendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillInfo) ProtoMessage() {}
+func (*MailSendRequest) ProtoMessage() {}
 
-func (x *SkillInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[18]
+func (x *MailSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,87 +5356,57 @@ func (x *SkillInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillInfo.ProtoReflect.Descriptor instead.
-func (*SkillInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MailSendRequest.ProtoReflect.Descriptor instead.
+func (*MailSendRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SkillInfo) GetSkillId() int64 {
+func (x *MailSendRequest) GetReceiverId() int64 {
 	if x != nil {
-		return x.SkillId
+		return x.ReceiverId
 	}
 	return 0
 }
 
-func (x *SkillInfo) GetSkillName() string {
+func (x *MailSendRequest) GetTitle() string {
 	if x != nil {
-		return x.SkillName
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SkillInfo) GetSkillLevel() int32 {
-	if x != nil {
-		return x.SkillLevel
-	}
-	return 0
-}
-
-func (x *SkillInfo) GetSkillType() int32 {
-	if x != nil {
-		return x.SkillType
-	}
-	return 0
-}
-
-func (x *SkillInfo) GetSkillCoolTime() int64 {
-	if x != nil {
-		return x.SkillCoolTime
-	}
-	return 0
-}
-
-func (x *SkillInfo) GetSkillDamage() int64 {
+func (x *MailSendRequest) GetContent() string {
 	if x != nil {
-		return x.SkillDamage
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-// 邮件信息
-type MailInfo struct {
+// 发送邮件响应
+type MailSendResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
-	SenderId      int64                  `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	SenderName    string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	ReceiverId    int64                  `protobuf:"varint,4,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`
-	ReceiverName  string                 `protobuf:"bytes,5,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
-	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
-	Items         []*ItemInfo            `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
-	Gold          int64                  `protobuf:"varint,9,opt,name=gold,proto3" json:"gold,omitempty"`
-	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
-	SendTime      int64                  `protobuf:"varint,11,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
-	ReadTime      int64                  `protobuf:"varint,12,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	MailId        int64                  `protobuf:"varint,3,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MailInfo) Reset() {
-	*x = MailInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[19]
+func (x *MailSendResponse) Reset() {
+	*x = MailSendResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MailInfo) String() string {
+func (x *MailSendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MailInfo) ProtoMessage() {}
+func (*MailSendResponse) ProtoMessage() {}
 
-func (x *MailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[19]
+func (x *MailSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,127 +5417,153 @@ func (x *MailInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MailInfo.ProtoReflect.Descriptor instead.
-func (*MailInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *MailInfo) GetMailId() int64 {
-	if x != nil {
-		return x.MailId
-	}
-	return 0
+// Deprecated: Use MailSendResponse.ProtoReflect.Descriptor instead.
+func (*MailSendResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *MailInfo) GetSenderId() int64 {
+func (x *MailSendResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SenderId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MailInfo) GetSenderName() string {
+func (x *MailSendResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.SenderName
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *MailInfo) GetReceiverId() int64 {
+func (x *MailSendResponse) GetMailId() int64 {
 	if x != nil {
-		return x.ReceiverId
+		return x.MailId
 	}
 	return 0
 }
 
-func (x *MailInfo) GetReceiverName() string {
-	if x != nil {
-		return x.ReceiverName
-	}
-	return ""
+// 删除邮件请求
+type MailDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MailInfo) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *MailDeleteRequest) Reset() {
+	*x = MailDeleteRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MailInfo) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *MailDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MailInfo) GetItems() []*ItemInfo {
+func (*MailDeleteRequest) ProtoMessage() {}
+
+func (x *MailDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[68]
 	if x != nil {
-		return x.Items
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MailInfo) GetGold() int64 {
+// Deprecated: Use MailDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MailDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MailDeleteRequest) GetMailId() int64 {
 	if x != nil {
-		return x.Gold
+		return x.MailId
 	}
 	return 0
 }
 
-func (x *MailInfo) GetStatus() int32 {
+// 删除邮件响应
+type MailDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MailDeleteResponse) Reset() {
+	*x = MailDeleteResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailDeleteResponse) ProtoMessage() {}
+
+func (x *MailDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[69]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MailInfo) GetSendTime() int64 {
+// Deprecated: Use MailDeleteResponse.ProtoReflect.Descriptor instead.
+func (*MailDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MailDeleteResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SendTime
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MailInfo) GetReadTime() int64 {
+func (x *MailDeleteResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.ReadTime
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-// 公会信息
-type GuildInfo struct {
+// 领取邮件附件请求
+type MailReceiveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GuildId       int64                  `protobuf:"varint,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	GuildName     string                 `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
-	LeaderId      int64                  `protobuf:"varint,5,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
-	LeaderName    string                 `protobuf:"bytes,6,opt,name=leader_name,json=leaderName,proto3" json:"leader_name,omitempty"`
-	MemberCount   int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	MaxMembers    int32                  `protobuf:"varint,8,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
-	Notice        string                 `protobuf:"bytes,9,opt,name=notice,proto3" json:"notice,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	MailId        int64                  `protobuf:"varint,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildInfo) Reset() {
-	*x = GuildInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[20]
+func (x *MailReceiveRequest) Reset() {
+	*x = MailReceiveRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildInfo) String() string {
+func (x *MailReceiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuildInfo) ProtoMessage() {}
+func (*MailReceiveRequest) ProtoMessage() {}
 
-func (x *GuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[20]
+func (x *MailReceiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,110 +5574,101 @@ func (x *GuildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuildInfo.ProtoReflect.Descriptor instead.
-func (*GuildInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MailReceiveRequest.ProtoReflect.Descriptor instead.
+func (*MailReceiveRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GuildInfo) GetGuildId() int64 {
+func (x *MailReceiveRequest) GetMailId() int64 {
 	if x != nil {
-		return x.GuildId
+		return x.MailId
 	}
 	return 0
 }
 
-func (x *GuildInfo) GetGuildName() string {
-	if x != nil {
-		return x.GuildName
-	}
-	return ""
+// 领取邮件附件响应
+type MailReceiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Items         []*ItemInfo            `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildInfo) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
+func (x *MailReceiveResponse) Reset() {
+	*x = MailReceiveResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildInfo) GetExp() int64 {
-	if x != nil {
-		return x.Exp
-	}
-	return 0
+func (x *MailReceiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GuildInfo) GetLeaderId() int64 {
-	if x != nil {
-		return x.LeaderId
-	}
-	return 0
-}
+func (*MailReceiveResponse) ProtoMessage() {}
 
-func (x *GuildInfo) GetLeaderName() string {
+func (x *MailReceiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[71]
 	if x != nil {
-		return x.LeaderName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GuildInfo) GetMemberCount() int32 {
-	if x != nil {
-		return x.MemberCount
-	}
-	return 0
+// Deprecated: Use MailReceiveResponse.ProtoReflect.Descriptor instead.
+func (*MailReceiveResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GuildInfo) GetMaxMembers() int32 {
+func (x *MailReceiveResponse) GetSuccess() bool {
 	if x != nil {
-		return x.MaxMembers
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GuildInfo) GetNotice() string {
+func (x *MailReceiveResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.Notice
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *GuildInfo) GetCreateTime() int64 {
+func (x *MailReceiveResponse) GetItems() []*ItemInfo {
 	if x != nil {
-		return x.CreateTime
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-// 公会成员信息
-type GuildMemberInfo struct {
+// 任务列表请求
+type TaskGetListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int64                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
-	Contribution  int64                  `protobuf:"varint,4,opt,name=contribution,proto3" json:"contribution,omitempty"`
-	JoinTime      int64                  `protobuf:"varint,5,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
-	Online        bool                   `protobuf:"varint,6,opt,name=online,proto3" json:"online,omitempty"`
-	LastOnline    int64                  `protobuf:"varint,7,opt,name=last_online,json=lastOnline,proto3" json:"last_online,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildMemberInfo) Reset() {
-	*x = GuildMemberInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[21]
+func (x *TaskGetListRequest) Reset() {
+	*x = TaskGetListRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildMemberInfo) String() string {
+func (x *TaskGetListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuildMemberInfo) ProtoMessage() {}
+func (*TaskGetListRequest) ProtoMessage() {}
 
-func (x *GuildMemberInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[21]
+func (x *TaskGetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,90 +5679,95 @@ func (x *GuildMemberInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuildMemberInfo.ProtoReflect.Descriptor instead.
-func (*GuildMemberInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use TaskGetListRequest.ProtoReflect.Descriptor instead.
+func (*TaskGetListRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GuildMemberInfo) GetPlayerId() int64 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
+// 任务列表响应
+type TaskGetListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Tasks         []*TaskInfo            `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildMemberInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *TaskGetListResponse) Reset() {
+	*x = TaskGetListResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildMemberInfo) GetPosition() int32 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
+func (x *TaskGetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GuildMemberInfo) GetContribution() int64 {
+func (*TaskGetListResponse) ProtoMessage() {}
+
+func (x *TaskGetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[73]
 	if x != nil {
-		return x.Contribution
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GuildMemberInfo) GetJoinTime() int64 {
+// Deprecated: Use TaskGetListResponse.ProtoReflect.Descriptor instead.
+func (*TaskGetListResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TaskGetListResponse) GetSuccess() bool {
 	if x != nil {
-		return x.JoinTime
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GuildMemberInfo) GetOnline() bool {
+func (x *TaskGetListResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.Online
+		return x.ErrorMsg
 	}
-	return false
+	return ""
 }
 
-func (x *GuildMemberInfo) GetLastOnline() int64 {
+func (x *TaskGetListResponse) GetTasks() []*TaskInfo {
 	if x != nil {
-		return x.LastOnline
+		return x.Tasks
 	}
-	return 0
+	return nil
 }
 
-// 公会申请信息
-type GuildApplyInfo struct {
+// 任务详情请求
+type TaskGetDetailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplyId       int64                  `protobuf:"varint,1,opt,name=apply_id,json=applyId,proto3" json:"apply_id,omitempty"`
-	PlayerId      int64                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	GuildId       int64                  `protobuf:"varint,4,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	GuildName     string                 `protobuf:"bytes,5,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
-	ApplyTime     int64                  `protobuf:"varint,6,opt,name=apply_time,json=applyTime,proto3" json:"apply_time,omitempty"`
-	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
-	Remark        string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildApplyInfo) Reset() {
-	*x = GuildApplyInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[22]
+func (x *TaskGetDetailRequest) Reset() {
+	*x = TaskGetDetailRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildApplyInfo) String() string {
+func (x *TaskGetDetailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GuildApplyInfo) ProtoMessage() {}
+func (*TaskGetDetailRequest) ProtoMessage() {}
 
-func (x *GuildApplyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[22]
+func (x *TaskGetDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,105 +5778,102 @@ func (x *GuildApplyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GuildApplyInfo.ProtoReflect.Descriptor instead.
-func (*GuildApplyInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TaskGetDetailRequest.ProtoReflect.Descriptor instead.
+func (*TaskGetDetailRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GuildApplyInfo) GetApplyId() int64 {
+func (x *TaskGetDetailRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.ApplyId
+		return x.TaskId
 	}
 	return 0
 }
 
-func (x *GuildApplyInfo) GetPlayerId() int64 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
+// 任务详情响应
+type TaskGetDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Task          *TaskInfo              `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GuildApplyInfo) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
-	}
-	return ""
+func (x *TaskGetDetailResponse) Reset() {
+	*x = TaskGetDetailResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GuildApplyInfo) GetGuildId() int64 {
-	if x != nil {
-		return x.GuildId
-	}
-	return 0
+func (x *TaskGetDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GuildApplyInfo) GetGuildName() string {
+func (*TaskGetDetailResponse) ProtoMessage() {}
+
+func (x *TaskGetDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[75]
 	if x != nil {
-		return x.GuildName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GuildApplyInfo) GetApplyTime() int64 {
-	if x != nil {
-		return x.ApplyTime
-	}
-	return 0
+// Deprecated: Use TaskGetDetailResponse.ProtoReflect.Descriptor instead.
+func (*TaskGetDetailResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GuildApplyInfo) GetStatus() int32 {
+func (x *TaskGetDetailResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GuildApplyInfo) GetRemark() string {
+func (x *TaskGetDetailResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.Remark
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-// 拍卖物品信息
-type AuctionItemInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuctionId     int64                  `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	SellerId      int64                  `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	SellerName    string                 `protobuf:"bytes,3,opt,name=seller_name,json=sellerName,proto3" json:"seller_name,omitempty"`
-	ItemId        int64                  `protobuf:"varint,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	ItemName      string                 `protobuf:"bytes,5,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	ItemType      int32                  `protobuf:"varint,6,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
-	ItemCount     int32                  `protobuf:"varint,7,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
-	AuctionType   int32                  `protobuf:"varint,8,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
-	StartingPrice int64                  `protobuf:"varint,9,opt,name=starting_price,json=startingPrice,proto3" json:"starting_price,omitempty"`
-	CurrentPrice  int64                  `protobuf:"varint,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	BuyoutPrice   int64                  `protobuf:"varint,11,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price,omitempty"`
-	BidIncrement  int64                  `protobuf:"varint,12,opt,name=bid_increment,json=bidIncrement,proto3" json:"bid_increment,omitempty"`
-	StartTime     int64                  `protobuf:"varint,13,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       int64                  `protobuf:"varint,14,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Status        int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentWinner int64                  `protobuf:"varint,16,opt,name=current_winner,json=currentWinner,proto3" json:"current_winner,omitempty"`
+func (x *TaskGetDetailResponse) GetTask() *TaskInfo {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// 接受任务请求
+type TaskAcceptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuctionItemInfo) Reset() {
-	*x = AuctionItemInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[23]
+func (x *TaskAcceptRequest) Reset() {
+	*x = TaskAcceptRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuctionItemInfo) String() string {
+func (x *TaskAcceptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionItemInfo) ProtoMessage() {}
+func (*TaskAcceptRequest) ProtoMessage() {}
 
-func (x *AuctionItemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[23]
+func (x *TaskAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,151 +5884,151 @@ func (x *AuctionItemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionItemInfo.ProtoReflect.Descriptor instead.
-func (*AuctionItemInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use TaskAcceptRequest.ProtoReflect.Descriptor instead.
+func (*TaskAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AuctionItemInfo) GetAuctionId() int64 {
+func (x *TaskAcceptRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.AuctionId
+		return x.TaskId
 	}
 	return 0
 }
 
-func (x *AuctionItemInfo) GetSellerId() int64 {
-	if x != nil {
-		return x.SellerId
-	}
-	return 0
+// 接受任务响应
+type TaskAcceptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Task          *TaskInfo              `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuctionItemInfo) GetSellerName() string {
-	if x != nil {
-		return x.SellerName
-	}
-	return ""
+func (x *TaskAcceptResponse) Reset() {
+	*x = TaskAcceptResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuctionItemInfo) GetItemId() int64 {
-	if x != nil {
-		return x.ItemId
-	}
-	return 0
+func (x *TaskAcceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AuctionItemInfo) GetItemName() string {
-	if x != nil {
-		return x.ItemName
-	}
-	return ""
-}
+func (*TaskAcceptResponse) ProtoMessage() {}
 
-func (x *AuctionItemInfo) GetItemType() int32 {
+func (x *TaskAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[77]
 	if x != nil {
-		return x.ItemType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AuctionItemInfo) GetItemCount() int32 {
-	if x != nil {
-		return x.ItemCount
-	}
-	return 0
+// Deprecated: Use TaskAcceptResponse.ProtoReflect.Descriptor instead.
+func (*TaskAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *AuctionItemInfo) GetAuctionType() int32 {
+func (x *TaskAcceptResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AuctionType
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *AuctionItemInfo) GetStartingPrice() int64 {
+func (x *TaskAcceptResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.StartingPrice
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *AuctionItemInfo) GetCurrentPrice() int64 {
+func (x *TaskAcceptResponse) GetTask() *TaskInfo {
 	if x != nil {
-		return x.CurrentPrice
+		return x.Task
 	}
-	return 0
+	return nil
 }
 
-func (x *AuctionItemInfo) GetBuyoutPrice() int64 {
-	if x != nil {
-		return x.BuyoutPrice
-	}
-	return 0
+// 提交任务请求
+type TaskSubmitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuctionItemInfo) GetBidIncrement() int64 {
-	if x != nil {
-		return x.BidIncrement
-	}
-	return 0
+func (x *TaskSubmitRequest) Reset() {
+	*x = TaskSubmitRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuctionItemInfo) GetStartTime() int64 {
-	if x != nil {
-		return x.StartTime
-	}
-	return 0
+func (x *TaskSubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AuctionItemInfo) GetEndTime() int64 {
+func (*TaskSubmitRequest) ProtoMessage() {}
+
+func (x *TaskSubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[78]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AuctionItemInfo) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use TaskSubmitRequest.ProtoReflect.Descriptor instead.
+func (*TaskSubmitRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *AuctionItemInfo) GetCurrentWinner() int64 {
+func (x *TaskSubmitRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.CurrentWinner
+		return x.TaskId
 	}
 	return 0
 }
 
-// 拍卖竞拍信息
-type AuctionBidInfo struct {
+// 提交任务响应
+type TaskSubmitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BidId         int64                  `protobuf:"varint,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
-	PlayerId      int64                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerName    string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	AuctionId     int64                  `protobuf:"varint,4,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
-	BidPrice      int64                  `protobuf:"varint,5,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
-	BidTime       int64                  `protobuf:"varint,6,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Gold          int64                  `protobuf:"varint,3,opt,name=gold,proto3" json:"gold,omitempty"`
+	Exp           int64                  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	Items         []*ItemInfo            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuctionBidInfo) Reset() {
-	*x = AuctionBidInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[24]
+func (x *TaskSubmitResponse) Reset() {
+	*x = TaskSubmitResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuctionBidInfo) String() string {
+func (x *TaskSubmitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionBidInfo) ProtoMessage() {}
+func (*TaskSubmitResponse) ProtoMessage() {}
 
-func (x *AuctionBidInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[24]
+func (x *TaskSubmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,85 +6039,69 @@ func (x *AuctionBidInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionBidInfo.ProtoReflect.Descriptor instead.
-func (*AuctionBidInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *AuctionBidInfo) GetBidId() int64 {
-	if x != nil {
-		return x.BidId
-	}
-	return 0
+// Deprecated: Use TaskSubmitResponse.ProtoReflect.Descriptor instead.
+func (*TaskSubmitResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *AuctionBidInfo) GetPlayerId() int64 {
+func (x *TaskSubmitResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PlayerId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *AuctionBidInfo) GetPlayerName() string {
+func (x *TaskSubmitResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.PlayerName
+		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *AuctionBidInfo) GetAuctionId() int64 {
+func (x *TaskSubmitResponse) GetGold() int64 {
 	if x != nil {
-		return x.AuctionId
+		return x.Gold
 	}
 	return 0
 }
 
-func (x *AuctionBidInfo) GetBidPrice() int64 {
+func (x *TaskSubmitResponse) GetExp() int64 {
 	if x != nil {
-		return x.BidPrice
+		return x.Exp
 	}
 	return 0
 }
 
-func (x *AuctionBidInfo) GetBidTime() int64 {
+func (x *TaskSubmitResponse) GetItems() []*ItemInfo {
 	if x != nil {
-		return x.BidTime
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-// 地图对象信息
-type MapObjectInfo struct {
+// 放弃任务请求
+type TaskCancelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ObjectId      int64                  `protobuf:"varint,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	ObjectType    int32                  `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
-	MapId         int64                  `protobuf:"varint,3,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	X             float32                `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float32                `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
-	Z             float32                `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
-	Orientation   float32                `protobuf:"fixed32,7,opt,name=orientation,proto3" json:"orientation,omitempty"`
-	MoveSpeed     float32                `protobuf:"fixed32,8,opt,name=move_speed,json=moveSpeed,proto3" json:"move_speed,omitempty"`
-	IsMoving      bool                   `protobuf:"varint,9,opt,name=is_moving,json=isMoving,proto3" json:"is_moving,omitempty"`
-	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapObjectInfo) Reset() {
-	*x = MapObjectInfo{}
-	mi := &file_resources_protocol_game_proto_msgTypes[25]
+func (x *TaskCancelRequest) Reset() {
+	*x = TaskCancelRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapObjectInfo) String() string {
+func (x *TaskCancelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapObjectInfo) ProtoMessage() {}
+func (*TaskCancelRequest) ProtoMessage() {}
 
-func (x *MapObjectInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[25]
+func (x *TaskCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,108 +6112,133 @@ func (x *MapObjectInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapObjectInfo.ProtoReflect.Descriptor instead.
-func (*MapObjectInfo) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use TaskCancelRequest.ProtoReflect.Descriptor instead.
+func (*TaskCancelRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *MapObjectInfo) GetObjectId() int64 {
+func (x *TaskCancelRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.ObjectId
+		return x.TaskId
 	}
 	return 0
 }
 
-func (x *MapObjectInfo) GetObjectType() int32 {
-	if x != nil {
-		return x.ObjectType
-	}
-	return 0
+// 放弃任务响应
+type TaskCancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapObjectInfo) GetMapId() int64 {
-	if x != nil {
-		return x.MapId
-	}
-	return 0
+func (x *TaskCancelResponse) Reset() {
+	*x = TaskCancelResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapObjectInfo) GetX() float32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
+func (x *TaskCancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MapObjectInfo) GetY() float32 {
+func (*TaskCancelResponse) ProtoMessage() {}
+
+func (x *TaskCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[81]
 	if x != nil {
-		return x.Y
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MapObjectInfo) GetZ() float32 {
-	if x != nil {
-		return x.Z
-	}
-	return 0
+// Deprecated: Use TaskCancelResponse.ProtoReflect.Descriptor instead.
+func (*TaskCancelResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *MapObjectInfo) GetOrientation() float32 {
+func (x *TaskCancelResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Orientation
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MapObjectInfo) GetMoveSpeed() float32 {
+func (x *TaskCancelResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.MoveSpeed
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *MapObjectInfo) GetIsMoving() bool {
+// 技能列表请求
+type SkillGetListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillGetListRequest) Reset() {
+	*x = SkillGetListRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillGetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillGetListRequest) ProtoMessage() {}
+
+func (x *SkillGetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[82]
 	if x != nil {
-		return x.IsMoving
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *MapObjectInfo) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use SkillGetListRequest.ProtoReflect.Descriptor instead.
+func (*SkillGetListRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{82}
 }
 
-// 地图移动请求
-type MapMoveRequest struct {
+// 技能列表响应
+type SkillGetListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	X             float32                `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float32                `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
-	Z             float32                `protobuf:"fixed32,4,opt,name=z,proto3" json:"z,omitempty"`
-	Orientation   float32                `protobuf:"fixed32,5,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Skills        []*SkillInfo           `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapMoveRequest) Reset() {
-	*x = MapMoveRequest{}
-	mi := &file_resources_protocol_game_proto_msgTypes[26]
+func (x *SkillGetListResponse) Reset() {
+	*x = SkillGetListResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapMoveRequest) String() string {
+func (x *SkillGetListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapMoveRequest) ProtoMessage() {}
+func (*SkillGetListResponse) ProtoMessage() {}
 
-func (x *MapMoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[26]
+func (x *SkillGetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,76 +6249,102 @@ func (x *MapMoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapMoveRequest.ProtoReflect.Descriptor instead.
-func (*MapMoveRequest) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SkillGetListResponse.ProtoReflect.Descriptor instead.
+func (*SkillGetListResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *MapMoveRequest) GetMapId() int64 {
+func (x *SkillGetListResponse) GetSuccess() bool {
 	if x != nil {
-		return x.MapId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MapMoveRequest) GetX() float32 {
+func (x *SkillGetListResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.X
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *MapMoveRequest) GetY() float32 {
+func (x *SkillGetListResponse) GetSkills() []*SkillInfo {
 	if x != nil {
-		return x.Y
+		return x.Skills
 	}
-	return 0
+	return nil
 }
 
-func (x *MapMoveRequest) GetZ() float32 {
+// 学习技能请求
+type SkillLearnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillLearnRequest) Reset() {
+	*x = SkillLearnRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillLearnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillLearnRequest) ProtoMessage() {}
+
+func (x *SkillLearnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[84]
 	if x != nil {
-		return x.Z
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MapMoveRequest) GetOrientation() float32 {
+// Deprecated: Use SkillLearnRequest.ProtoReflect.Descriptor instead.
+func (*SkillLearnRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SkillLearnRequest) GetSkillId() int64 {
 	if x != nil {
-		return x.Orientation
+		return x.SkillId
 	}
 	return 0
 }
 
-// 地图移动响应
-type MapMoveResponse struct {
+// 学习技能响应
+type SkillLearnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	ObjectId      int64                  `protobuf:"varint,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
-	X             float32                `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float32                `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
-	Z             float32                `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
-	Orientation   float32                `protobuf:"fixed32,7,opt,name=orientation,proto3" json:"orientation,omitempty"`
-	Time          int64                  `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	Skill         *SkillInfo             `protobuf:"bytes,3,opt,name=skill,proto3" json:"skill,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapMoveResponse) Reset() {
-	*x = MapMoveResponse{}
-	mi := &file_resources_protocol_game_proto_msgTypes[27]
+func (x *SkillLearnResponse) Reset() {
+	*x = SkillLearnResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapMoveResponse) String() string {
+func (x *SkillLearnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapMoveResponse) ProtoMessage() {}
+func (*SkillLearnResponse) ProtoMessage() {}
 
-func (x *MapMoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[27]
+func (x *SkillLearnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2732,94 +6355,102 @@ func (x *MapMoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapMoveResponse.ProtoReflect.Descriptor instead.
-func (*MapMoveResponse) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SkillLearnResponse.ProtoReflect.Descriptor instead.
+func (*SkillLearnResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *MapMoveResponse) GetSuccess() bool {
+func (x *SkillLearnResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *MapMoveResponse) GetErrorMsg() string {
+func (x *SkillLearnResponse) GetErrorMsg() string {
 	if x != nil {
 		return x.ErrorMsg
 	}
 	return ""
 }
 
-func (x *MapMoveResponse) GetObjectId() int64 {
+func (x *SkillLearnResponse) GetSkill() *SkillInfo {
 	if x != nil {
-		return x.ObjectId
+		return x.Skill
 	}
-	return 0
+	return nil
 }
 
-func (x *MapMoveResponse) GetX() float32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
+// 升级技能请求
+type SkillUpgradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapMoveResponse) GetY() float32 {
-	if x != nil {
-		return x.Y
-	}
-	return 0
+func (x *SkillUpgradeRequest) Reset() {
+	*x = SkillUpgradeRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapMoveResponse) GetZ() float32 {
-	if x != nil {
-		return x.Z
-	}
-	return 0
+func (x *SkillUpgradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MapMoveResponse) GetOrientation() float32 {
+func (*SkillUpgradeRequest) ProtoMessage() {}
+
+func (x *SkillUpgradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[86]
 	if x != nil {
-		return x.Orientation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MapMoveResponse) GetTime() int64 {
+// Deprecated: Use SkillUpgradeRequest.ProtoReflect.Descriptor instead.
+func (*SkillUpgradeRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SkillUpgradeRequest) GetSkillId() int64 {
 	if x != nil {
-		return x.Time
+		return x.SkillId
 	}
 	return 0
 }
 
-// 路径请求
-type MapPathRequest struct {
+// 升级技能响应
+type SkillUpgradeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	StartX        float32                `protobuf:"fixed32,2,opt,name=start_x,json=startX,proto3" json:"start_x,omitempty"`
-	StartY        float32                `protobuf:"fixed32,3,opt,name=start_y,json=startY,proto3" json:"start_y,omitempty"`
-	EndX          float32                `protobuf:"fixed32,4,opt,name=end_x,json=endX,proto3" json:"end_x,omitempty"`
-	EndY          float32                `protobuf:"fixed32,5,opt,name=end_y,json=endY,proto3" json:"end_y,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Skill         *SkillInfo             `protobuf:"bytes,3,opt,name=skill,proto3" json:"skill,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapPathRequest) Reset() {
-	*x = MapPathRequest{}
-	mi := &file_resources_protocol_game_proto_msgTypes[28]
+func (x *SkillUpgradeResponse) Reset() {
+	*x = SkillUpgradeResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapPathRequest) String() string {
+func (x *SkillUpgradeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapPathRequest) ProtoMessage() {}
+func (*SkillUpgradeResponse) ProtoMessage() {}
 
-func (x *MapPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[28]
+func (x *SkillUpgradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2830,71 +6461,56 @@ func (x *MapPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapPathRequest.ProtoReflect.Descriptor instead.
-func (*MapPathRequest) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *MapPathRequest) GetMapId() int64 {
-	if x != nil {
-		return x.MapId
-	}
-	return 0
-}
-
-func (x *MapPathRequest) GetStartX() float32 {
-	if x != nil {
-		return x.StartX
-	}
-	return 0
+// Deprecated: Use SkillUpgradeResponse.ProtoReflect.Descriptor instead.
+func (*SkillUpgradeResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *MapPathRequest) GetStartY() float32 {
+func (x *SkillUpgradeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.StartY
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MapPathRequest) GetEndX() float32 {
+func (x *SkillUpgradeResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.EndX
+		return x.ErrorMsg
 	}
-	return 0
+	return ""
 }
 
-func (x *MapPathRequest) GetEndY() float32 {
+func (x *SkillUpgradeResponse) GetSkill() *SkillInfo {
 	if x != nil {
-		return x.EndY
+		return x.Skill
 	}
-	return 0
+	return nil
 }
 
-// 路径响应
-type MapPathResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMsg      string                   `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	Path          []*MapPathResponse_Point `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+// 使用技能请求
+type SkillUseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkillId       int64                  `protobuf:"varint,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapPathResponse) Reset() {
-	*x = MapPathResponse{}
-	mi := &file_resources_protocol_game_proto_msgTypes[29]
+func (x *SkillUseRequest) Reset() {
+	*x = SkillUseRequest{}
+	mi := &file_resources_protocol_game_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapPathResponse) String() string {
+func (x *SkillUseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapPathResponse) ProtoMessage() {}
+func (*SkillUseRequest) ProtoMessage() {}
 
-func (x *MapPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[29]
+func (x *SkillUseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2905,57 +6521,51 @@ func (x *MapPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapPathResponse.ProtoReflect.Descriptor instead.
-func (*MapPathResponse) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *MapPathResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use SkillUseRequest.ProtoReflect.Descriptor instead.
+func (*SkillUseRequest) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *MapPathResponse) GetErrorMsg() string {
+func (x *SkillUseRequest) GetSkillId() int64 {
 	if x != nil {
-		return x.ErrorMsg
+		return x.SkillId
 	}
-	return ""
+	return 0
 }
 
-func (x *MapPathResponse) GetPath() []*MapPathResponse_Point {
+func (x *SkillUseRequest) GetTargetId() int64 {
 	if x != nil {
-		return x.Path
+		return x.TargetId
 	}
-	return nil
+	return 0
 }
 
-// 地图对象同步
-type MapSyncObjects struct {
+// 使用技能响应
+type SkillUseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MapId         int64                  `protobuf:"varint,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	Objects       []*MapObjectInfo       `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
-	SyncTime      int64                  `protobuf:"varint,3,opt,name=sync_time,json=syncTime,proto3" json:"sync_time,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	SkillId       int64                  `protobuf:"varint,3,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapSyncObjects) Reset() {
-	*x = MapSyncObjects{}
-	mi := &file_resources_protocol_game_proto_msgTypes[30]
+func (x *SkillUseResponse) Reset() {
+	*x = SkillUseResponse{}
+	mi := &file_resources_protocol_game_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapSyncObjects) String() string {
+func (x *SkillUseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapSyncObjects) ProtoMessage() {}
+func (*SkillUseResponse) ProtoMessage() {}
 
-func (x *MapSyncObjects) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[30]
+func (x *SkillUseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,33 +6576,40 @@ func (x *MapSyncObjects) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapSyncObjects.ProtoReflect.Descriptor instead.
-func (*MapSyncObjects) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SkillUseResponse.ProtoReflect.Descriptor instead.
+func (*SkillUseResponse) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *MapSyncObjects) GetMapId() int64 {
+func (x *SkillUseResponse) GetSuccess() bool {
 	if x != nil {
-		return x.MapId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *MapSyncObjects) GetObjects() []*MapObjectInfo {
+func (x *SkillUseResponse) GetErrorMsg() string {
 	if x != nil {
-		return x.Objects
+		return x.ErrorMsg
 	}
-	return nil
+	return ""
 }
 
-func (x *MapSyncObjects) GetSyncTime() int64 {
+func (x *SkillUseResponse) GetSkillId() int64 {
 	if x != nil {
-		return x.SyncTime
+		return x.SkillId
+	}
+	return 0
+}
+
+func (x *SkillUseResponse) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
 	}
 	return 0
 }
 
-type MapPathResponse_Point struct {
+type MapGetPathResponse_Point struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
 	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
@@ -3001,21 +6618,21 @@ type MapPathResponse_Point struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MapPathResponse_Point) Reset() {
-	*x = MapPathResponse_Point{}
-	mi := &file_resources_protocol_game_proto_msgTypes[31]
+func (x *MapGetPathResponse_Point) Reset() {
+	*x = MapGetPathResponse_Point{}
+	mi := &file_resources_protocol_game_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MapPathResponse_Point) String() string {
+func (x *MapGetPathResponse_Point) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapPathResponse_Point) ProtoMessage() {}
+func (*MapGetPathResponse_Point) ProtoMessage() {}
 
-func (x *MapPathResponse_Point) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_protocol_game_proto_msgTypes[31]
+func (x *MapGetPathResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_protocol_game_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3026,26 +6643,26 @@ func (x *MapPathResponse_Point) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapPathResponse_Point.ProtoReflect.Descriptor instead.
-func (*MapPathResponse_Point) Descriptor() ([]byte, []int) {
-	return file_resources_protocol_game_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use MapGetPathResponse_Point.ProtoReflect.Descriptor instead.
+func (*MapGetPathResponse_Point) Descriptor() ([]byte, []int) {
+	return file_resources_protocol_game_proto_rawDescGZIP(), []int{46, 0}
 }
 
-func (x *MapPathResponse_Point) GetX() float32 {
+func (x *MapGetPathResponse_Point) GetX() float32 {
 	if x != nil {
 		return x.X
 	}
 	return 0
 }
 
-func (x *MapPathResponse_Point) GetY() float32 {
+func (x *MapGetPathResponse_Point) GetY() float32 {
 	if x != nil {
 		return x.Y
 	}
 	return 0
 }
 
-func (x *MapPathResponse_Point) GetZ() float32 {
+func (x *MapGetPathResponse_Point) GetZ() float32 {
 	if x != nil {
 		return x.Z
 	}
@@ -3062,12 +6679,13 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\tplayer_id\x18\x02 \x01(\x03R\bplayerId\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x03 \x01(\x03R\tsessionId\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\"j\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"|\n" +
 	"\bResponse\x12\x15\n" +
 	"\x06msg_id\x18\x01 \x01(\rR\x05msgId\x12\x16\n" +
 	"\x06result\x18\x02 \x01(\x05R\x06result\x12\x1b\n" +
 	"\terror_msg\x18\x03 \x01(\tR\berrorMsg\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\"\xa4\x01\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x10\n" +
+	"\x03seq\x18\x05 \x01(\rR\x03seq\"\xa4\x01\n" +
 	"\x14AccountCreateRequest\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
@@ -3077,25 +6695,31 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\aversion\x18\x05 \x01(\tR\aversion\"N\n" +
 	"\x15AccountCreateResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
-	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"\xa3\x01\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"\xdd\x01\n" +
 	"\x13AccountLoginRequest\x12\x18\n" +
 	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
 	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x1f\n" +
 	"\vdevice_type\x18\x04 \x01(\x05R\n" +
 	"deviceType\x12\x18\n" +
-	"\aversion\x18\x05 \x01(\tR\aversion\"w\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12\"\n" +
+	"\fcompressions\x18\x06 \x03(\tR\fcompressions\x12\x14\n" +
+	"\x05batch\x18\a \x01(\bR\x05batch\"\x94\x01\n" +
 	"\n" +
 	"PlayerInfo\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05level\x18\x03 \x01(\x05R\x05level\x12\x10\n" +
 	"\x03sex\x18\x04 \x01(\x05R\x03sex\x12\x10\n" +
-	"\x03age\x18\x05 \x01(\x05R\x03age\"}\n" +
+	"\x03age\x18\x05 \x01(\x05R\x03age\x12\x1b\n" +
+	"\tdelete_at\x18\x06 \x01(\x03R\bdeleteAt\"\xe0\x01\n" +
 	"\x14AccountLoginResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12.\n" +
-	"\aplayers\x18\x03 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\"M\n" +
+	"\aplayers\x18\x03 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\x12 \n" +
+	"\vcompression\x18\x04 \x01(\tR\vcompression\x12)\n" +
+	"\x10protocol_version\x18\x05 \x01(\x05R\x0fprotocolVersion\x12\x14\n" +
+	"\x05batch\x18\x06 \x01(\bR\x05batch\"M\n" +
 	"\x13PlayerCreateRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03sex\x18\x02 \x01(\x05R\x03sex\x12\x10\n" +
@@ -3105,14 +6729,48 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12,\n" +
 	"\x06player\x18\x03 \x01(\v2\x14.protocol.PlayerInfoR\x06player\"1\n" +
 	"\x12PlayerLoginRequest\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"\xa7\x01\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"\xca\x01\n" +
 	"\x13PlayerLoginResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x1b\n" +
 	"\tplayer_id\x18\x03 \x01(\x03R\bplayerId\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
 	"\x05level\x18\x05 \x01(\x05R\x05level\x12\x12\n" +
-	"\x04gold\x18\x06 \x01(\x03R\x04gold\"3\n" +
+	"\x04gold\x18\x06 \x01(\x03R\x04gold\x12!\n" +
+	"\fresume_token\x18\a \x01(\tR\vresumeToken\"_\n" +
+	"\x16PlayerReconnectRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12\"\n" +
+	"\rlast_recv_seq\x18\x02 \x01(\x04R\vlastRecvSeq\"\xfb\x01\n" +
+	"\x17PlayerReconnectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x03R\bplayerId\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\x12\x1d\n" +
+	"\n" +
+	"server_seq\x18\x05 \x01(\x04R\tserverSeq\x12\x1f\n" +
+	"\vfull_resync\x18\x06 \x01(\bR\n" +
+	"fullResync\x12)\n" +
+	"\x10protocol_version\x18\a \x01(\x05R\x0fprotocolVersion\"E\n" +
+	"\x13PlayerRenameRequest\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"a\n" +
+	"\x14PlayerRenameResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"2\n" +
+	"\x13PlayerDeleteRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"\x87\x01\n" +
+	"\x14PlayerDeleteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x03R\bplayerId\x12\x1b\n" +
+	"\tdelete_at\x18\x04 \x01(\x03R\bdeleteAt\"3\n" +
+	"\x14PlayerRestoreRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"|\n" +
+	"\x15PlayerRestoreResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12,\n" +
+	"\x06player\x18\x03 \x01(\v2\x14.protocol.PlayerInfoR\x06player\"3\n" +
 	"\x14PlayerGetInfoRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"\x8a\x01\n" +
 	"\x15PlayerGetInfoResponse\x12\x18\n" +
@@ -3124,7 +6782,45 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\"M\n" +
 	"\x14PlayerLogoutResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
-	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"\xd9\x01\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"r\n" +
+	"\x10LoginQueueNotify\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12!\n" +
+	"\fqueue_length\x18\x02 \x01(\x05R\vqueueLength\x12\x1f\n" +
+	"\veta_seconds\x18\x03 \x01(\x05R\n" +
+	"etaSeconds\"T\n" +
+	"\n" +
+	"KickNotify\x12,\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x14.protocol.KickReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
+	"\x10HandshakeRequest\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\x12\x18\n" +
+	"\aciphers\x18\x02 \x03(\tR\aciphers\"\x9f\x01\n" +
+	"\x11HandshakeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x16\n" +
+	"\x06cipher\x18\x04 \x01(\tR\x06cipher\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\":\n" +
+	"\vPingRequest\x12\x1f\n" +
+	"\vclient_time\x18\x01 \x01(\x03R\n" +
+	"clientTimeJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"m\n" +
+	"\fPingResponse\x12\x1f\n" +
+	"\vclient_time\x18\x01 \x01(\x03R\n" +
+	"clientTime\x12\x1f\n" +
+	"\vserver_time\x18\x02 \x01(\x03R\n" +
+	"serverTime\x12\x1b\n" +
+	"\tprobe_seq\x18\x03 \x01(\rR\bprobeSeq\"-\n" +
+	"\x0ePingAckRequest\x12\x1b\n" +
+	"\tprobe_seq\x18\x01 \x01(\rR\bprobeSeq\"\x10\n" +
+	"\x0eUdpBindRequest\"\x8a\x01\n" +
+	"\x0fUdpBindResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x10\n" +
+	"\x03mtu\x18\x05 \x01(\x05R\x03mtu\"\xd9\x01\n" +
 	"\x0fPlayerBasicInfo\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x03R\bplayerId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -3279,17 +6975,17 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\x01y\x18\x05 \x01(\x02R\x01y\x12\f\n" +
 	"\x01z\x18\x06 \x01(\x02R\x01z\x12 \n" +
 	"\vorientation\x18\a \x01(\x02R\vorientation\x12\x12\n" +
-	"\x04time\x18\b \x01(\x03R\x04time\"\x83\x01\n" +
-	"\x0eMapPathRequest\x12\x15\n" +
+	"\x04time\x18\b \x01(\x03R\x04time\"\x86\x01\n" +
+	"\x11MapGetPathRequest\x12\x15\n" +
 	"\x06map_id\x18\x01 \x01(\x03R\x05mapId\x12\x17\n" +
 	"\astart_x\x18\x02 \x01(\x02R\x06startX\x12\x17\n" +
 	"\astart_y\x18\x03 \x01(\x02R\x06startY\x12\x13\n" +
 	"\x05end_x\x18\x04 \x01(\x02R\x04endX\x12\x13\n" +
-	"\x05end_y\x18\x05 \x01(\x02R\x04endY\"\xb0\x01\n" +
-	"\x0fMapPathResponse\x12\x18\n" +
+	"\x05end_y\x18\x05 \x01(\x02R\x04endY\"\xb6\x01\n" +
+	"\x12MapGetPathResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
-	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x123\n" +
-	"\x04path\x18\x03 \x03(\v2\x1f.protocol.MapPathResponse.PointR\x04path\x1a1\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x126\n" +
+	"\x04path\x18\x03 \x03(\v2\".protocol.MapGetPathResponse.PointR\x04path\x1a1\n" +
 	"\x05Point\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x02R\x01y\x12\f\n" +
@@ -3297,16 +6993,156 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\x0eMapSyncObjects\x12\x15\n" +
 	"\x06map_id\x18\x01 \x01(\x03R\x05mapId\x121\n" +
 	"\aobjects\x18\x02 \x03(\v2\x17.protocol.MapObjectInfoR\aobjects\x12\x1b\n" +
-	"\tsync_time\x18\x03 \x01(\x03R\bsyncTime*\x8d\x01\n" +
+	"\tsync_time\x18\x03 \x01(\x03R\bsyncTime\"\x15\n" +
+	"\x13InventoryGetRequest\"\x8b\x01\n" +
+	"\x14InventoryGetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12(\n" +
+	"\x05items\x18\x04 \x03(\v2\x12.protocol.ItemInfoR\x05items\"J\n" +
+	"\x16InventoryRemoveRequest\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"P\n" +
+	"\x17InventoryRemoveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"1\n" +
+	"\x13InventoryUseRequest\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\"M\n" +
+	"\x14InventoryUseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"\x16\n" +
+	"\x14InventorySortRequest\"x\n" +
+	"\x15InventorySortResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12(\n" +
+	"\x05items\x18\x03 \x03(\v2\x12.protocol.ItemInfoR\x05items\"\x15\n" +
+	"\x13EquipmentGetRequest\"\x81\x01\n" +
+	"\x14EquipmentGetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x122\n" +
+	"\n" +
+	"equipments\x18\x03 \x03(\v2\x12.protocol.ItemInfoR\n" +
+	"equipments\"P\n" +
+	"\x15EquipmentEquipRequest\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x1b\n" +
+	"\tequip_pos\x18\x02 \x01(\x05R\bequipPos\"\x81\x01\n" +
+	"\x16EquipmentEquipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x120\n" +
+	"\tequipment\x18\x03 \x01(\v2\x12.protocol.ItemInfoR\tequipment\"6\n" +
+	"\x17EquipmentUnequipRequest\x12\x1b\n" +
+	"\tequip_pos\x18\x01 \x01(\x05R\bequipPos\"m\n" +
+	"\x18EquipmentUnequipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"\x14\n" +
+	"\x12MailGetListRequest\"v\n" +
+	"\x13MailGetListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12(\n" +
+	"\x05mails\x18\x03 \x03(\v2\x12.protocol.MailInfoR\x05mails\"/\n" +
+	"\x14MailGetDetailRequest\x12\x17\n" +
+	"\amail_id\x18\x01 \x01(\x03R\x06mailId\"v\n" +
+	"\x15MailGetDetailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12&\n" +
+	"\x04mail\x18\x03 \x01(\v2\x12.protocol.MailInfoR\x04mail\"b\n" +
+	"\x0fMailSendRequest\x12\x1f\n" +
+	"\vreceiver_id\x18\x01 \x01(\x03R\n" +
+	"receiverId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"b\n" +
+	"\x10MailSendResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x17\n" +
+	"\amail_id\x18\x03 \x01(\x03R\x06mailId\",\n" +
+	"\x11MailDeleteRequest\x12\x17\n" +
+	"\amail_id\x18\x01 \x01(\x03R\x06mailId\"K\n" +
+	"\x12MailDeleteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"-\n" +
+	"\x12MailReceiveRequest\x12\x17\n" +
+	"\amail_id\x18\x01 \x01(\x03R\x06mailId\"v\n" +
+	"\x13MailReceiveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12(\n" +
+	"\x05items\x18\x03 \x03(\v2\x12.protocol.ItemInfoR\x05items\"\x14\n" +
+	"\x12TaskGetListRequest\"v\n" +
+	"\x13TaskGetListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12(\n" +
+	"\x05tasks\x18\x03 \x03(\v2\x12.protocol.TaskInfoR\x05tasks\"/\n" +
+	"\x14TaskGetDetailRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"v\n" +
+	"\x15TaskGetDetailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12&\n" +
+	"\x04task\x18\x03 \x01(\v2\x12.protocol.TaskInfoR\x04task\",\n" +
+	"\x11TaskAcceptRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"s\n" +
+	"\x12TaskAcceptResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12&\n" +
+	"\x04task\x18\x03 \x01(\v2\x12.protocol.TaskInfoR\x04task\",\n" +
+	"\x11TaskSubmitRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"\x9b\x01\n" +
+	"\x12TaskSubmitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x12\n" +
+	"\x04gold\x18\x03 \x01(\x03R\x04gold\x12\x10\n" +
+	"\x03exp\x18\x04 \x01(\x03R\x03exp\x12(\n" +
+	"\x05items\x18\x05 \x03(\v2\x12.protocol.ItemInfoR\x05items\",\n" +
+	"\x11TaskCancelRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"K\n" +
+	"\x12TaskCancelResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\"\x15\n" +
+	"\x13SkillGetListRequest\"z\n" +
+	"\x14SkillGetListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12+\n" +
+	"\x06skills\x18\x03 \x03(\v2\x13.protocol.SkillInfoR\x06skills\".\n" +
+	"\x11SkillLearnRequest\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\x03R\askillId\"v\n" +
+	"\x12SkillLearnResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12)\n" +
+	"\x05skill\x18\x03 \x01(\v2\x13.protocol.SkillInfoR\x05skill\"0\n" +
+	"\x13SkillUpgradeRequest\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\x03R\askillId\"x\n" +
+	"\x14SkillUpgradeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12)\n" +
+	"\x05skill\x18\x03 \x01(\v2\x13.protocol.SkillInfoR\x05skill\"I\n" +
+	"\x0fSkillUseRequest\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\x03R\askillId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\"\x81\x01\n" +
+	"\x10SkillUseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\terror_msg\x18\x02 \x01(\tR\berrorMsg\x12\x19\n" +
+	"\bskill_id\x18\x03 \x01(\x03R\askillId\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\x03R\btargetId*\x8d\x01\n" +
 	"\vMessageType\x12\x14\n" +
 	"\x10MSG_TYPE_INVALID\x10\x00\x12\x13\n" +
 	"\x0fMSG_TYPE_SYSTEM\x10\x01\x12\x14\n" +
 	"\x0fMSG_TYPE_PLAYER\x10\xe8\a\x12\x13\n" +
 	"\x0eMSG_TYPE_GUILD\x10\xd0\x0f\x12\x15\n" +
 	"\x10MSG_TYPE_AUCTION\x10\xb8\x17\x12\x11\n" +
-	"\fMSG_TYPE_MAP\x10\xa0\x1f*%\n" +
+	"\fMSG_TYPE_MAP\x10\xa0\x1f*\xb1\x01\n" +
 	"\vSystemMsgId\x12\x16\n" +
-	"\x12MSG_SYSTEM_INVALID\x10\x00*\xc0\a\n" +
+	"\x12MSG_SYSTEM_INVALID\x10\x00\x12\x13\n" +
+	"\x0fMSG_SYSTEM_KICK\x10\x01\x12\x17\n" +
+	"\x13MSG_SYSTEM_UDP_BIND\x10\x02\x12\x18\n" +
+	"\x14MSG_SYSTEM_HANDSHAKE\x10\x03\x12\x13\n" +
+	"\x0fMSG_SYSTEM_PING\x10\x04\x12\x14\n" +
+	"\x10MSG_SYSTEM_BATCH\x10\x05\x12\x17\n" +
+	"\x13MSG_SYSTEM_PING_ACK\x10\x06*|\n" +
+	"\n" +
+	"KickReason\x12\x17\n" +
+	"\x13KICK_REASON_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bKICK_REASON_DUPLICATE_LOGIN\x10\x01\x12\x1c\n" +
+	"\x18KICK_REASON_RATE_LIMITED\x10\x02\x12\x16\n" +
+	"\x12KICK_REASON_BANNED\x10\x03*\xcf\b\n" +
 	"\vPlayerMsgId\x12\x16\n" +
 	"\x12MSG_PLAYER_INVALID\x10\x00\x12\x1e\n" +
 	"\x19MSG_PLAYER_ACCOUNT_CREATE\x10\xe9\a\x12\x1d\n" +
@@ -3315,7 +7151,9 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\x17MSG_PLAYER_PLAYER_LOGIN\x10\xec\a\x12\x1d\n" +
 	"\x18MSG_PLAYER_PLAYER_LOGOUT\x10\xed\a\x12\x18\n" +
 	"\x13MSG_PLAYER_GET_INFO\x10\xee\a\x12\x1b\n" +
-	"\x16MSG_PLAYER_UPDATE_INFO\x10\xef\a\x12\x1d\n" +
+	"\x16MSG_PLAYER_UPDATE_INFO\x10\xef\a\x12\x19\n" +
+	"\x14MSG_PLAYER_RECONNECT\x10\xf0\a\x12\x1b\n" +
+	"\x16MSG_PLAYER_LOGIN_QUEUE\x10\xf1\a\x12\x1d\n" +
 	"\x18MSG_PLAYER_INVENTORY_GET\x10\xf2\a\x12\x1d\n" +
 	"\x18MSG_PLAYER_INVENTORY_ADD\x10\xf3\a\x12 \n" +
 	"\x1bMSG_PLAYER_INVENTORY_REMOVE\x10\xf4\a\x12\x1d\n" +
@@ -3338,7 +7176,10 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\x19MSG_PLAYER_SKILL_GET_LIST\x10\x9a\b\x12\x1b\n" +
 	"\x16MSG_PLAYER_SKILL_LEARN\x10\x9b\b\x12\x1d\n" +
 	"\x18MSG_PLAYER_SKILL_UPGRADE\x10\x9c\b\x12\x19\n" +
-	"\x14MSG_PLAYER_SKILL_USE\x10\x9d\b*\xbf\x02\n" +
+	"\x14MSG_PLAYER_SKILL_USE\x10\x9d\b\x12\x16\n" +
+	"\x11MSG_PLAYER_RENAME\x10\xa4\b\x12\x1d\n" +
+	"\x18MSG_PLAYER_PLAYER_DELETE\x10\xae\b\x12\x1e\n" +
+	"\x19MSG_PLAYER_PLAYER_RESTORE\x10\xaf\b*\xbf\x02\n" +
 	"\n" +
 	"GuildMsgId\x12\x15\n" +
 	"\x11MSG_GUILD_INVALID\x10\x00\x12\x15\n" +
@@ -3369,7 +7210,78 @@ const file_resources_protocol_game_proto_rawDesc = "" +
 	"\fMSG_MAP_MOVE\x10\xa3\x1f\x12\x15\n" +
 	"\x10MSG_MAP_GET_PATH\x10\xa4\x1f\x12\x18\n" +
 	"\x13MSG_MAP_GET_OBJECTS\x10\xa5\x1f\x12\x19\n" +
-	"\x14MSG_MAP_SYNC_OBJECTS\x10\xa6\x1fB\rZ\v./;protocolb\x06proto3"
+	"\x14MSG_MAP_SYNC_OBJECTS\x10\xa6\x1f*\x87\x0e\n" +
+	"\tErrorCode\x12\n" +
+	"\n" +
+	"\x06ERR_OK\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ERR_SERVER\x10\x01\x12\x17\n" +
+	"\x13ERR_INVALID_REQUEST\x10\x02\x12\x19\n" +
+	"\x15ERR_STATE_NOT_ALLOWED\x10\x03\x12\x1a\n" +
+	"\x16ERR_HANDSHAKE_REQUIRED\x10\x04\x12\x19\n" +
+	"\x15ERR_SECURITY_DISABLED\x10\x05\x12\x16\n" +
+	"\x12ERR_HANDSHAKE_DONE\x10\x06\x12\x1a\n" +
+	"\x16ERR_UNSUPPORTED_CIPHER\x10\a\x12\x18\n" +
+	"\x14ERR_HANDSHAKE_FAILED\x10\b\x12\x17\n" +
+	"\x13ERR_NOT_IMPLEMENTED\x10\t\x12\x14\n" +
+	"\x10ERR_RATE_LIMITED\x10\n" +
+	"\x12\x13\n" +
+	"\x0fERR_SERVER_FULL\x10\v\x12\x13\n" +
+	"\x0fERR_NAME_LENGTH\x10\f\x12\x14\n" +
+	"\x10ERR_NAME_CHARSET\x10\r\x12\x15\n" +
+	"\x11ERR_NAME_RESERVED\x10\x0e\x12\x16\n" +
+	"\x12ERR_NAME_SENSITIVE\x10\x0f\x12\x12\n" +
+	"\x0eERR_NAME_TAKEN\x10\x10\x12\x15\n" +
+	"\x11ERR_ACCOUNT_EMPTY\x10d\x12\x16\n" +
+	"\x12ERR_ACCOUNT_EXISTS\x10e\x12\x19\n" +
+	"\x15ERR_ACCOUNT_NOT_FOUND\x10f\x12\x18\n" +
+	"\x14ERR_ACCOUNT_PASSWORD\x10g\x12 \n" +
+	"\x1cERR_ACCOUNT_LOGGED_ELSEWHERE\x10h\x12\x1d\n" +
+	"\x19ERR_ACCOUNT_NOT_LOGGED_IN\x10i\x12\x1f\n" +
+	"\x1bERR_RECONNECT_TOKEN_INVALID\x10j\x12\x1e\n" +
+	"\x1aERR_CLIENT_VERSION_TOO_OLD\x10k\x12\x16\n" +
+	"\x12ERR_ACCOUNT_BANNED\x10l\x12\x1b\n" +
+	"\x17ERR_LOGIN_QUEUE_TIMEOUT\x10m\x12\x14\n" +
+	"\x10ERR_LOGIN_QUEUED\x10n\x12\x1a\n" +
+	"\x15ERR_PLAYER_NAME_EMPTY\x10\xc8\x01\x12\x19\n" +
+	"\x14ERR_PLAYER_NOT_FOUND\x10\xc9\x01\x12\x1d\n" +
+	"\x18ERR_PLAYER_NOT_LOGGED_IN\x10\xca\x01\x12!\n" +
+	"\x1cERR_PLAYER_ALREADY_LOGGED_IN\x10\xcb\x01\x12 \n" +
+	"\x1bERR_PLAYER_LOGGED_ELSEWHERE\x10\xcc\x01\x12\x1f\n" +
+	"\x1aERR_PLAYER_LOGOUT_REQUIRED\x10\xcd\x01\x12\x17\n" +
+	"\x12ERR_PLAYER_OFFLINE\x10\xce\x01\x12\x16\n" +
+	"\x11ERR_LEVEL_TOO_LOW\x10\xcf\x01\x12\x16\n" +
+	"\x11ERR_PLAYER_BANNED\x10\xd0\x01\x12\x15\n" +
+	"\x10ERR_PLAYER_MUTED\x10\xd1\x01\x12\x1c\n" +
+	"\x17ERR_PLAYER_TRADE_LOCKED\x10\xd2\x01\x12\x1d\n" +
+	"\x18ERR_RENAME_CARD_REQUIRED\x10\xd3\x01\x12\x1c\n" +
+	"\x17ERR_PLAYER_GUILD_LEADER\x10\xd4\x01\x12\x1e\n" +
+	"\x19ERR_PLAYER_ACTIVE_AUCTION\x10\xd5\x01\x12\x1e\n" +
+	"\x19ERR_PLAYER_PENDING_DELETE\x10\xd6\x01\x12\"\n" +
+	"\x1dERR_PLAYER_NOT_PENDING_DELETE\x10\xd7\x01\x12\x1f\n" +
+	"\x1aERR_PLAYER_RESTORE_EXPIRED\x10\xd8\x01\x12\x17\n" +
+	"\x12ERR_ITEM_NOT_FOUND\x10\xac\x02\x12\x1b\n" +
+	"\x16ERR_ITEM_COUNT_INVALID\x10\xad\x02\x12\x17\n" +
+	"\x12ERR_INVENTORY_FULL\x10\xae\x02\x12\x1b\n" +
+	"\x16ERR_EQUIP_SLOT_INVALID\x10\xb6\x02\x12\x1c\n" +
+	"\x17ERR_EQUIP_SLOT_MISMATCH\x10\xb7\x02\x12\x19\n" +
+	"\x14ERR_EQUIP_SLOT_EMPTY\x10\xb8\x02\x12\x17\n" +
+	"\x12ERR_MAIL_NOT_FOUND\x10\x90\x03\x12\x1b\n" +
+	"\x16ERR_MAIL_NO_ATTACHMENT\x10\x91\x03\x12\x19\n" +
+	"\x14ERR_MAIL_TITLE_EMPTY\x10\x92\x03\x12\x1e\n" +
+	"\x19ERR_MAIL_RECEIVER_OFFLINE\x10\x93\x03\x12\x17\n" +
+	"\x12ERR_TASK_NOT_FOUND\x10\xf4\x03\x12\x1b\n" +
+	"\x16ERR_TASK_NOT_COMPLETED\x10\xf5\x03\x12\x13\n" +
+	"\x0eERR_TASK_LIMIT\x10\xf6\x03\x12\x16\n" +
+	"\x11ERR_TASK_ACCEPTED\x10\xf7\x03\x12\x1b\n" +
+	"\x16ERR_TASK_CANNOT_CANCEL\x10\xf8\x03\x12\x18\n" +
+	"\x13ERR_SKILL_NOT_FOUND\x10\xd8\x04\x12\x15\n" +
+	"\x10ERR_SKILL_LOCKED\x10\xd9\x04\x12\x16\n" +
+	"\x11ERR_SKILL_LEARNED\x10\xda\x04\x12\x14\n" +
+	"\x0fERR_SKILL_LIMIT\x10\xdb\x04\x12\x17\n" +
+	"\x12ERR_SKILL_COOLDOWN\x10\xdc\x04\x12\x16\n" +
+	"\x11ERR_SKILL_PASSIVE\x10\xdd\x04\x12 \n" +
+	"\x1bERR_SKILL_UPGRADE_CONDITION\x10\xde\x04B\rZ\v./;protocolb\x06proto3"
 
 var (
 	file_resources_protocol_game_proto_rawDescOnce sync.Once
@@ -3383,61 +7295,138 @@ func file_resources_protocol_game_proto_rawDescGZIP() []byte {
 	return file_resources_protocol_game_proto_rawDescData
 }
 
-var file_resources_protocol_game_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_resources_protocol_game_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_resources_protocol_game_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_resources_protocol_game_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_resources_protocol_game_proto_goTypes = []any{
-	(MessageType)(0),              // 0: protocol.MessageType
-	(SystemMsgId)(0),              // 1: protocol.SystemMsgId
-	(PlayerMsgId)(0),              // 2: protocol.PlayerMsgId
-	(GuildMsgId)(0),               // 3: protocol.GuildMsgId
-	(AuctionMsgId)(0),             // 4: protocol.AuctionMsgId
-	(MapMsgId)(0),                 // 5: protocol.MapMsgId
-	(*Message)(nil),               // 6: protocol.Message
-	(*Response)(nil),              // 7: protocol.Response
-	(*AccountCreateRequest)(nil),  // 8: protocol.AccountCreateRequest
-	(*AccountCreateResponse)(nil), // 9: protocol.AccountCreateResponse
-	(*AccountLoginRequest)(nil),   // 10: protocol.AccountLoginRequest
-	(*PlayerInfo)(nil),            // 11: protocol.PlayerInfo
-	(*AccountLoginResponse)(nil),  // 12: protocol.AccountLoginResponse
-	(*PlayerCreateRequest)(nil),   // 13: protocol.PlayerCreateRequest
-	(*PlayerCreateResponse)(nil),  // 14: protocol.PlayerCreateResponse
-	(*PlayerLoginRequest)(nil),    // 15: protocol.PlayerLoginRequest
-	(*PlayerLoginResponse)(nil),   // 16: protocol.PlayerLoginResponse
-	(*PlayerGetInfoRequest)(nil),  // 17: protocol.PlayerGetInfoRequest
-	(*PlayerGetInfoResponse)(nil), // 18: protocol.PlayerGetInfoResponse
-	(*PlayerLogoutRequest)(nil),   // 19: protocol.PlayerLogoutRequest
-	(*PlayerLogoutResponse)(nil),  // 20: protocol.PlayerLogoutResponse
-	(*PlayerBasicInfo)(nil),       // 21: protocol.PlayerBasicInfo
-	(*ItemInfo)(nil),              // 22: protocol.ItemInfo
-	(*TaskInfo)(nil),              // 23: protocol.TaskInfo
-	(*SkillInfo)(nil),             // 24: protocol.SkillInfo
-	(*MailInfo)(nil),              // 25: protocol.MailInfo
-	(*GuildInfo)(nil),             // 26: protocol.GuildInfo
-	(*GuildMemberInfo)(nil),       // 27: protocol.GuildMemberInfo
-	(*GuildApplyInfo)(nil),        // 28: protocol.GuildApplyInfo
-	(*AuctionItemInfo)(nil),       // 29: protocol.AuctionItemInfo
-	(*AuctionBidInfo)(nil),        // 30: protocol.AuctionBidInfo
-	(*MapObjectInfo)(nil),         // 31: protocol.MapObjectInfo
-	(*MapMoveRequest)(nil),        // 32: protocol.MapMoveRequest
-	(*MapMoveResponse)(nil),       // 33: protocol.MapMoveResponse
-	(*MapPathRequest)(nil),        // 34: protocol.MapPathRequest
-	(*MapPathResponse)(nil),       // 35: protocol.MapPathResponse
-	(*MapSyncObjects)(nil),        // 36: protocol.MapSyncObjects
-	(*MapPathResponse_Point)(nil), // 37: protocol.MapPathResponse.Point
+	(MessageType)(0),                 // 0: protocol.MessageType
+	(SystemMsgId)(0),                 // 1: protocol.SystemMsgId
+	(KickReason)(0),                  // 2: protocol.KickReason
+	(PlayerMsgId)(0),                 // 3: protocol.PlayerMsgId
+	(GuildMsgId)(0),                  // 4: protocol.GuildMsgId
+	(AuctionMsgId)(0),                // 5: protocol.AuctionMsgId
+	(MapMsgId)(0),                    // 6: protocol.MapMsgId
+	(ErrorCode)(0),                   // 7: protocol.ErrorCode
+	(*Message)(nil),                  // 8: protocol.Message
+	(*Response)(nil),                 // 9: protocol.Response
+	(*AccountCreateRequest)(nil),     // 10: protocol.AccountCreateRequest
+	(*AccountCreateResponse)(nil),    // 11: protocol.AccountCreateResponse
+	(*AccountLoginRequest)(nil),      // 12: protocol.AccountLoginRequest
+	(*PlayerInfo)(nil),               // 13: protocol.PlayerInfo
+	(*AccountLoginResponse)(nil),     // 14: protocol.AccountLoginResponse
+	(*PlayerCreateRequest)(nil),      // 15: protocol.PlayerCreateRequest
+	(*PlayerCreateResponse)(nil),     // 16: protocol.PlayerCreateResponse
+	(*PlayerLoginRequest)(nil),       // 17: protocol.PlayerLoginRequest
+	(*PlayerLoginResponse)(nil),      // 18: protocol.PlayerLoginResponse
+	(*PlayerReconnectRequest)(nil),   // 19: protocol.PlayerReconnectRequest
+	(*PlayerReconnectResponse)(nil),  // 20: protocol.PlayerReconnectResponse
+	(*PlayerRenameRequest)(nil),      // 21: protocol.PlayerRenameRequest
+	(*PlayerRenameResponse)(nil),     // 22: protocol.PlayerRenameResponse
+	(*PlayerDeleteRequest)(nil),      // 23: protocol.PlayerDeleteRequest
+	(*PlayerDeleteResponse)(nil),     // 24: protocol.PlayerDeleteResponse
+	(*PlayerRestoreRequest)(nil),     // 25: protocol.PlayerRestoreRequest
+	(*PlayerRestoreResponse)(nil),    // 26: protocol.PlayerRestoreResponse
+	(*PlayerGetInfoRequest)(nil),     // 27: protocol.PlayerGetInfoRequest
+	(*PlayerGetInfoResponse)(nil),    // 28: protocol.PlayerGetInfoResponse
+	(*PlayerLogoutRequest)(nil),      // 29: protocol.PlayerLogoutRequest
+	(*PlayerLogoutResponse)(nil),     // 30: protocol.PlayerLogoutResponse
+	(*LoginQueueNotify)(nil),         // 31: protocol.LoginQueueNotify
+	(*KickNotify)(nil),               // 32: protocol.KickNotify
+	(*HandshakeRequest)(nil),         // 33: protocol.HandshakeRequest
+	(*HandshakeResponse)(nil),        // 34: protocol.HandshakeResponse
+	(*PingRequest)(nil),              // 35: protocol.PingRequest
+	(*PingResponse)(nil),             // 36: protocol.PingResponse
+	(*PingAckRequest)(nil),           // 37: protocol.PingAckRequest
+	(*UdpBindRequest)(nil),           // 38: protocol.UdpBindRequest
+	(*UdpBindResponse)(nil),          // 39: protocol.UdpBindResponse
+	(*PlayerBasicInfo)(nil),          // 40: protocol.PlayerBasicInfo
+	(*ItemInfo)(nil),                 // 41: protocol.ItemInfo
+	(*TaskInfo)(nil),                 // 42: protocol.TaskInfo
+	(*SkillInfo)(nil),                // 43: protocol.SkillInfo
+	(*MailInfo)(nil),                 // 44: protocol.MailInfo
+	(*GuildInfo)(nil),                // 45: protocol.GuildInfo
+	(*GuildMemberInfo)(nil),          // 46: protocol.GuildMemberInfo
+	(*GuildApplyInfo)(nil),           // 47: protocol.GuildApplyInfo
+	(*AuctionItemInfo)(nil),          // 48: protocol.AuctionItemInfo
+	(*AuctionBidInfo)(nil),           // 49: protocol.AuctionBidInfo
+	(*MapObjectInfo)(nil),            // 50: protocol.MapObjectInfo
+	(*MapMoveRequest)(nil),           // 51: protocol.MapMoveRequest
+	(*MapMoveResponse)(nil),          // 52: protocol.MapMoveResponse
+	(*MapGetPathRequest)(nil),        // 53: protocol.MapGetPathRequest
+	(*MapGetPathResponse)(nil),       // 54: protocol.MapGetPathResponse
+	(*MapSyncObjects)(nil),           // 55: protocol.MapSyncObjects
+	(*InventoryGetRequest)(nil),      // 56: protocol.InventoryGetRequest
+	(*InventoryGetResponse)(nil),     // 57: protocol.InventoryGetResponse
+	(*InventoryRemoveRequest)(nil),   // 58: protocol.InventoryRemoveRequest
+	(*InventoryRemoveResponse)(nil),  // 59: protocol.InventoryRemoveResponse
+	(*InventoryUseRequest)(nil),      // 60: protocol.InventoryUseRequest
+	(*InventoryUseResponse)(nil),     // 61: protocol.InventoryUseResponse
+	(*InventorySortRequest)(nil),     // 62: protocol.InventorySortRequest
+	(*InventorySortResponse)(nil),    // 63: protocol.InventorySortResponse
+	(*EquipmentGetRequest)(nil),      // 64: protocol.EquipmentGetRequest
+	(*EquipmentGetResponse)(nil),     // 65: protocol.EquipmentGetResponse
+	(*EquipmentEquipRequest)(nil),    // 66: protocol.EquipmentEquipRequest
+	(*EquipmentEquipResponse)(nil),   // 67: protocol.EquipmentEquipResponse
+	(*EquipmentUnequipRequest)(nil),  // 68: protocol.EquipmentUnequipRequest
+	(*EquipmentUnequipResponse)(nil), // 69: protocol.EquipmentUnequipResponse
+	(*MailGetListRequest)(nil),       // 70: protocol.MailGetListRequest
+	(*MailGetListResponse)(nil),      // 71: protocol.MailGetListResponse
+	(*MailGetDetailRequest)(nil),     // 72: protocol.MailGetDetailRequest
+	(*MailGetDetailResponse)(nil),    // 73: protocol.MailGetDetailResponse
+	(*MailSendRequest)(nil),          // 74: protocol.MailSendRequest
+	(*MailSendResponse)(nil),         // 75: protocol.MailSendResponse
+	(*MailDeleteRequest)(nil),        // 76: protocol.MailDeleteRequest
+	(*MailDeleteResponse)(nil),       // 77: protocol.MailDeleteResponse
+	(*MailReceiveRequest)(nil),       // 78: protocol.MailReceiveRequest
+	(*MailReceiveResponse)(nil),      // 79: protocol.MailReceiveResponse
+	(*TaskGetListRequest)(nil),       // 80: protocol.TaskGetListRequest
+	(*TaskGetListResponse)(nil),      // 81: protocol.TaskGetListResponse
+	(*TaskGetDetailRequest)(nil),     // 82: protocol.TaskGetDetailRequest
+	(*TaskGetDetailResponse)(nil),    // 83: protocol.TaskGetDetailResponse
+	(*TaskAcceptRequest)(nil),        // 84: protocol.TaskAcceptRequest
+	(*TaskAcceptResponse)(nil),       // 85: protocol.TaskAcceptResponse
+	(*TaskSubmitRequest)(nil),        // 86: protocol.TaskSubmitRequest
+	(*TaskSubmitResponse)(nil),       // 87: protocol.TaskSubmitResponse
+	(*TaskCancelRequest)(nil),        // 88: protocol.TaskCancelRequest
+	(*TaskCancelResponse)(nil),       // 89: protocol.TaskCancelResponse
+	(*SkillGetListRequest)(nil),      // 90: protocol.SkillGetListRequest
+	(*SkillGetListResponse)(nil),     // 91: protocol.SkillGetListResponse
+	(*SkillLearnRequest)(nil),        // 92: protocol.SkillLearnRequest
+	(*SkillLearnResponse)(nil),       // 93: protocol.SkillLearnResponse
+	(*SkillUpgradeRequest)(nil),      // 94: protocol.SkillUpgradeRequest
+	(*SkillUpgradeResponse)(nil),     // 95: protocol.SkillUpgradeResponse
+	(*SkillUseRequest)(nil),          // 96: protocol.SkillUseRequest
+	(*SkillUseResponse)(nil),         // 97: protocol.SkillUseResponse
+	(*MapGetPathResponse_Point)(nil), // 98: protocol.MapGetPathResponse.Point
 }
 var file_resources_protocol_game_proto_depIdxs = []int32{
-	11, // 0: protocol.AccountLoginResponse.players:type_name -> protocol.PlayerInfo
-	11, // 1: protocol.PlayerCreateResponse.player:type_name -> protocol.PlayerInfo
-	21, // 2: protocol.PlayerGetInfoResponse.player_info:type_name -> protocol.PlayerBasicInfo
-	22, // 3: protocol.TaskInfo.rewards:type_name -> protocol.ItemInfo
-	22, // 4: protocol.MailInfo.items:type_name -> protocol.ItemInfo
-	37, // 5: protocol.MapPathResponse.path:type_name -> protocol.MapPathResponse.Point
-	31, // 6: protocol.MapSyncObjects.objects:type_name -> protocol.MapObjectInfo
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	13, // 0: protocol.AccountLoginResponse.players:type_name -> protocol.PlayerInfo
+	13, // 1: protocol.PlayerCreateResponse.player:type_name -> protocol.PlayerInfo
+	13, // 2: protocol.PlayerRestoreResponse.player:type_name -> protocol.PlayerInfo
+	40, // 3: protocol.PlayerGetInfoResponse.player_info:type_name -> protocol.PlayerBasicInfo
+	2,  // 4: protocol.KickNotify.reason:type_name -> protocol.KickReason
+	41, // 5: protocol.TaskInfo.rewards:type_name -> protocol.ItemInfo
+	41, // 6: protocol.MailInfo.items:type_name -> protocol.ItemInfo
+	98, // 7: protocol.MapGetPathResponse.path:type_name -> protocol.MapGetPathResponse.Point
+	50, // 8: protocol.MapSyncObjects.objects:type_name -> protocol.MapObjectInfo
+	41, // 9: protocol.InventoryGetResponse.items:type_name -> protocol.ItemInfo
+	41, // 10: protocol.InventorySortResponse.items:type_name -> protocol.ItemInfo
+	41, // 11: protocol.EquipmentGetResponse.equipments:type_name -> protocol.ItemInfo
+	41, // 12: protocol.EquipmentEquipResponse.equipment:type_name -> protocol.ItemInfo
+	44, // 13: protocol.MailGetListResponse.mails:type_name -> protocol.MailInfo
+	44, // 14: protocol.MailGetDetailResponse.mail:type_name -> protocol.MailInfo
+	41, // 15: protocol.MailReceiveResponse.items:type_name -> protocol.ItemInfo
+	42, // 16: protocol.TaskGetListResponse.tasks:type_name -> protocol.TaskInfo
+	42, // 17: protocol.TaskGetDetailResponse.task:type_name -> protocol.TaskInfo
+	42, // 18: protocol.TaskAcceptResponse.task:type_name -> protocol.TaskInfo
+	41, // 19: protocol.TaskSubmitResponse.items:type_name -> protocol.ItemInfo
+	43, // 20: protocol.SkillGetListResponse.skills:type_name -> protocol.SkillInfo
+	43, // 21: protocol.SkillLearnResponse.skill:type_name -> protocol.SkillInfo
+	43, // 22: protocol.SkillUpgradeResponse.skill:type_name -> protocol.SkillInfo
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_resources_protocol_game_proto_init() }
@@ -3450,8 +7439,8 @@ func file_resources_protocol_game_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_protocol_game_proto_rawDesc), len(file_resources_protocol_game_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   32,
+			NumEnums:      8,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
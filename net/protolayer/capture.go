@@ -0,0 +1,206 @@
+package protolayer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/capture"
+	"go.uber.org/zap"
+)
+
+// CaptureRedactor 录制前处理上行消息体（如替换密码），返回写入录制文件的消息体
+// version为请求的协议版本，决定消息体是否带请求序号前缀
+type CaptureRedactor func(version int32, data []byte) []byte
+
+// CaptureSeeder 获取会话当前登录账号的数据，会话未登录账号时返回nil
+// 用于在会话中途开启录制时补写账号数据
+type CaptureSeeder func(sessionId zNet.SessionIdType) *capture.Seed
+
+var (
+	captureRedactors   = make(map[int32]CaptureRedactor)
+	captureSeeder      CaptureSeeder
+	captureRedactorsMu sync.RWMutex
+)
+
+// SetCaptureRedactor 设置消息ID的录制脱敏函数
+func SetCaptureRedactor(protoId int32, redactor CaptureRedactor) {
+	captureRedactorsMu.Lock()
+	defer captureRedactorsMu.Unlock()
+	captureRedactors[protoId] = redactor
+}
+
+// SetCaptureSeeder 设置中途开启录制时获取账号数据的函数
+func SetCaptureSeeder(seeder CaptureSeeder) {
+	captureRedactorsMu.Lock()
+	defer captureRedactorsMu.Unlock()
+	captureSeeder = seeder
+}
+
+// sessionCaptureSeed 获取会话当前登录账号的数据，未设置获取函数或会话未登录账号时返回nil
+func sessionCaptureSeed(sessionId zNet.SessionIdType) *capture.Seed {
+	captureRedactorsMu.RLock()
+	seeder := captureSeeder
+	captureRedactorsMu.RUnlock()
+	if seeder == nil {
+		return nil
+	}
+	return seeder(sessionId)
+}
+
+// redactInbound 对上行消息体脱敏
+func redactInbound(protoId int32, version int32, data []byte) []byte {
+	captureRedactorsMu.RLock()
+	redactor := captureRedactors[protoId]
+	captureRedactorsMu.RUnlock()
+	if redactor == nil {
+		return data
+	}
+	return redactor(version, data)
+}
+
+// StartCapture 开始录制会话的上下行消息
+// 已在录制时返回当前录制文件
+// 参数:
+//   - account: 账号名（按账号开启时填写，用于录制文件命名）
+//
+// 返回:
+//   - string: 录制文件路径
+//   - error: 创建录制文件错误
+func (s *LayeredSession) StartCapture(account string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.recorder != nil {
+		return s.recorder.Path(), nil
+	}
+	recorder, err := capture.NewWriter(config.GetCaptureConfig().Dir, capture.Header{
+		SessionId: s.GetSid(),
+		Account:   account,
+		StartTime: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	s.recorder = recorder
+	zLog.Info("Session capture started",
+		zap.Uint64("sessionId", s.GetSid()),
+		zap.String("account", account),
+		zap.String("file", recorder.Path()))
+	return recorder.Path(), nil
+}
+
+// StopCapture 停止录制
+func (s *LayeredSession) StopCapture() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopCaptureLocked()
+}
+
+// stopCaptureLocked 关闭录制文件
+// 注意: 调用前必须持有锁
+func (s *LayeredSession) stopCaptureLocked() {
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.Close(); err != nil {
+		zLog.Warn("Failed to close capture file", zap.String("file", s.recorder.Path()), zap.Error(err))
+	}
+	zLog.Info("Session capture stopped", zap.Uint64("sessionId", s.GetSid()), zap.String("file", s.recorder.Path()))
+	s.recorder = nil
+}
+
+// IsCapturing 是否正在录制
+func (s *LayeredSession) IsCapturing() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.recorder != nil
+}
+
+// RecordInbound 补录已还原的上行数据包
+// 用于在处理请求时才开启录制的场景（如按账号录制时的登录请求）
+func (s *LayeredSession) RecordInbound(packet *zNet.NetPacket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recordLocked(capture.DirectionIn, packet.ProtoId, packet.Version, redactInbound(packet.ProtoId, packet.Version, packet.Data))
+}
+
+// RecordSeed 写入录制开始时的账号数据
+func (s *LayeredSession) RecordSeed(seed *capture.Seed) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.WriteSeed(seed); err != nil {
+		zLog.Warn("Failed to write capture seed", zap.Uint64("sessionId", s.GetSid()), zap.Error(err))
+	}
+}
+
+// recordLocked 写入一条录制消息，写入失败时停止录制
+// 注意: 调用前必须持有锁
+func (s *LayeredSession) recordLocked(dir capture.Direction, protoId, version int32, data []byte) {
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.Write(dir, protoId, version, data); err != nil {
+		zLog.Warn("Failed to write capture record, stopping capture", zap.Uint64("sessionId", s.GetSid()), zap.Error(err))
+		s.stopCaptureLocked()
+	}
+}
+
+// SetSessionCapture 开启或停止指定会话的录制
+// 开启时会话已登录账号的，写入当前的账号数据供重放时初始化数据库
+// 参数:
+//   - sessionId: 会话ID
+//   - enable: 是否开启
+//
+// 返回:
+//   - string: 录制文件路径（停止时为空）
+//   - error: 会话不存在或创建录制文件错误
+func SetSessionCapture(sessionId zNet.SessionIdType, enable bool) (string, error) {
+	layered, exists := layeredSessions.Load(sessionId)
+	if !exists {
+		return "", ErrSessionNotFound
+	}
+	if !enable {
+		layered.StopCapture()
+		return "", nil
+	}
+	if layered.IsCapturing() {
+		return layered.StartCapture("")
+	}
+	file, err := layered.StartCapture("")
+	if err != nil {
+		return "", err
+	}
+	// 会话已登录账号时补写账号数据，登录请求本身未录制
+	if seed := sessionCaptureSeed(sessionId); seed != nil {
+		layered.RecordSeed(seed)
+	}
+	return file, nil
+}
+
+// StopAccountCapture 停止按账号开启的会话录制
+// 返回: 停止录制的会话数
+func StopAccountCapture(account string) int {
+	var sessions []*LayeredSession
+	layeredSessions.Range(func(sid zNet.SessionIdType, session *LayeredSession) bool {
+		sessions = append(sessions, session)
+		return true
+	})
+
+	stopped := 0
+	for _, session := range sessions {
+		session.mu.Lock()
+		if session.recorder != nil && session.recorder.Account() == account {
+			session.stopCaptureLocked()
+			stopped++
+		}
+		session.mu.Unlock()
+	}
+	return stopped
+}
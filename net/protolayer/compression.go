@@ -1,6 +1,8 @@
 package protolayer
 
 import (
+	"time"
+
 	"github.com/golang/snappy"
 	"github.com/pzqf/zGameServer/config"
 )
@@ -8,6 +10,10 @@ import (
 // CompressThreshold 压缩阈值
 const CompressThreshold = 1024 // 1KB
 
+// MaxDecompressedSize 解压后消息体的最大字节数
+// 解压前先检查snappy头中记录的长度，避免很小的恶意数据解压出超大内存
+const MaxDecompressedSize = 4 * 1024 * 1024 // 4MB
+
 // CompressionLevel 压缩级别
 const (
 	CompressionLevelNone = 0 // 不压缩
@@ -22,8 +28,13 @@ type CompressionConfig struct {
 	Level           int  // 压缩级别
 	NetworkQuality  int  // 网络质量评估 (0-100)
 	MaxCompressSize int  // 最大压缩大小
+	MinQuality      int  // 网络质量评估下限
+	MaxQuality      int  // 网络质量评估上限
 }
 
+// CompressionSnappy 登录时协商的压缩算法名
+const CompressionSnappy = "snappy"
+
 // NewCompressionConfig 创建默认压缩配置
 func NewCompressionConfig() *CompressionConfig {
 	// 从配置中读取压缩配置
@@ -34,6 +45,8 @@ func NewCompressionConfig() *CompressionConfig {
 		Level:           cfg.Level,
 		NetworkQuality:  80,          // 默认网络质量良好
 		MaxCompressSize: 1024 * 1024, // 最大压缩1MB
+		MinQuality:      cfg.MinQuality,
+		MaxQuality:      cfg.MaxQuality,
 	}
 }
 
@@ -73,7 +86,16 @@ func Compress(data []byte, config *CompressionConfig) ([]byte, bool, error) {
 }
 
 // Decompress 解压数据
+// 解压后超过MaxDecompressedSize时返回ErrDecompressedTooLarge
 func Decompress(data []byte) ([]byte, error) {
+	size, err := snappy.DecodedLen(data)
+	if err != nil {
+		return data, err
+	}
+	if size > MaxDecompressedSize {
+		return data, ErrDecompressedTooLarge
+	}
+
 	decompressed, err := snappy.Decode(nil, data)
 	if err != nil {
 		return data, err
@@ -81,3 +103,67 @@ func Decompress(data []byte) ([]byte, error) {
 
 	return decompressed, nil
 }
+
+// SelectCompression 从客户端支持的压缩算法中选择服务器使用的算法
+// 压缩标志位于包头，只有能按数据包写出的连接（见PacketSender）才会启用压缩
+// 参数:
+//   - session: 登录的连接
+//   - algorithms: 客户端支持的压缩算法
+//
+// 返回: 选定的算法名，服务器未启用压缩、连接无法写出压缩标志或没有共同算法时返回空字符串
+func SelectCompression(session *LayeredSession, algorithms []string) string {
+	if !config.GetCompressionConfig().Enabled || !session.CanCompress() {
+		return ""
+	}
+	if containsString(algorithms, CompressionSnappy) {
+		return CompressionSnappy
+	}
+	return ""
+}
+
+// EstimateNetworkQuality 根据往返时延与丢包率估算网络质量 (0-100)
+// 参数:
+//   - rtt: 往返时延
+//   - lossRate: 丢包率（0-1）
+func EstimateNetworkQuality(rtt time.Duration, lossRate float64) int {
+	quality := 100
+	// 50ms以内视为良好，之后每10ms扣1分
+	if ms := int(rtt / time.Millisecond); ms > 50 {
+		quality -= (ms - 50) / 10
+	}
+	// 每1%丢包扣5分
+	quality -= int(lossRate * 500)
+
+	if quality < 0 {
+		return 0
+	}
+	return quality
+}
+
+// compressPayload 按压缩配置压缩下行消息体
+// 返回: 写出的消息体，以及是否已压缩（需在包头设置压缩标志）
+func compressPayload(data []byte, config *CompressionConfig) ([]byte, bool) {
+	compressed, ok, err := Compress(data, config)
+	if err != nil || !ok {
+		return data, false
+	}
+	if globalNetworkMetrics != nil {
+		globalNetworkMetrics.RecordCompression(len(data), len(compressed))
+	}
+	return compressed, true
+}
+
+// decompressPayload 解压包头标记为压缩的上行消息体
+func decompressPayload(data []byte) ([]byte, error) {
+	raw, err := Decompress(data)
+	if err != nil {
+		if globalNetworkMetrics != nil {
+			globalNetworkMetrics.IncCompressionErrors()
+		}
+		return nil, err
+	}
+	if globalNetworkMetrics != nil {
+		globalNetworkMetrics.IncDecompressedPackets()
+	}
+	return raw, nil
+}
@@ -8,4 +8,35 @@ var (
 	ErrInvalidProtobufMessage = errors.New("invalid protobuf message")
 	ErrProtocolEncodeFailed   = errors.New("protocol encode failed")
 	ErrProtocolDecodeFailed   = errors.New("protocol decode failed")
+	ErrRequestTooShort        = errors.New("request too short")
+)
+
+// 传输加密错误定义
+var (
+	ErrSecurityDisabled     = errors.New("net security disabled")
+	ErrHandshakeDone        = errors.New("handshake already completed")
+	ErrUnsupportedCipher    = errors.New("unsupported cipher")
+	ErrInvalidPublicKey     = errors.New("invalid public key")
+	ErrInvalidSigningKey    = errors.New("invalid ed25519 signing key")
+	ErrSigningKeyUnset      = errors.New("handshake signing key not loaded")
+	ErrSecurePacketTooShort = errors.New("secure packet too short")
+	ErrSecureReplay         = errors.New("secure packet replayed")
+	ErrSecureOpenFailed     = errors.New("secure packet authentication failed")
+)
+
+// 发送队列错误定义
+var (
+	ErrSlowConsumer = errors.New("send queue exceeded budget")
+	ErrInvalidBatch = errors.New("invalid batch packet")
+)
+
+// 流量录制错误定义
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// 压缩错误定义
+var (
+	ErrCompressionNotNegotiated = errors.New("compressed packet before compression negotiated")
+	ErrDecompressedTooLarge     = errors.New("decompressed packet too large")
 )
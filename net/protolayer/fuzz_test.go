@@ -0,0 +1,144 @@
+package protolayer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// fuzzCaptureDir 种子语料使用的录制文件目录，放入线上录制的文件即可扩充语料
+const fuzzCaptureDir = "../../testdata/captures"
+
+// addCaptureSeeds 将录制文件中的上行消息加入种子语料
+func addCaptureSeeds(f *testing.F, add func(record capture.Record)) {
+	records, err := capture.LoadInbound(fuzzCaptureDir)
+	if err != nil {
+		f.Fatalf("load captures: %v", err)
+	}
+	for _, record := range records {
+		add(record)
+	}
+}
+
+// 解码任意消息体：各协议的Decode与按消息ID解码为请求类型均不应panic
+func FuzzDecodeMessage(f *testing.F) {
+	protocols := []Protocol{NewProtobufProtocol(), NewJSONProtocol(), NewXMLProtocol()}
+
+	addCaptureSeeds(f, func(record capture.Record) {
+		f.Add(record.ProtoId, false, record.Data)
+		f.Add(record.ProtoId, true, snappy.Encode(nil, record.Data))
+
+		// 同一请求的JSON编码，覆盖JSON协议的解码路径
+		info := msgreg.Get(record.ProtoId)
+		if info == nil || !info.IsRequest() {
+			return
+		}
+		req := info.Request()
+		if err := DecodeMessage(protocols[0], &zNet.NetPacket{ProtoId: record.ProtoId, Version: record.Version, Data: record.Data}, req); err != nil {
+			return
+		}
+		if body, err := protocols[1].Marshal(req); err == nil {
+			prefix, _, _ := SplitRequest(record.Version, record.Data)
+			f.Add(record.ProtoId, false, append(prefix[:len(prefix):len(prefix)], body...))
+		}
+	})
+
+	f.Fuzz(func(t *testing.T, protoId int32, compressed bool, data []byte) {
+		var req interface{} = &protocol.PingRequest{}
+		if info := msgreg.Get(protoId); info != nil && info.IsRequest() {
+			req = info.Request()
+		}
+
+		// 分别按不带与带请求序号的协议版本解码
+		for _, version := range []int32{0, ProtocolVersionCurrent} {
+			for _, p := range protocols {
+				packet := &zNet.NetPacket{ProtoId: protoId, Version: version, DataSize: int32(len(data)), Data: data}
+				if compressed {
+					packet.IsCompressed = 1
+				}
+				DecodeMessage(p, packet, req)
+			}
+		}
+	})
+}
+
+// 解压：任意输入还原时不panic且不超过解压上限，压缩后的消息体往返一致
+func FuzzDecompress(f *testing.F) {
+	f.Add([]byte{})
+	f.Add(snappy.Encode(nil, bytes.Repeat([]byte("a"), 2048)))
+	// 头部声明的长度远超上限
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0x0f})
+	addCaptureSeeds(f, func(record capture.Record) {
+		f.Add(record.Data)
+	})
+
+	cfg := &CompressionConfig{Enabled: true, Threshold: 1, NetworkQuality: 80, MaxCompressSize: 1024 * 1024}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if raw, err := decompressPayload(data); err == nil && len(raw) > MaxDecompressedSize {
+			t.Fatalf("decompressed %d bytes, limit %d", len(raw), MaxDecompressedSize)
+		}
+
+		payload, compressed := compressPayload(data, cfg)
+		if !compressed {
+			return
+		}
+		raw, err := decompressPayload(payload)
+		if err != nil {
+			t.Fatalf("decompress own payload failed: %v", err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("round trip mismatch: got %d bytes, want %d", len(raw), len(data))
+		}
+	})
+}
+
+// 合批消息：任意输入拆分时不panic，拆出的消息体不越界
+func FuzzSplitBatch(f *testing.F) {
+	q := newSendQueue(1024 * 1024)
+	addCaptureSeeds(f, func(record capture.Record) {
+		q.push(record.ProtoId, record.Data, SendPriorityNormal)
+	})
+	if _, batch := q.encode(); batch != nil {
+		f.Add(batch)
+	}
+	f.Add([]byte{0, 0, 0, 1})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		packets, err := SplitBatch(data)
+		if err != nil {
+			return
+		}
+		total := 0
+		for _, p := range packets {
+			total += len(p.Data)
+		}
+		if total > len(data) {
+			t.Fatalf("split %d bytes of bodies from %d bytes", total, len(data))
+		}
+	})
+}
+
+// 会话还原上行数据包：启用压缩后任意消息体与压缩标志不panic
+func FuzzSessionOpen(f *testing.F) {
+	addCaptureSeeds(f, func(record capture.Record) {
+		f.Add(record.ProtoId, false, record.Data)
+	})
+	seq := make([]byte, RequestSeqSize)
+	binary.BigEndian.PutUint32(seq, 1)
+	f.Add(int32(protocol.SystemMsgId_MSG_SYSTEM_PING), true, snappy.Encode(nil, seq))
+
+	session := &LayeredSession{Session: &recordSession{}, compression: NewCompressionConfig()}
+	f.Fuzz(func(t *testing.T, protoId int32, compressed bool, data []byte) {
+		packet := &zNet.NetPacket{ProtoId: protoId, DataSize: int32(len(data)), Data: data}
+		if compressed {
+			packet.IsCompressed = 1
+		}
+		session.Open(packet)
+	})
+}
@@ -4,6 +4,15 @@ import (
 	"encoding/json"
 
 	"github.com/pzqf/zEngine/zNet"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// protojson编解码选项
+// 字段名沿用proto定义（下划线风格），忽略客户端多传的未知字段
+var (
+	jsonMarshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	jsonUnmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
 )
 
 // JSONProtocol JSON协议实现
@@ -17,7 +26,7 @@ func NewJSONProtocol() *JSONProtocol {
 // Encode 将应用层消息编码为JSON格式
 func (jp *JSONProtocol) Encode(protoId int32, version int32, data interface{}) (*zNet.NetPacket, error) {
 	// 序列化JSON数据
-	body, err := json.Marshal(data)
+	body, err := jp.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
@@ -38,3 +47,20 @@ func (jp *JSONProtocol) Decode(packet *zNet.NetPacket) (interface{}, error) {
 	// 这里只返回原始数据，实际使用时需要根据protoId解析为具体的消息类型
 	return packet.Data, nil
 }
+
+// Marshal 将应用层消息序列化为JSON消息体
+// Protobuf消息使用protojson，保证与proto字段定义一致
+func (jp *JSONProtocol) Marshal(data interface{}) ([]byte, error) {
+	if msg, ok := data.(proto.Message); ok {
+		return jsonMarshalOptions.Marshal(msg)
+	}
+	return json.Marshal(data)
+}
+
+// Unmarshal 将JSON消息体反序列化到指定的应用层消息
+func (jp *JSONProtocol) Unmarshal(data []byte, v interface{}) error {
+	if msg, ok := v.(proto.Message); ok {
+		return jsonUnmarshalOptions.Unmarshal(data, msg)
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,46 @@
+package protolayer
+
+import "time"
+
+// pingProbeWindow 统计丢包率的探测次数窗口，超过后计数减半，使估算跟随近期网络状况
+const pingProbeWindow = 20
+
+// pingProbe 服务器发起的网络探测
+// 每次回复客户端Ping时附带探测序号，客户端收到后立即回应，服务器据此测量往返时延；
+// 开始下一次探测时上一次仍未回应的计为丢失
+type pingProbe struct {
+	seq    uint32
+	sentAt time.Time // 未完成探测的发出时间（零值表示没有未完成的探测）
+	sent   int       // 窗口内的探测次数
+	lost   int       // 窗口内未回应的探测次数
+}
+
+// start 开始新的探测
+// 返回: 探测序号（从1开始）
+func (p *pingProbe) start(now time.Time) uint32 {
+	if !p.sentAt.IsZero() {
+		p.lost++
+	}
+	if p.sent >= pingProbeWindow {
+		p.sent /= 2
+		p.lost /= 2
+	}
+	p.sent++
+	p.seq++
+	if p.seq == 0 {
+		p.seq = 1
+	}
+	p.sentAt = now
+	return p.seq
+}
+
+// ack 处理客户端对探测的回应
+// 返回: 往返时延与窗口内的丢包率，序号与未完成的探测不符时ok为false
+func (p *pingProbe) ack(seq uint32, now time.Time) (rtt time.Duration, lossRate float64, ok bool) {
+	if p.sentAt.IsZero() || seq != p.seq {
+		return 0, 0, false
+	}
+	rtt = now.Sub(p.sentAt)
+	p.sentAt = time.Time{}
+	return rtt, float64(p.lost) / float64(p.sent), true
+}
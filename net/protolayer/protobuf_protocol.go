@@ -136,3 +136,37 @@ func (pp *ProtobufProtocol) Decode(packet *zNet.NetPacket) (interface{}, error)
 	// 例如：使用消息注册表根据protoId创建对应的消息实例，然后进行Unmarshal
 	return data, nil
 }
+
+// Marshal 将Protobuf消息序列化为消息体
+func (pp *ProtobufProtocol) Marshal(data interface{}) ([]byte, error) {
+	msg, ok := data.(proto.Message)
+	if !ok {
+		if globalNetworkMetrics != nil {
+			globalNetworkMetrics.IncEncodingErrors()
+		}
+		return nil, ErrInvalidProtobufMessage
+	}
+
+	body, err := proto.Marshal(msg)
+	if err != nil && globalNetworkMetrics != nil {
+		globalNetworkMetrics.IncEncodingErrors()
+	}
+	return body, err
+}
+
+// Unmarshal 将消息体反序列化为Protobuf消息
+func (pp *ProtobufProtocol) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		if globalNetworkMetrics != nil {
+			globalNetworkMetrics.IncDecodingErrors()
+		}
+		return ErrInvalidProtobufMessage
+	}
+
+	err := proto.Unmarshal(data, msg)
+	if err != nil && globalNetworkMetrics != nil {
+		globalNetworkMetrics.IncDecodingErrors()
+	}
+	return err
+}
@@ -13,6 +13,10 @@ type Protocol interface {
 	Encode(protoId int32, version int32, data interface{}) (*zNet.NetPacket, error)
 	// Decode 将二进制数据解码为应用层消息
 	Decode(packet *zNet.NetPacket) (interface{}, error)
+	// Marshal 将应用层消息序列化为消息体
+	Marshal(data interface{}) ([]byte, error)
+	// Unmarshal 将消息体反序列化到指定的应用层消息
+	Unmarshal(data []byte, v interface{}) error
 }
 
 // ProtocolType 协议类型枚举
@@ -66,6 +70,32 @@ func NewProtocolByName(name string) (Protocol, error) {
 	return factory(), nil
 }
 
+// DecodeMessage 解码数据包并反序列化到指定的应用层消息
+// 按数据包的协议版本跳过消息体前的请求序号
+// 参数:
+//   - protocol: 协议实例
+//   - packet: 网络数据包
+//   - v: 目标消息（指针）
+//
+// 返回:
+//   - error: 解码错误
+func DecodeMessage(protocol Protocol, packet *zNet.NetPacket, v interface{}) error {
+	body, err := protocol.Decode(packet)
+	if err != nil {
+		return err
+	}
+
+	data, ok := body.([]byte)
+	if !ok {
+		return ErrProtocolDecodeFailed
+	}
+	_, payload, ok := SplitRequest(packet.Version, data)
+	if !ok {
+		return ErrRequestTooShort
+	}
+	return protocol.Unmarshal(payload, v)
+}
+
 // 初始化函数，注册默认协议
 func init() {
 	RegisterProtocol("protobuf", func() Protocol {
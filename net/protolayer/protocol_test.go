@@ -0,0 +1,103 @@
+package protolayer
+
+import (
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// 测试各协议对同一消息的序列化与反序列化
+func TestProtocolMarshalUnmarshal(t *testing.T) {
+	for _, name := range []string{"protobuf", "json", "xml"} {
+		p, err := NewProtocolByName(name)
+		if err != nil {
+			t.Fatalf("Failed to create protocol %s: %v", name, err)
+		}
+
+		req := &protocol.AccountLoginResponse{
+			Success:  true,
+			ErrorMsg: "ok",
+			Players: []*protocol.PlayerInfo{
+				{PlayerId: 1001, Name: "测试玩家", Level: 10},
+			},
+		}
+
+		data, err := p.Marshal(req)
+		if err != nil {
+			t.Fatalf("[%s] Marshal failed: %v", name, err)
+		}
+
+		// 请求消息体前带请求序号
+		data = append([]byte{0, 0, 0, 7}, data...)
+		packet := &zNet.NetPacket{ProtoId: 1002, Version: ProtocolVersionCurrent, DataSize: int32(len(data)), Data: data}
+		var resp protocol.AccountLoginResponse
+		if err := DecodeMessage(p, packet, &resp); err != nil {
+			t.Fatalf("[%s] DecodeMessage failed: %v", name, err)
+		}
+
+		// 不带请求序号的旧协议版本直接解码消息体
+		resp.Reset()
+		legacy := &zNet.NetPacket{ProtoId: 1002, DataSize: int32(len(data) - RequestSeqSize), Data: data[RequestSeqSize:]}
+		if err := DecodeMessage(p, legacy, &resp); err != nil || !resp.Success {
+			t.Fatalf("[%s] DecodeMessage without request seq failed: %v", name, err)
+		}
+
+		if !resp.Success || resp.ErrorMsg != "ok" {
+			t.Errorf("[%s] Unexpected response fields: success=%v, errorMsg=%s", name, resp.Success, resp.ErrorMsg)
+		}
+		if len(resp.Players) != 1 || resp.Players[0].PlayerId != 1001 || resp.Players[0].Name != "测试玩家" {
+			t.Errorf("[%s] Unexpected players: %v", name, resp.Players)
+		}
+	}
+}
+
+// 测试Protobuf协议拒绝非Protobuf消息
+func TestProtobufProtocolInvalidMessage(t *testing.T) {
+	p := NewProtobufProtocol()
+	if _, err := p.Marshal(struct{}{}); err != ErrInvalidProtobufMessage {
+		t.Errorf("Expected ErrInvalidProtobufMessage, got %v", err)
+	}
+}
+
+// 测试回复封装为Response并带回请求序号与错误码
+func TestEncodeResponse(t *testing.T) {
+	p := NewProtobufProtocol()
+	packet := &zNet.NetPacket{ProtoId: 1002, Version: ProtocolVersionCurrent, Data: []byte{0, 0, 1, 2}}
+
+	data, err := EncodeResponse(p, packet, protocol.ErrorCode_ERR_OK, &protocol.AccountLoginResponse{Success: true})
+	if err != nil {
+		t.Fatalf("EncodeResponse failed: %v", err)
+	}
+	var resp protocol.Response
+	if err := p.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if resp.MsgId != 1002 || resp.Seq != 258 || resp.Result != 0 || len(resp.Data) == 0 {
+		t.Fatalf("Unexpected response: %v", &resp)
+	}
+
+	data, _ = EncodeResponse(p, packet, protocol.ErrorCode_ERR_ACCOUNT_PASSWORD, nil)
+	resp.Reset()
+	if err := p.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if resp.Result != int32(protocol.ErrorCode_ERR_ACCOUNT_PASSWORD) || resp.ErrorMsg != "账号或密码错误" || len(resp.Data) != 0 {
+		t.Fatalf("Unexpected error response: %v", &resp)
+	}
+
+	// 不带请求序号的旧协议版本回复序号为0
+	if RequestSeq(&zNet.NetPacket{ProtoId: 1002, Data: []byte{0, 0, 1, 2}}) != 0 {
+		t.Fatalf("expected no request seq before version %d", ProtocolVersionRequestSeq)
+	}
+}
+
+// 测试错误码都有默认描述
+func TestErrorMessagesRegistered(t *testing.T) {
+	for value, name := range protocol.ErrorCode_name {
+		code := protocol.ErrorCode(value)
+		if _, ok := errorMessages[code]; !ok {
+			t.Errorf("Error code %s has no message", name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package protolayer
+
+import (
+	"encoding/binary"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+// RequestSeqSize 请求消息体前缀的请求序号长度（协议版本不低于ProtocolVersionRequestSeq时携带）
+const RequestSeqSize = 4
+
+// 错误码的默认描述，客户端应按错误码本地化
+var errorMessages = map[protocol.ErrorCode]string{
+	protocol.ErrorCode_ERR_OK:                 "",
+	protocol.ErrorCode_ERR_SERVER:             "服务器错误",
+	protocol.ErrorCode_ERR_INVALID_REQUEST:    "请求格式错误",
+	protocol.ErrorCode_ERR_STATE_NOT_ALLOWED:  "当前状态不允许该操作",
+	protocol.ErrorCode_ERR_HANDSHAKE_REQUIRED: "请先完成加密握手",
+	protocol.ErrorCode_ERR_SECURITY_DISABLED:  "服务器未启用加密",
+	protocol.ErrorCode_ERR_HANDSHAKE_DONE:     "已完成握手",
+	protocol.ErrorCode_ERR_UNSUPPORTED_CIPHER: "不支持的加密算法",
+	protocol.ErrorCode_ERR_HANDSHAKE_FAILED:   "握手失败",
+	protocol.ErrorCode_ERR_NOT_IMPLEMENTED:    "功能暂未开放",
+	protocol.ErrorCode_ERR_RATE_LIMITED:       "操作过于频繁，请稍后再试",
+	protocol.ErrorCode_ERR_SERVER_FULL:        "服务器已满，请稍后再试",
+	protocol.ErrorCode_ERR_NAME_LENGTH:        "名称长度不符合要求",
+	protocol.ErrorCode_ERR_NAME_CHARSET:       "名称包含不允许的字符",
+	protocol.ErrorCode_ERR_NAME_RESERVED:      "该名称不可使用",
+	protocol.ErrorCode_ERR_NAME_SENSITIVE:     "名称包含敏感词",
+	protocol.ErrorCode_ERR_NAME_TAKEN:         "名称已被使用",
+
+	protocol.ErrorCode_ERR_ACCOUNT_EMPTY:            "账号或密码不能为空",
+	protocol.ErrorCode_ERR_ACCOUNT_EXISTS:           "账号已存在",
+	protocol.ErrorCode_ERR_ACCOUNT_NOT_FOUND:        "账号不存在，请创建账号",
+	protocol.ErrorCode_ERR_ACCOUNT_PASSWORD:         "账号或密码错误",
+	protocol.ErrorCode_ERR_ACCOUNT_LOGGED_ELSEWHERE: "账号已在其他地方登录",
+	protocol.ErrorCode_ERR_ACCOUNT_NOT_LOGGED_IN:    "请先登录账号",
+	protocol.ErrorCode_ERR_RECONNECT_TOKEN_INVALID:  "重连令牌无效或已过期，请重新登录",
+	protocol.ErrorCode_ERR_CLIENT_VERSION_TOO_OLD:   "客户端版本过低，请更新后重试",
+	protocol.ErrorCode_ERR_ACCOUNT_BANNED:           "账号已被封禁",
+	protocol.ErrorCode_ERR_LOGIN_QUEUE_TIMEOUT:      "排队超时，请重新登录",
+	protocol.ErrorCode_ERR_LOGIN_QUEUED:             "正在排队中，请耐心等待",
+
+	protocol.ErrorCode_ERR_PLAYER_NAME_EMPTY:         "玩家名称不能为空",
+	protocol.ErrorCode_ERR_PLAYER_NOT_FOUND:          "玩家不存在",
+	protocol.ErrorCode_ERR_PLAYER_NOT_LOGGED_IN:      "玩家未登录",
+	protocol.ErrorCode_ERR_PLAYER_ALREADY_LOGGED_IN:  "角色已登录",
+	protocol.ErrorCode_ERR_PLAYER_LOGGED_ELSEWHERE:   "角色已在其他地方登录",
+	protocol.ErrorCode_ERR_PLAYER_LOGOUT_REQUIRED:    "请先登出当前角色",
+	protocol.ErrorCode_ERR_PLAYER_OFFLINE:            "玩家不在线",
+	protocol.ErrorCode_ERR_LEVEL_TOO_LOW:             "等级不足",
+	protocol.ErrorCode_ERR_PLAYER_BANNED:             "角色已被封禁",
+	protocol.ErrorCode_ERR_PLAYER_MUTED:              "已被禁言",
+	protocol.ErrorCode_ERR_PLAYER_TRADE_LOCKED:       "交易已被冻结",
+	protocol.ErrorCode_ERR_RENAME_CARD_REQUIRED:      "需要使用改名卡",
+	protocol.ErrorCode_ERR_PLAYER_GUILD_LEADER:       "公会会长不能删除角色，请先转让会长或解散公会",
+	protocol.ErrorCode_ERR_PLAYER_ACTIVE_AUCTION:     "有进行中的拍卖，不能删除角色",
+	protocol.ErrorCode_ERR_PLAYER_PENDING_DELETE:     "角色待删除，请先恢复角色",
+	protocol.ErrorCode_ERR_PLAYER_NOT_PENDING_DELETE: "角色未申请删除",
+	protocol.ErrorCode_ERR_PLAYER_RESTORE_EXPIRED:    "已超过角色恢复期限",
+
+	protocol.ErrorCode_ERR_ITEM_NOT_FOUND:      "物品不存在",
+	protocol.ErrorCode_ERR_ITEM_COUNT_INVALID:  "数量错误",
+	protocol.ErrorCode_ERR_INVENTORY_FULL:      "背包已满",
+	protocol.ErrorCode_ERR_EQUIP_SLOT_INVALID:  "装备位置错误",
+	protocol.ErrorCode_ERR_EQUIP_SLOT_MISMATCH: "该物品无法装备到此位置",
+	protocol.ErrorCode_ERR_EQUIP_SLOT_EMPTY:    "该位置没有装备",
+
+	protocol.ErrorCode_ERR_MAIL_NOT_FOUND:        "邮件不存在",
+	protocol.ErrorCode_ERR_MAIL_NO_ATTACHMENT:    "邮件没有附件",
+	protocol.ErrorCode_ERR_MAIL_TITLE_EMPTY:      "邮件标题不能为空",
+	protocol.ErrorCode_ERR_MAIL_RECEIVER_OFFLINE: "收件人不在线",
+
+	protocol.ErrorCode_ERR_TASK_NOT_FOUND:     "任务不存在",
+	protocol.ErrorCode_ERR_TASK_NOT_COMPLETED: "任务未完成",
+	protocol.ErrorCode_ERR_TASK_LIMIT:         "任务数量已达上限",
+	protocol.ErrorCode_ERR_TASK_ACCEPTED:      "任务已接受",
+	protocol.ErrorCode_ERR_TASK_CANNOT_CANCEL: "任务不存在或无法放弃",
+
+	protocol.ErrorCode_ERR_SKILL_NOT_FOUND:         "技能不存在",
+	protocol.ErrorCode_ERR_SKILL_LOCKED:            "技能未解锁",
+	protocol.ErrorCode_ERR_SKILL_LEARNED:           "技能已学习",
+	protocol.ErrorCode_ERR_SKILL_LIMIT:             "技能数量已达上限",
+	protocol.ErrorCode_ERR_SKILL_COOLDOWN:          "技能冷却中",
+	protocol.ErrorCode_ERR_SKILL_PASSIVE:           "被动技能无法使用",
+	protocol.ErrorCode_ERR_SKILL_UPGRADE_CONDITION: "不满足升级条件",
+}
+
+// ErrorMessage 获取错误码的默认描述
+func ErrorMessage(code protocol.ErrorCode) string {
+	if msg, ok := errorMessages[code]; ok {
+		return msg
+	}
+	return errorMessages[protocol.ErrorCode_ERR_SERVER]
+}
+
+// RequestSeq 获取请求数据包的请求序号
+// 协议版本不带请求序号或消息体不足序号长度时返回0
+func RequestSeq(packet *zNet.NetPacket) uint32 {
+	prefix, _, _ := SplitRequest(packet.Version, packet.Data)
+	if prefix == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(prefix)
+}
+
+// EncodeResponse 将请求的回复编码为统一的Response消息体
+// 参数:
+//   - p: 协议实例
+//   - packet: 请求数据包（提供消息ID与请求序号）
+//   - code: 错误码，ERR_OK表示成功
+//   - v: 业务响应消息（失败时可为空）
+//
+// 返回:
+//   - []byte: Response消息体
+//   - error: 编码错误
+func EncodeResponse(p Protocol, packet *zNet.NetPacket, code protocol.ErrorCode, v interface{}) ([]byte, error) {
+	resp := &protocol.Response{
+		MsgId:    uint32(packet.ProtoId),
+		Result:   int32(code),
+		ErrorMsg: ErrorMessage(code),
+		Seq:      RequestSeq(packet),
+	}
+	if v != nil {
+		data, err := p.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		resp.Data = data
+	}
+	return p.Marshal(resp)
+}
@@ -0,0 +1,304 @@
+package protolayer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/config"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// secureSeqSize 加密消息体前缀的序号长度
+const secureSeqSize = 8
+
+// 密钥派生用途标识，区分两个方向的密钥
+const (
+	secureInfoClientToServer = "zgameserver c2s"
+	secureInfoServerToClient = "zgameserver s2c"
+)
+
+// handshakeSignContext 握手签名内容的用途标识，防止签名被挪用到其他场景
+const handshakeSignContext = "zgameserver handshake v1"
+
+// secureDirection 单方向的加密状态
+// 每条消息体为 序号(8字节,大端) + AEAD密文，序号同时作为nonce并要求严格递增以防重放
+type secureDirection struct {
+	aead cipher.AEAD
+	seq  uint64
+}
+
+// nonce 由序号构造nonce（高位补零）
+func (d *secureDirection) nonce(seq uint64) []byte {
+	nonce := make([]byte, d.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-secureSeqSize:], seq)
+	return nonce
+}
+
+// additionalData 以消息ID与包头压缩标志作为附加认证数据，防止密文被挪用到其他消息或篡改压缩标志
+func additionalData(protoId int32, compressed int32) []byte {
+	var ad [5]byte
+	binary.BigEndian.PutUint32(ad[:], uint32(protoId))
+	if compressed != 0 {
+		ad[4] = 1
+	}
+	return ad[:]
+}
+
+// seal 加密消息体
+func (d *secureDirection) seal(protoId int32, compressed int32, plaintext []byte) []byte {
+	d.seq++
+	out := make([]byte, secureSeqSize, secureSeqSize+len(plaintext)+d.aead.Overhead())
+	binary.BigEndian.PutUint64(out, d.seq)
+	return d.aead.Seal(out, d.nonce(d.seq), plaintext, additionalData(protoId, compressed))
+}
+
+// open 解密消息体并校验序号
+func (d *secureDirection) open(protoId int32, compressed int32, data []byte) ([]byte, error) {
+	if len(data) < secureSeqSize+d.aead.Overhead() {
+		return nil, ErrSecurePacketTooShort
+	}
+	seq := binary.BigEndian.Uint64(data)
+	if seq <= d.seq {
+		return nil, ErrSecureReplay
+	}
+	plaintext, err := d.aead.Open(nil, d.nonce(seq), data[secureSeqSize:], additionalData(protoId, compressed))
+	if err != nil {
+		return nil, ErrSecureOpenFailed
+	}
+	d.seq = seq
+	return plaintext, nil
+}
+
+// SecurityLayer 传输加密层
+// 处理密钥交换并派生双向密钥，加解密由连接对应的LayeredSession完成；
+// 服务器临时公钥由静态签名私钥签名，客户端以预置的服务器签名公钥校验，防止中间人替换公钥
+type SecurityLayer struct {
+	config     *config.NetSecurityConfig
+	signingKey ed25519.PrivateKey // 服务器静态签名私钥
+}
+
+// HandshakeResult 握手结果，以明文回复给客户端
+type HandshakeResult struct {
+	PublicKey []byte // 服务器X25519临时公钥
+	Signature []byte // 签名私钥对握手内容的签名
+	Cipher    string // 选定的AEAD算法
+}
+
+var (
+	securityLayer     *SecurityLayer
+	securityLayerOnce sync.Once
+)
+
+// GetSecurityLayer 获取全局传输加密层（首次调用时按配置创建）
+func GetSecurityLayer() *SecurityLayer {
+	securityLayerOnce.Do(func() {
+		securityLayer = NewSecurityLayer(config.GetNetSecurityConfig(), nil)
+	})
+	return securityLayer
+}
+
+// InitSecurityLayer 启用加密时为全局传输加密层加载签名私钥，需在网络服务启动前调用
+// 签名公钥随日志输出，需预置到客户端
+func InitSecurityLayer() error {
+	layer := GetSecurityLayer()
+	if !layer.config.Enabled {
+		return nil
+	}
+	signingKey, err := LoadSigningKey(layer.config.SigningKeyFile)
+	if err != nil {
+		return err
+	}
+	layer.signingKey = signingKey
+
+	zLog.Info("Net security signing key loaded",
+		zap.String("publicKey", hex.EncodeToString(signingKey.Public().(ed25519.PublicKey))))
+	return nil
+}
+
+// NewSecurityLayer 创建传输加密层
+// 参数:
+//   - cfg: 传输加密配置
+//   - signingKey: 服务器静态签名私钥，为nil时握手失败
+func NewSecurityLayer(cfg *config.NetSecurityConfig, signingKey ed25519.PrivateKey) *SecurityLayer {
+	return &SecurityLayer{
+		config:     cfg,
+		signingKey: signingKey,
+	}
+}
+
+// LoadSigningKey 读取PEM格式（PKCS#8）的Ed25519签名私钥
+// 可用 openssl genpkey -algorithm ed25519 -out <文件> 生成
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidSigningKey
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signingKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidSigningKey
+	}
+	return signingKey, nil
+}
+
+// handshakeTranscript 握手签名内容：用途标识 + 客户端公钥 + 服务器临时公钥 + 选定的AEAD算法
+func handshakeTranscript(clientPublicKey, serverPublicKey []byte, cipherName string) []byte {
+	transcript := make([]byte, 0, len(handshakeSignContext)+len(clientPublicKey)+len(serverPublicKey)+len(cipherName))
+	transcript = append(transcript, handshakeSignContext...)
+	transcript = append(transcript, clientPublicKey...)
+	transcript = append(transcript, serverPublicKey...)
+	return append(transcript, cipherName...)
+}
+
+// VerifyHandshake 客户端以预置的服务器签名公钥校验握手响应，校验失败时须断开连接
+// 参数:
+//   - serverSigningKey: 预置的服务器签名公钥
+//   - clientPublicKey: 客户端发送的X25519临时公钥
+//   - result: 服务器的握手响应
+//
+// 返回: 签名是否有效
+func VerifyHandshake(serverSigningKey ed25519.PublicKey, clientPublicKey []byte, result *HandshakeResult) bool {
+	if len(serverSigningKey) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(serverSigningKey, handshakeTranscript(clientPublicKey, result.PublicKey, result.Cipher), result.Signature)
+}
+
+// IsEnabled 是否允许加密握手
+func (l *SecurityLayer) IsEnabled() bool {
+	return l.config.Enabled
+}
+
+// IsRequired 是否强制加密
+func (l *SecurityLayer) IsRequired() bool {
+	return l.config.Enabled && l.config.Required
+}
+
+// Handshake 以客户端临时公钥完成X25519密钥交换
+// 密钥经HKDF-SHA256按方向派生，服务器临时公钥连同客户端公钥与选定算法由签名私钥签名；
+// 调用方先以明文回复握手结果，再调用返回的函数启用加密
+// 参数:
+//   - session: 连接会话
+//   - clientPublicKey: 客户端X25519公钥
+//   - ciphers: 客户端支持的AEAD算法（为空表示接受服务器配置）
+//
+// 返回:
+//   - *HandshakeResult: 握手结果
+//   - func(): 启用加密的函数
+//   - error: 握手错误
+func (l *SecurityLayer) Handshake(session *LayeredSession, clientPublicKey []byte, ciphers []string) (*HandshakeResult, func(), error) {
+	if !l.config.Enabled {
+		return nil, nil, ErrSecurityDisabled
+	}
+	if l.signingKey == nil {
+		return nil, nil, ErrSigningKeyUnset
+	}
+	if session.IsSecure() {
+		return nil, nil, ErrHandshakeDone
+	}
+
+	cipherName := l.config.Cipher
+	if len(ciphers) > 0 && !containsString(ciphers, cipherName) {
+		return nil, nil, ErrUnsupportedCipher
+	}
+
+	curve := ecdh.X25519()
+	clientKey, err := curve.NewPublicKey(clientPublicKey)
+	if err != nil {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	serverKey, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, err := serverKey.ECDH(clientKey)
+	if err != nil {
+		return nil, nil, ErrInvalidPublicKey
+	}
+
+	serverPublicKey := serverKey.PublicKey().Bytes()
+	salt := append(append([]byte(nil), clientPublicKey...), serverPublicKey...)
+	recv, err := newSecureDirection(cipherName, secret, salt, secureInfoClientToServer)
+	if err != nil {
+		return nil, nil, err
+	}
+	send, err := newSecureDirection(cipherName, secret, salt, secureInfoServerToClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := &HandshakeResult{
+		PublicKey: serverPublicKey,
+		Signature: ed25519.Sign(l.signingKey, handshakeTranscript(clientPublicKey, serverPublicKey, cipherName)),
+		Cipher:    cipherName,
+	}
+
+	activate := func() {
+		session.mu.Lock()
+		// 已排队的消息（含握手响应）以明文写出
+		session.writeQueuedLocked(time.Now())
+		session.recv = recv
+		session.send = send
+		session.mu.Unlock()
+	}
+	return result, activate, nil
+}
+
+// newSecureDirection 派生单方向密钥并创建AEAD
+func newSecureDirection(cipherName string, secret, salt []byte, info string) (*secureDirection, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(info)), key); err != nil {
+		return nil, err
+	}
+
+	var aead cipher.AEAD
+	switch cipherName {
+	case config.CipherAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if aead, err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
+	case config.CipherChaCha20Poly1305:
+		var err error
+		if aead, err = chacha20poly1305.New(key); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnsupportedCipher
+	}
+	return &secureDirection{aead: aead}, nil
+}
+
+// containsString 判断列表是否包含指定字符串
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
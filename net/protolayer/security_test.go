@@ -0,0 +1,127 @@
+package protolayer
+
+import (
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+)
+
+// recordSession 记录发送内容的测试会话
+type recordSession struct {
+	sent [][]byte
+}
+
+func (s *recordSession) GetSid() zNet.SessionIdType { return 1 }
+func (s *recordSession) Close()                     {}
+func (s *recordSession) Send(protoId int32, data []byte) error {
+	s.sent = append(s.sent, data)
+	return nil
+}
+
+// 测试握手后双向加解密与重放拦截
+func TestSecurityHandshakeAndReplay(t *testing.T) {
+	signingPublicKey, signingKey, _ := ed25519.GenerateKey(rand.Reader)
+	for _, cipherName := range []string{config.CipherAES256GCM, config.CipherChaCha20Poly1305} {
+		layer := NewSecurityLayer(&config.NetSecurityConfig{Enabled: true, Cipher: cipherName}, signingKey)
+		raw := &recordSession{}
+		secure := WrapSession(raw).(*LayeredSession)
+		if WrapSession(raw) != secure {
+			t.Fatalf("[%s] expected the same layered session for one connection", cipherName)
+		}
+
+		clientKey, _ := ecdh.X25519().GenerateKey(rand.Reader)
+		result, activate, err := layer.Handshake(secure, clientKey.PublicKey().Bytes(), nil)
+		if err != nil || result.Cipher != cipherName {
+			t.Fatalf("[%s] handshake failed: %v", cipherName, err)
+		}
+		activate()
+
+		// 客户端以预置的签名公钥校验服务器临时公钥，被替换的公钥或其他密钥的签名均校验失败
+		if !VerifyHandshake(signingPublicKey, clientKey.PublicKey().Bytes(), result) {
+			t.Fatalf("[%s] expected handshake signature to verify", cipherName)
+		}
+		mitmKey, _ := ecdh.X25519().GenerateKey(rand.Reader)
+		forged := *result
+		forged.PublicKey = mitmKey.PublicKey().Bytes()
+		if VerifyHandshake(signingPublicKey, clientKey.PublicKey().Bytes(), &forged) {
+			t.Fatalf("[%s] expected replaced server key to fail verification", cipherName)
+		}
+		otherPublicKey, _, _ := ed25519.GenerateKey(rand.Reader)
+		if VerifyHandshake(otherPublicKey, clientKey.PublicKey().Bytes(), result) {
+			t.Fatalf("[%s] expected verification with another signing key to fail", cipherName)
+		}
+
+		// 客户端按相同方式派生密钥
+		serverPublicKey := result.PublicKey
+		serverKey, _ := ecdh.X25519().NewPublicKey(serverPublicKey)
+		secret, _ := clientKey.ECDH(serverKey)
+		salt := append(clientKey.PublicKey().Bytes(), serverPublicKey...)
+		clientSend, _ := newSecureDirection(cipherName, secret, salt, secureInfoClientToServer)
+		clientRecv, _ := newSecureDirection(cipherName, secret, salt, secureInfoServerToClient)
+
+		sealed := clientSend.seal(1002, 0, []byte("password"))
+		packet := &zNet.NetPacket{ProtoId: 1002, Data: sealed}
+		if err := secure.Open(packet); err != nil || string(packet.Data) != "password" {
+			t.Fatalf("[%s] open failed: %v", cipherName, err)
+		}
+		if err := secure.Open(&zNet.NetPacket{ProtoId: 1002, Data: sealed}); err != ErrSecureReplay {
+			t.Fatalf("[%s] expected ErrSecureReplay, got %v", cipherName, err)
+		}
+		if err := secure.Open(&zNet.NetPacket{ProtoId: 1003, Data: clientSend.seal(1002, 0, []byte("x"))}); err != ErrSecureOpenFailed {
+			t.Fatalf("[%s] expected ErrSecureOpenFailed for mismatched cmd, got %v", cipherName, err)
+		}
+
+		if err := secure.Send(1002, []byte("ok")); err != nil {
+			t.Fatalf("[%s] send failed: %v", cipherName, err)
+		}
+		plaintext, err := clientRecv.open(1002, 0, raw.sent[0])
+		if err != nil || string(plaintext) != "ok" {
+			t.Fatalf("[%s] client open failed: %v", cipherName, err)
+		}
+
+		if _, _, err := layer.Handshake(secure, clientKey.PublicKey().Bytes(), nil); err != ErrHandshakeDone {
+			t.Fatalf("[%s] expected ErrHandshakeDone, got %v", cipherName, err)
+		}
+		RemoveSession(raw.GetSid())
+	}
+}
+
+// 测试签名私钥的加载，以及未加载私钥时拒绝握手
+func TestSigningKey(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "sign.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadSigningKey(path)
+	if err != nil || !publicKey.Equal(loaded.Public()) {
+		t.Fatalf("load signing key failed: %v", err)
+	}
+
+	ecKey, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	if err := os.WriteFile(path, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSigningKey(path); err != ErrInvalidSigningKey {
+		t.Fatalf("expected ErrInvalidSigningKey, got %v", err)
+	}
+
+	layer := NewSecurityLayer(&config.NetSecurityConfig{Enabled: true, Cipher: config.CipherAES256GCM}, nil)
+	raw := &recordSession{}
+	if _, _, err := layer.Handshake(WrapSession(raw).(*LayeredSession), ecKey.PublicKey().Bytes(), nil); err != ErrSigningKeyUnset {
+		t.Fatalf("expected ErrSigningKeyUnset, got %v", err)
+	}
+	RemoveSession(raw.GetSid())
+}
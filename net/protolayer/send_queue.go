@@ -0,0 +1,266 @@
+package protolayer
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+// SendPriority 下行消息优先级
+type SendPriority int
+
+const (
+	SendPriorityControl SendPriority = iota // 控制消息（踢下线、探测等），最先写出
+	SendPriorityNormal                      // 普通消息（默认，请求响应与业务推送）
+	SendPriorityBulk                        // 同步类消息，队列超出预算时最先丢弃
+	sendPriorityCount
+)
+
+// batchEntryHeadSize 合并消息中每条消息的头部长度：消息ID(4字节) + 长度(4字节)
+const batchEntryHeadSize = 8
+
+// batchProtoId 合并消息的消息ID
+var batchProtoId = int32(protocol.SystemMsgId_MSG_SYSTEM_BATCH)
+
+var (
+	sendPriorities   = make(map[int32]SendPriority)
+	sendPrioritiesMu sync.RWMutex
+)
+
+// SetSendPriority 声明下行消息的优先级
+// 参数:
+//   - protoId: 消息ID
+//   - priority: 优先级
+func SetSendPriority(protoId int32, priority SendPriority) {
+	sendPrioritiesMu.Lock()
+	defer sendPrioritiesMu.Unlock()
+
+	if priority == SendPriorityNormal {
+		delete(sendPriorities, protoId)
+		return
+	}
+	sendPriorities[protoId] = priority
+}
+
+// GetSendPriority 获取下行消息的优先级（未声明时为普通）
+func GetSendPriority(protoId int32) SendPriority {
+	sendPrioritiesMu.RLock()
+	defer sendPrioritiesMu.RUnlock()
+
+	if priority, exists := sendPriorities[protoId]; exists {
+		return priority
+	}
+	return SendPriorityNormal
+}
+
+// queuedPacket 排队中的下行消息
+type queuedPacket struct {
+	protoId int32
+	data    []byte
+}
+
+// sendQueue 单个会话的下行发送队列
+// 注意: 非并发安全，由所属会话加锁访问
+type sendQueue struct {
+	maxBytes     int
+	queues       [sendPriorityCount][]queuedPacket
+	queuedBytes  int
+	stalledSince time.Time // 开始无法写出的时间（零值表示写出正常）
+}
+
+// newSendQueue 创建下行发送队列
+func newSendQueue(maxBytes int) *sendQueue {
+	return &sendQueue{maxBytes: maxBytes}
+}
+
+// push 消息入队
+// 超出字节预算时先丢弃最早的同步类消息；仍然不足时丢弃同步类新消息，其他消息返回false表示对端消费过慢
+// 队列为空时总是接受，单条超过预算的大消息不视为积压
+func (q *sendQueue) push(protoId int32, data []byte, priority SendPriority) bool {
+	size := batchEntryHeadSize + len(data)
+	bulk := &q.queues[SendPriorityBulk]
+	for q.overBudget(size) && len(*bulk) > 0 {
+		q.queuedBytes -= batchEntryHeadSize + len((*bulk)[0].data)
+		*bulk = (*bulk)[1:]
+		q.recordDropped()
+	}
+
+	if q.overBudget(size) {
+		if priority == SendPriorityBulk {
+			q.recordDropped()
+			return true
+		}
+		return false
+	}
+
+	q.queues[priority] = append(q.queues[priority], queuedPacket{protoId: protoId, data: data})
+	q.queuedBytes += size
+	return true
+}
+
+// overBudget 再加入size字节后是否超出预算
+func (q *sendQueue) overBudget(size int) bool {
+	return q.queuedBytes > 0 && q.queuedBytes+size > q.maxBytes
+}
+
+// empty 队列是否为空
+func (q *sendQueue) empty() bool {
+	return q.queuedBytes == 0
+}
+
+// encode 将排队的消息按优先级编码为一次写出的内容
+// 只有一条消息时原样写出，否则合并为一条批量消息
+func (q *sendQueue) encode() (int32, []byte) {
+	var count int
+	var single queuedPacket
+	for _, packets := range q.queues {
+		count += len(packets)
+		if len(packets) > 0 {
+			single = packets[0]
+		}
+	}
+	if count == 1 {
+		return single.protoId, single.data
+	}
+
+	buf := make([]byte, 0, q.queuedBytes)
+	for _, packets := range q.queues {
+		for _, packet := range packets {
+			buf = binary.BigEndian.AppendUint32(buf, uint32(packet.protoId))
+			buf = binary.BigEndian.AppendUint32(buf, uint32(len(packet.data)))
+			buf = append(buf, packet.data...)
+		}
+	}
+	return batchProtoId, buf
+}
+
+// BatchedPacket 合并消息中的一条消息
+type BatchedPacket struct {
+	ProtoId int32
+	Data    []byte
+}
+
+// SplitBatch 拆分合并写出的消息体（MSG_SYSTEM_BATCH），供客户端与工具还原各条消息
+// 参数:
+//   - data: 合并消息体（已解密解压）
+//
+// 返回:
+//   - []BatchedPacket: 按写出顺序排列的消息
+//   - error: 消息体格式错误
+func SplitBatch(data []byte) ([]BatchedPacket, error) {
+	var packets []BatchedPacket
+	for len(data) > 0 {
+		if len(data) < batchEntryHeadSize {
+			return nil, ErrInvalidBatch
+		}
+		protoId := int32(binary.BigEndian.Uint32(data))
+		size := binary.BigEndian.Uint32(data[4:])
+		if uint64(len(data)-batchEntryHeadSize) < uint64(size) {
+			return nil, ErrInvalidBatch
+		}
+		packets = append(packets, BatchedPacket{
+			ProtoId: protoId,
+			Data:    data[batchEntryHeadSize : batchEntryHeadSize+int(size)],
+		})
+		data = data[batchEntryHeadSize+int(size):]
+	}
+	return packets, nil
+}
+
+// ReadPacket 从明文连接中读取一个完整的数据包，供客户端与工具使用
+// 参数:
+//   - r: 连接
+//
+// 返回:
+//   - *zNet.NetPacket: 数据包（合并消息未拆分）
+//   - error: 读取或包头解析错误
+func ReadPacket(r io.Reader) (*zNet.NetPacket, error) {
+	headBuf := make([]byte, zNet.NetPacketHeadSize)
+	if _, err := io.ReadFull(r, headBuf); err != nil {
+		return nil, err
+	}
+
+	packet := &zNet.NetPacket{}
+	if err := packet.UnmarshalHead(headBuf); err != nil {
+		return nil, err
+	}
+
+	if packet.DataSize > 0 {
+		packet.Data = make([]byte, packet.DataSize)
+		if _, err := io.ReadFull(r, packet.Data); err != nil {
+			return nil, err
+		}
+	}
+	return packet, nil
+}
+
+// ReadMessages 从明文连接中读取一个数据包，合并消息拆分为单条
+// 返回:
+//   - []BatchedPacket: 按写出顺序排列的消息（非合并消息时只有一条）
+//   - error: 读取错误或合并消息格式错误
+func ReadMessages(r io.Reader) ([]BatchedPacket, error) {
+	packet, err := ReadPacket(r)
+	if err != nil {
+		return nil, err
+	}
+	if packet.ProtoId != batchProtoId {
+		return []BatchedPacket{{ProtoId: packet.ProtoId, Data: packet.Data}}, nil
+	}
+	return SplitBatch(packet.Data)
+}
+
+// reset 清空队列
+func (q *sendQueue) reset() {
+	for i := range q.queues {
+		q.queues[i] = nil
+	}
+	q.queuedBytes = 0
+	q.stalledSince = time.Time{}
+}
+
+// recordDropped 记录丢弃的消息
+func (q *sendQueue) recordDropped() {
+	if globalNetworkMetrics != nil {
+		globalNetworkMetrics.IncDroppedPackets()
+	}
+}
+
+// SelectBatching 客户端登录时决定是否合并写出下行消息
+// 参数:
+//   - supported: 客户端能否拆分合并消息
+//
+// 返回: 服务器启用发送队列且客户端支持时返回true
+func SelectBatching(supported bool) bool {
+	return supported && config.GetSendQueueConfig().Enabled
+}
+
+var sendQueueFlusherOnce sync.Once
+
+// startSendQueueFlusher 启动发送队列刷新协程（只启动一次）
+// 每个刷新周期写出所有会话的排队消息，持续无法写出的会话作为慢消费者断开
+func startSendQueueFlusher(cfg *config.SendQueueConfig) {
+	sendQueueFlusherOnce.Do(func() {
+		interval := time.Duration(cfg.FlushInterval) * time.Millisecond
+		slowTimeout := time.Duration(cfg.SlowConsumerTimeout) * time.Second
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for now := range ticker.C {
+				layeredSessions.Range(func(sid zNet.SessionIdType, session *LayeredSession) bool {
+					if !session.flush(now, slowTimeout) {
+						zLog.Warn("Slow consumer disconnected", zap.Uint64("sessionId", sid))
+					}
+					return true
+				})
+			}
+		}()
+	})
+}
@@ -0,0 +1,54 @@
+package protolayer
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// 测试合并写出按优先级排序，以及超出预算时丢弃同步类消息
+func TestSendQueueCoalesceAndBudget(t *testing.T) {
+	raw := &recordSession{}
+	layered := &LayeredSession{Session: raw, queue: newSendQueue(100)}
+
+	layered.Send(10, make([]byte, 20))
+	layered.Send(30, make([]byte, 8))
+	layered.queue.push(20, make([]byte, 8), SendPriorityBulk)
+	layered.queue.push(1, []byte("kick"), SendPriorityControl)
+	if len(raw.sent) != 0 {
+		t.Fatalf("expected messages to be queued, got %d writes", len(raw.sent))
+	}
+
+	// 超出预算：先丢弃同步类消息腾出空间
+	if !layered.queue.push(11, make([]byte, 36), SendPriorityNormal) {
+		t.Fatalf("expected normal message to fit after dropping bulk")
+	}
+
+	if !layered.flush(time.Now(), time.Second) || len(raw.sent) != 1 {
+		t.Fatalf("expected a single coalesced write, got %d", len(raw.sent))
+	}
+	packets, err := SplitBatch(raw.sent[0])
+	if err != nil {
+		t.Fatalf("split batch: %v", err)
+	}
+	var protoIds []int32
+	for _, packet := range packets {
+		protoIds = append(protoIds, packet.ProtoId)
+	}
+	if len(protoIds) != 4 || protoIds[0] != 1 || protoIds[1] != 10 || protoIds[3] != 11 {
+		t.Fatalf("unexpected coalesced order: %v", protoIds)
+	}
+
+	// 无法腾出空间的普通消息视为慢消费者
+	layered.Send(12, make([]byte, 40))
+	if err := layered.Send(13, make([]byte, 60)); err != ErrSlowConsumer {
+		t.Fatalf("expected ErrSlowConsumer, got %v", err)
+	}
+
+	// 截断的合并消息体
+	truncated := binary.BigEndian.AppendUint32(nil, 10)
+	truncated = binary.BigEndian.AppendUint32(truncated, 5)
+	if _, err := SplitBatch(append(truncated, 1, 2)); err != ErrInvalidBatch {
+		t.Fatalf("expected ErrInvalidBatch, got %v", err)
+	}
+}
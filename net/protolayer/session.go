@@ -0,0 +1,337 @@
+package protolayer
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zUtil/zMap"
+	"go.uber.org/zap"
+)
+
+// LayeredSession 连接级会话
+// 在网络会话之上叠加下行发送队列以及协商后的压缩与传输加密：
+// 启用发送队列时下行消息按刷新周期合并写出；写出时先压缩（设置包头压缩标志）再加密，接收时按相反顺序还原
+type LayeredSession struct {
+	zNet.Session
+	mu          sync.Mutex
+	recv        *secureDirection   // 客户端 -> 服务器
+	send        *secureDirection   // 服务器 -> 客户端
+	compression *CompressionConfig // 协商启用的压缩配置（为空表示未启用）
+	queue       *sendQueue         // 下行发送队列（登录时协商启用，为空表示直接写出）
+	version     int32              // 登录时协商的协议版本（0表示尚未协商）
+	recorder    *capture.Writer    // 流量录制（为空表示未录制）
+	probe       pingProbe          // 服务器发起的网络探测
+}
+
+// PacketSender 可按完整数据包写出的底层会话
+// 压缩标志位于包头，只有实现该接口的连接才能发送压缩消息
+type PacketSender interface {
+	SendPacket(packet *zNet.NetPacket) error
+}
+
+// 同一连接始终对应同一个LayeredSession实例
+var layeredSessions = zMap.NewTypedShardedMap32[zNet.SessionIdType, *LayeredSession]()
+
+// WrapSession 获取连接对应的LayeredSession
+// 网络服务在分发数据包前调用，保证处理函数与玩家持有的是同一会话实例
+func WrapSession(session zNet.Session) zNet.Session {
+	if layered, ok := session.(*LayeredSession); ok {
+		return layered
+	}
+	if layered, exists := layeredSessions.Load(session.GetSid()); exists {
+		return layered
+	}
+	layered := &LayeredSession{Session: session}
+	layeredSessions.Store(session.GetSid(), layered)
+	return layered
+}
+
+// RemoveSession 连接关闭时释放连接级状态
+func RemoveSession(sessionId zNet.SessionIdType) {
+	if layered, exists := layeredSessions.Load(sessionId); exists {
+		layered.StopCapture()
+	}
+	layeredSessions.Delete(sessionId)
+}
+
+// Send 发送消息
+// 启用发送队列时消息进入队列，由刷新协程合并写出；队列超出预算且无法腾出空间时断开连接
+func (s *LayeredSession) Send(protoId int32, data []byte) error {
+	s.mu.Lock()
+	s.recordLocked(capture.DirectionOut, protoId, s.version, data)
+	if s.queue == nil {
+		defer s.mu.Unlock()
+		return s.writeLocked(protoId, data)
+	}
+	ok := s.queue.push(protoId, data, GetSendPriority(protoId))
+	if !ok {
+		s.queue.reset()
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		zLog.Warn("Send queue exceeded budget, disconnecting slow consumer",
+			zap.Uint64("sessionId", s.GetSid()),
+			zap.Int32("cmd", protoId))
+		s.closeSlowConsumer()
+		return ErrSlowConsumer
+	}
+	return nil
+}
+
+// Close 写出排队的消息后关闭连接
+func (s *LayeredSession) Close() {
+	s.mu.Lock()
+	s.writeQueuedLocked(time.Now())
+	s.mu.Unlock()
+
+	s.Session.Close()
+}
+
+// flush 写出排队的消息
+// 参数:
+//   - now: 当前时间
+//   - slowTimeout: 持续无法写出的最长时间
+//
+// 返回: 会话是否仍然可用（作为慢消费者断开时返回false）
+func (s *LayeredSession) flush(now time.Time, slowTimeout time.Duration) bool {
+	s.mu.Lock()
+	err := s.writeQueuedLocked(now)
+	slow := err != nil && now.Sub(s.queue.stalledSince) >= slowTimeout
+	if slow {
+		s.queue.reset()
+	}
+	s.mu.Unlock()
+
+	if slow {
+		s.closeSlowConsumer()
+		return false
+	}
+	return true
+}
+
+// writeQueuedLocked 将排队的消息一次写出，写出失败时保留队列等待下次重试
+// 注意: 调用前必须持有锁
+func (s *LayeredSession) writeQueuedLocked(now time.Time) error {
+	if s.queue == nil || s.queue.empty() {
+		return nil
+	}
+
+	protoId, data := s.queue.encode()
+	if err := s.writeLocked(protoId, data); err != nil {
+		if s.queue.stalledSince.IsZero() {
+			s.queue.stalledSince = now
+		}
+		return err
+	}
+	s.queue.reset()
+	return nil
+}
+
+// writeLocked 按协商结果压缩并加密消息体后写出，压缩的消息在包头设置压缩标志
+// 注意: 调用前必须持有锁，保证加密序号顺序与实际写出顺序一致
+func (s *LayeredSession) writeLocked(protoId int32, data []byte) error {
+	var compressed int32
+	sender, canCompress := s.Session.(PacketSender)
+	if s.compression != nil && canCompress {
+		if payload, ok := compressPayload(data, s.compression); ok {
+			data, compressed = payload, 1
+		}
+	}
+	if s.send != nil {
+		data = s.send.seal(protoId, compressed, data)
+	}
+	if compressed != 0 {
+		return sender.SendPacket(&zNet.NetPacket{
+			ProtoId:      protoId,
+			DataSize:     int32(len(data)),
+			IsCompressed: compressed,
+			Data:         data,
+		})
+	}
+	return s.Session.Send(protoId, data)
+}
+
+// closeSlowConsumer 断开下行积压的连接（不再写出排队的消息）
+func (s *LayeredSession) closeSlowConsumer() {
+	if globalNetworkMetrics != nil {
+		globalNetworkMetrics.IncSlowConsumers()
+	}
+	s.Session.Close()
+}
+
+// Open 还原客户端发来的数据包：解密、校验序号并按包头压缩标志解压，已协商协议版本时包头版本替换为协商的版本
+// 参数:
+//   - packet: 数据包，成功后Data替换为原始消息体
+//
+// 返回: 未协商压缩却收到压缩消息时返回ErrCompressionNotNegotiated
+func (s *LayeredSession) Open(packet *zNet.NetPacket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if packet.IsCompressed != 0 && s.compression == nil {
+		return ErrCompressionNotNegotiated
+	}
+
+	data := packet.Data
+	if s.recv != nil {
+		plaintext, err := s.recv.open(packet.ProtoId, packet.IsCompressed, data)
+		if err != nil {
+			return err
+		}
+		data = plaintext
+	}
+
+	if packet.IsCompressed != 0 {
+		raw, err := decompressPayload(data)
+		if err != nil {
+			return err
+		}
+		data = raw
+		packet.IsCompressed = 0
+	}
+
+	packet.Data = data
+	packet.DataSize = int32(len(data))
+	// 协商后按会话协议版本解析消息体（如是否带请求序号），不依赖每个数据包头
+	if s.version != 0 {
+		packet.Version = s.version
+	}
+	if s.recorder != nil {
+		s.recordLocked(capture.DirectionIn, packet.ProtoId, packet.Version, redactInbound(packet.ProtoId, packet.Version, data))
+	}
+	return nil
+}
+
+// RemoteIP 获取客户端IP
+// 底层会话提供RemoteIP或RemoteAddr时返回客户端地址，否则返回空字符串
+func (s *LayeredSession) RemoteIP() string {
+	switch inner := s.Session.(type) {
+	case interface{ RemoteIP() string }:
+		return inner.RemoteIP()
+	case interface{ RemoteAddr() net.Addr }:
+		addr := inner.RemoteAddr()
+		if addr == nil {
+			return ""
+		}
+		host, _, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			return addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
+// IsSecure 是否已完成加密握手
+func (s *LayeredSession) IsSecure() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.recv != nil
+}
+
+// EnableCompression 启用压缩，此后双向消息都可在包头标记压缩
+// 已排队的消息先按未压缩写出
+func (s *LayeredSession) EnableCompression() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.compression == nil {
+		s.writeQueuedLocked(time.Now())
+		s.compression = NewCompressionConfig()
+	}
+}
+
+// IsCompressionEnabled 是否已启用压缩
+func (s *LayeredSession) IsCompressionEnabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compression != nil
+}
+
+// EnableBatching 启用下行发送队列，此后同一刷新周期内的下行消息合并写出
+// 只在客户端登录时声明能拆分合并消息后调用
+func (s *LayeredSession) EnableBatching() {
+	cfg := config.GetSendQueueConfig()
+	if !cfg.Enabled {
+		return
+	}
+
+	s.mu.Lock()
+	if s.queue == nil {
+		s.queue = newSendQueue(cfg.MaxQueuedBytes)
+	}
+	s.mu.Unlock()
+	startSendQueueFlusher(cfg)
+}
+
+// IsBatchingEnabled 是否已启用下行发送队列
+func (s *LayeredSession) IsBatchingEnabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queue != nil
+}
+
+// CanCompress 连接能否发送压缩消息（底层会话实现PacketSender）
+func (s *LayeredSession) CanCompress() bool {
+	_, ok := s.Session.(PacketSender)
+	return ok
+}
+
+// StartPingProbe 回复客户端Ping时开始一次网络探测
+// 返回: 探测序号，随PingResponse下发，客户端收到后立即以PingAck回应
+func (s *LayeredSession) StartPingProbe() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.probe.start(time.Now())
+}
+
+// AckPingProbe 处理客户端对网络探测的回应，按服务器测得的往返时延与丢包率更新网络质量评估
+// 参数:
+//   - seq: 客户端回应的探测序号
+//
+// 返回: 是否为未完成的探测（过期或伪造的序号返回false）
+func (s *LayeredSession) AckPingProbe(seq uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rtt, lossRate, ok := s.probe.ack(seq, time.Now())
+	if ok {
+		s.updateNetworkQualityLocked(rtt, lossRate)
+	}
+	return ok
+}
+
+// UpdateNetworkQuality 根据测得的往返时延与丢包率更新网络质量评估
+// 网络质量越差，越倾向于压缩较小的消息
+// 参数:
+//   - rtt: 往返时延
+//   - lossRate: 丢包率（0-1）
+func (s *LayeredSession) UpdateNetworkQuality(rtt time.Duration, lossRate float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updateNetworkQualityLocked(rtt, lossRate)
+}
+
+// updateNetworkQualityLocked 更新网络质量评估
+// 注意: 调用前必须持有锁
+func (s *LayeredSession) updateNetworkQualityLocked(rtt time.Duration, lossRate float64) {
+	if s.compression == nil {
+		return
+	}
+	quality := EstimateNetworkQuality(rtt, lossRate)
+	// 平滑处理，避免单次抖动导致策略频繁变化
+	quality = (s.compression.NetworkQuality*3 + quality) / 4
+	if quality < s.compression.MinQuality {
+		quality = s.compression.MinQuality
+	}
+	if quality > s.compression.MaxQuality {
+		quality = s.compression.MaxQuality
+	}
+	s.compression.NetworkQuality = quality
+}
@@ -0,0 +1,132 @@
+package protolayer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+)
+
+// packetSession 可按数据包写出的测试会话
+type packetSession struct {
+	recordSession
+	packets []*zNet.NetPacket
+}
+
+func (s *packetSession) Send(protoId int32, data []byte) error {
+	return s.SendPacket(&zNet.NetPacket{ProtoId: protoId, DataSize: int32(len(data)), Data: data})
+}
+
+func (s *packetSession) SendPacket(packet *zNet.NetPacket) error {
+	s.packets = append(s.packets, packet)
+	return nil
+}
+
+// 测试协商压缩后包头的压缩标志与双向还原
+func TestLayeredSessionCompression(t *testing.T) {
+	raw := &packetSession{}
+	layered := WrapSession(raw).(*LayeredSession)
+	defer RemoveSession(raw.GetSid())
+
+	// 未协商时原样发送，且拒绝压缩的上行消息
+	if err := layered.Send(1, []byte("hi")); err != nil || raw.packets[0].IsCompressed != 0 || string(raw.packets[0].Data) != "hi" {
+		t.Fatalf("expected raw payload before negotiation, got %+v (%v)", raw.packets[0], err)
+	}
+	if err := layered.Open(&zNet.NetPacket{ProtoId: 1, IsCompressed: 1, Data: []byte("hi")}); err != ErrCompressionNotNegotiated {
+		t.Fatalf("expected ErrCompressionNotNegotiated, got %v", err)
+	}
+
+	layered.EnableCompression()
+	large := bytes.Repeat([]byte("sync"), 1024)
+	if err := layered.Send(2, large); err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if err := layered.Send(3, []byte("small")); err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if p := raw.packets[1]; p.IsCompressed == 0 || len(p.Data) >= len(large) || int(p.DataSize) != len(p.Data) {
+		t.Fatalf("expected large payload to be compressed, got %d bytes", len(p.Data))
+	}
+	if p := raw.packets[2]; p.IsCompressed != 0 || string(p.Data) != "small" {
+		t.Fatalf("expected small payload to stay raw, got %+v", p)
+	}
+
+	packet := raw.packets[1]
+	if err := layered.Open(packet); err != nil || !bytes.Equal(packet.Data, large) || int(packet.DataSize) != len(large) || packet.IsCompressed != 0 {
+		t.Fatalf("unexpected decompressed payload: %v", err)
+	}
+	packet = raw.packets[2]
+	if err := layered.Open(packet); err != nil || string(packet.Data) != "small" {
+		t.Fatalf("unexpected raw payload: %q (%v)", packet.Data, err)
+	}
+	if err := layered.Open(&zNet.NetPacket{ProtoId: 4, IsCompressed: 1, Data: []byte{0xff, 0xff}}); err == nil {
+		t.Fatalf("expected invalid compressed payload to be rejected")
+	}
+}
+
+// 测试无法写出包头标志的连接不发送压缩消息
+func TestLayeredSessionCompressionUnsupported(t *testing.T) {
+	raw := &recordSession{}
+	layered := WrapSession(raw).(*LayeredSession)
+	defer RemoveSession(raw.GetSid())
+
+	if layered.CanCompress() {
+		t.Fatalf("expected session without packet sends to be unable to compress")
+	}
+	layered.EnableCompression()
+	large := bytes.Repeat([]byte("sync"), 1024)
+	if err := layered.Send(2, large); err != nil || !bytes.Equal(raw.sent[0], large) {
+		t.Fatalf("expected payload to be sent raw, got %d bytes (%v)", len(raw.sent[0]), err)
+	}
+}
+
+// 测试服务器发起的网络探测
+func TestPingProbe(t *testing.T) {
+	var probe pingProbe
+	now := time.Now()
+
+	seq := probe.start(now)
+	if rtt, lossRate, ok := probe.ack(seq, now.Add(40*time.Millisecond)); !ok || rtt != 40*time.Millisecond || lossRate != 0 {
+		t.Fatalf("unexpected probe result %v %v %v", rtt, lossRate, ok)
+	}
+	if _, _, ok := probe.ack(seq, now); ok {
+		t.Fatalf("expected repeated ack to be ignored")
+	}
+
+	// 未回应的探测在下一次探测开始时计为丢失，迟到的回应被忽略
+	lost := probe.start(now)
+	seq = probe.start(now)
+	if _, _, ok := probe.ack(lost, now); ok {
+		t.Fatalf("expected stale ack to be ignored")
+	}
+	if _, lossRate, ok := probe.ack(seq, now); !ok || lossRate != 1.0/3 {
+		t.Fatalf("expected loss rate 1/3, got %v (%v)", lossRate, ok)
+	}
+}
+
+// 测试网络质量估算
+func TestEstimateNetworkQuality(t *testing.T) {
+	if q := EstimateNetworkQuality(30*time.Millisecond, 0); q != 100 {
+		t.Fatalf("expected good network to score 100, got %d", q)
+	}
+	if q := EstimateNetworkQuality(250*time.Millisecond, 0.05); q != 55 {
+		t.Fatalf("expected 55, got %d", q)
+	}
+	if q := EstimateNetworkQuality(2*time.Second, 0.5); q != 0 {
+		t.Fatalf("expected quality to be clamped at 0, got %d", q)
+	}
+}
+
+// 测试协议版本协商
+func TestNegotiateVersion(t *testing.T) {
+	if _, ok := NegotiateVersion(0, 1); ok {
+		t.Fatalf("expected client below min version to be rejected")
+	}
+	if v, ok := NegotiateVersion(1, 1); !ok || v != 1 {
+		t.Fatalf("expected version 1, got %d (%v)", v, ok)
+	}
+	if v, ok := NegotiateVersion(ProtocolVersionCurrent+5, 1); !ok || v != ProtocolVersionCurrent {
+		t.Fatalf("expected newer client to fall back to %d, got %d", ProtocolVersionCurrent, v)
+	}
+}
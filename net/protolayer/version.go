@@ -0,0 +1,78 @@
+package protolayer
+
+import (
+	"github.com/pzqf/zEngine/zNet"
+)
+
+// 客户端协议版本（数据包头Version字段）
+// 协议发生不兼容变更时递增ProtocolVersionCurrent，处理函数按会话协商的版本区分新旧格式
+const (
+	ProtocolVersionCurrent    int32 = 1 // 服务器支持的最高协议版本
+	ProtocolVersionRequestSeq int32 = 1 // 请求消息体带请求序号前缀的最低协议版本
+)
+
+// NegotiateVersion 协商协议版本
+// 客户端版本低于最低版本时协商失败（客户端需强制更新）；高于服务器支持的最高版本时按服务器版本通信
+// 参数:
+//   - clientVersion: 客户端数据包头中的协议版本
+//   - minVersion: 允许的最低协议版本
+//
+// 返回:
+//   - int32: 协商的协议版本
+//   - bool: 是否协商成功
+func NegotiateVersion(clientVersion, minVersion int32) (int32, bool) {
+	if clientVersion < minVersion {
+		return 0, false
+	}
+	if clientVersion > ProtocolVersionCurrent {
+		return ProtocolVersionCurrent, true
+	}
+	return clientVersion, true
+}
+
+// HasRequestSeq 指定协议版本的请求消息体是否带请求序号前缀
+func HasRequestSeq(version int32) bool {
+	return version >= ProtocolVersionRequestSeq
+}
+
+// SplitRequest 拆分请求消息体中的请求序号前缀与业务消息体
+// 参数:
+//   - version: 请求的协议版本（已协商时为会话协商的版本，否则为数据包头中的版本）
+//   - data: 请求消息体
+//
+// 返回:
+//   - []byte: 请求序号前缀，不带前缀的协议版本为空
+//   - []byte: 业务消息体
+//   - bool: 带前缀的协议版本消息体不足序号长度时返回false
+func SplitRequest(version int32, data []byte) ([]byte, []byte, bool) {
+	if !HasRequestSeq(version) {
+		return nil, data, true
+	}
+	if len(data) < RequestSeqSize {
+		return nil, nil, false
+	}
+	return data[:RequestSeqSize], data[RequestSeqSize:], true
+}
+
+// SetProtocolVersion 记录会话协商的协议版本
+func (s *LayeredSession) SetProtocolVersion(version int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = version
+}
+
+// ProtocolVersion 获取会话协商的协议版本（尚未协商时为0）
+func (s *LayeredSession) ProtocolVersion() int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.version
+}
+
+// SessionProtocolVersion 获取会话协商的协议版本
+// 非连接级会话或尚未协商时返回0
+func SessionProtocolVersion(session zNet.Session) int32 {
+	if layered, ok := session.(*LayeredSession); ok {
+		return layered.ProtocolVersion()
+	}
+	return 0
+}
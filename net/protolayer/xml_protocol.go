@@ -17,7 +17,7 @@ func NewXMLProtocol() *XMLProtocol {
 // Encode 将应用层消息编码为XML格式
 func (xp *XMLProtocol) Encode(protoId int32, version int32, data interface{}) (*zNet.NetPacket, error) {
 	// 序列化XML数据
-	body, err := xml.Marshal(data)
+	body, err := xp.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
@@ -38,3 +38,13 @@ func (xp *XMLProtocol) Decode(packet *zNet.NetPacket) (interface{}, error) {
 	// 这里只返回原始数据，实际使用时需要根据protoId解析为具体的消息类型
 	return packet.Data, nil
 }
+
+// Marshal 将应用层消息序列化为XML消息体
+func (xp *XMLProtocol) Marshal(data interface{}) ([]byte, error) {
+	return xml.Marshal(data)
+}
+
+// Unmarshal 将XML消息体反序列化到指定的应用层消息
+func (xp *XMLProtocol) Unmarshal(data []byte, v interface{}) error {
+	return xml.Unmarshal(data, v)
+}
@@ -0,0 +1,141 @@
+package router
+
+import (
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"go.uber.org/zap"
+)
+
+// Context 消息处理上下文
+// 封装会话、数据包以及当前配置的协议，处理函数通过它完成请求解码与响应编码
+type Context struct {
+	Session  zNet.Session
+	Packet   *zNet.NetPacket
+	Protocol protolayer.Protocol
+	router   *PacketRouter // 所属路由器（按消息声明的投递方式发送）
+}
+
+// NewContext 创建消息处理上下文
+func NewContext(session zNet.Session, packet *zNet.NetPacket, proto protolayer.Protocol) *Context {
+	return &Context{
+		Session:  session,
+		Packet:   packet,
+		Protocol: proto,
+	}
+}
+
+// newContext 创建绑定到路由器的消息处理上下文
+func (pr *PacketRouter) newContext(session zNet.Session, packet *zNet.NetPacket) *Context {
+	ctx := NewContext(session, packet, pr.GetProtocol())
+	ctx.router = pr
+	return ctx
+}
+
+// Decode 将数据包解码到请求消息
+// 参数:
+//   - v: 请求消息（指针）
+//
+// 返回:
+//   - error: 解码错误
+func (c *Context) Decode(v interface{}) error {
+	return protolayer.DecodeMessage(c.Protocol, c.Packet, v)
+}
+
+// ProtocolVersion 获取会话在登录时协商的协议版本（尚未协商时为0）
+// 处理函数据此兼容仍在使用旧协议格式的客户端
+func (c *Context) ProtocolVersion() int32 {
+	return protolayer.SessionProtocolVersion(c.Session)
+}
+
+// Reply 以请求的消息ID回复成功响应
+// 响应封装为Response并带回请求序号
+// 参数:
+//   - v: 业务响应消息
+//
+// 返回:
+//   - error: 编码或发送错误
+func (c *Context) Reply(v interface{}) error {
+	return c.reply(protocol.ErrorCode_ERR_OK, v)
+}
+
+// ReplyError 以请求的消息ID回复错误码
+// 参数:
+//   - code: 错误码
+//
+// 返回:
+//   - error: 编码或发送错误
+func (c *Context) ReplyError(code protocol.ErrorCode) error {
+	return c.reply(code, nil)
+}
+
+// reply 编码并发送对当前请求的回复
+func (c *Context) reply(code protocol.ErrorCode, v interface{}) error {
+	data, err := protolayer.EncodeResponse(c.Protocol, c.Packet, code, v)
+	if err != nil {
+		return err
+	}
+	return c.sendData(c.Packet.ProtoId, data)
+}
+
+// Send 向当前会话推送指定消息ID的消息（不封装为Response）
+// 标记为不可靠有序的消息在会话绑定UDP通道后经该通道发送
+// 参数:
+//   - protoId: 消息ID
+//   - v: 消息
+//
+// 返回:
+//   - error: 编码或发送错误
+func (c *Context) Send(protoId int32, v interface{}) error {
+	data, err := c.Protocol.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.sendData(protoId, data)
+}
+
+// sendData 按消息声明的投递方式发送已编码的消息体
+func (c *Context) sendData(protoId int32, data []byte) error {
+	if c.router != nil {
+		return c.router.Deliver(c.Session, protoId, data)
+	}
+	return c.Session.Send(protoId, data)
+}
+
+// ContextHandlerFunc 基于上下文的消息处理函数
+type ContextHandlerFunc func(ctx *Context) error
+
+// RegisterContextHandler 注册基于上下文的消息处理函数
+// 参数:
+//   - cmd: 消息ID
+//   - handler: 处理函数
+//   - states: 允许的会话状态（未声明时仅游戏中可用）
+func (pr *PacketRouter) RegisterContextHandler(cmd int32, handler ContextHandlerFunc, states ...SessionState) {
+	pr.RegisterHandler(cmd, func(session zNet.Session, packet *zNet.NetPacket) error {
+		return handler(pr.newContext(session, packet))
+	}, states...)
+}
+
+// TypedHandlerFunc 强类型消息处理函数，请求已按当前协议解码
+type TypedHandlerFunc[T any] func(ctx *Context, req *T) error
+
+// RegisterTypedHandler 注册强类型消息处理函数
+// 按路由器配置的协议将消息体解码为请求类型T后再调用处理函数，解码失败时回复请求格式错误
+// 参数:
+//   - pr: 数据包路由器
+//   - cmd: 消息ID
+//   - handler: 处理函数
+//   - states: 允许的会话状态（未声明时仅游戏中可用）
+func RegisterTypedHandler[T any](pr *PacketRouter, cmd int32, handler TypedHandlerFunc[T], states ...SessionState) {
+	pr.RegisterContextHandler(cmd, func(ctx *Context) error {
+		req := new(T)
+		if err := ctx.Decode(req); err != nil {
+			if replyErr := ctx.ReplyError(protocol.ErrorCode_ERR_INVALID_REQUEST); replyErr != nil {
+				zLog.Warn("Failed to reply error response", zap.Int32("cmd", cmd), zap.Error(replyErr))
+			}
+			return err
+		}
+		return handler(ctx, req)
+	}, states...)
+}
@@ -0,0 +1,78 @@
+package router
+
+import (
+	"github.com/pzqf/zEngine/zNet"
+)
+
+// DeliveryMode 下行消息投递方式
+type DeliveryMode int32
+
+const (
+	DeliveryReliable            DeliveryMode = iota // 可靠有序（默认，经会话本身发送）
+	DeliveryUnreliableSequenced                     // 不可靠有序：可能丢失，但客户端只接收比已收到的更新的消息
+)
+
+// UnreliableChannel 支持不可靠有序投递的附加通道
+// 会话绑定UDP通道后，标记为不可靠的下行消息经该通道发送
+type UnreliableChannel interface {
+	SendUnreliable(protoId int32, data []byte) error
+}
+
+// SetDeliveryMode 声明下行消息的投递方式
+// 参数:
+//   - cmd: 消息ID
+//   - mode: 投递方式
+func (pr *PacketRouter) SetDeliveryMode(cmd int32, mode DeliveryMode) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	if mode == DeliveryReliable {
+		delete(pr.deliveryModes, cmd)
+		return
+	}
+	pr.deliveryModes[cmd] = mode
+}
+
+// GetDeliveryMode 获取下行消息的投递方式（未声明时为可靠有序）
+func (pr *PacketRouter) GetDeliveryMode(cmd int32) DeliveryMode {
+	pr.mu.RLock()
+	defer pr.mu.RUnlock()
+
+	if mode, exists := pr.deliveryModes[cmd]; exists {
+		return mode
+	}
+	return DeliveryReliable
+}
+
+// BindUnreliableChannel 为会话绑定不可靠投递通道
+func (pr *PacketRouter) BindUnreliableChannel(sessionId zNet.SessionIdType, channel UnreliableChannel) {
+	pr.channels.Store(sessionId, channel)
+}
+
+// UnbindUnreliableChannel 解除会话的不可靠投递通道
+// 仅当当前绑定的仍是channel时解除，避免误删重新绑定的通道
+func (pr *PacketRouter) UnbindUnreliableChannel(sessionId zNet.SessionIdType, channel UnreliableChannel) {
+	if current, exists := pr.channels.Load(sessionId); exists && current == channel {
+		pr.channels.Delete(sessionId)
+	}
+}
+
+// Deliver 按消息声明的投递方式向会话发送消息
+// 不可靠消息在会话未绑定通道或通道发送失败时退化为经会话可靠发送
+// 参数:
+//   - session: 网络会话
+//   - protoId: 消息ID
+//   - data: 已编码的消息体
+//
+// 返回:
+//   - error: 发送错误
+func (pr *PacketRouter) Deliver(session zNet.Session, protoId int32, data []byte) error {
+	if pr.GetDeliveryMode(protoId) == DeliveryUnreliableSequenced {
+		if channel, exists := pr.channels.Load(session.GetSid()); exists {
+			if err := channel.SendUnreliable(protoId, data); err == nil {
+				return nil
+			}
+		}
+	}
+	return session.Send(protoId, data)
+}
@@ -0,0 +1,204 @@
+package router
+
+import (
+	"fmt"
+	"math"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"go.uber.org/zap"
+)
+
+// Middleware 消息处理中间件
+// 包装下一个处理函数并返回新的处理函数，可在调用前后执行横切逻辑，或直接拦截请求
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// middlewareEntry 中间件注册项
+type middlewareEntry struct {
+	minCmd     int32 // 生效的最小消息ID（含）
+	maxCmd     int32 // 生效的最大消息ID（含）
+	middleware Middleware
+}
+
+// match 判断中间件是否对消息ID生效
+func (e middlewareEntry) match(cmd int32) bool {
+	return cmd >= e.minCmd && cmd <= e.maxCmd
+}
+
+// Use 注册全局中间件，对所有消息生效
+// 中间件按注册顺序执行，先注册的位于外层
+// 参数:
+//   - middlewares: 中间件列表
+func (pr *PacketRouter) Use(middlewares ...Middleware) {
+	pr.UseRange(math.MinInt32, math.MaxInt32, middlewares...)
+}
+
+// UseRange 注册对指定消息ID范围生效的中间件
+// 与全局中间件共用同一注册顺序
+// 参数:
+//   - minCmd: 最小消息ID（含）
+//   - maxCmd: 最大消息ID（含）
+//   - middlewares: 中间件列表
+func (pr *PacketRouter) UseRange(minCmd, maxCmd int32, middlewares ...Middleware) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	for _, middleware := range middlewares {
+		pr.middlewares = append(pr.middlewares, middlewareEntry{
+			minCmd:     minCmd,
+			maxCmd:     maxCmd,
+			middleware: middleware,
+		})
+	}
+	pr.chains = make(HandlerTable)
+}
+
+// ReplyError 以请求的消息ID回复错误码
+// 供中间件在处理函数之外拒绝请求
+// 参数:
+//   - session: 网络会话
+//   - packet: 请求数据包
+//   - code: 错误码
+//
+// 返回:
+//   - error: 编码或发送错误
+func (pr *PacketRouter) ReplyError(session zNet.Session, packet *zNet.NetPacket, code protocol.ErrorCode) error {
+	return pr.newContext(session, packet).ReplyError(code)
+}
+
+// Recovery 异常恢复中间件
+// 捕获处理函数的panic并记录堆栈，向客户端回复该消息的错误响应，避免影响同一连接的后续消息
+// 参数:
+//   - pr: 数据包路由器（用于编码错误响应）
+func Recovery(pr *PacketRouter) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					zLog.Error("Packet handler panicked",
+						zap.Int32("cmd", packet.ProtoId),
+						zap.Uint64("sessionId", session.GetSid()),
+						zap.Any("panic", r),
+						zap.String("stack", string(debug.Stack())))
+
+					if replyErr := pr.ReplyError(session, packet, protocol.ErrorCode_ERR_SERVER); replyErr != nil {
+						zLog.Warn("Failed to reply error response", zap.Int32("cmd", packet.ProtoId), zap.Error(replyErr))
+					}
+					err = fmt.Errorf("handler for cmd %d panicked: %v", packet.ProtoId, r)
+				}
+			}()
+			return next(session, packet)
+		}
+	}
+}
+
+// PacketCodec 消息体编解码中间件
+// 按连接协商结果解密并解压消息体，解密失败视为篡改或重放、解压失败视为数据损坏，均断开连接；
+// 强制加密时，未完成握手的会话只允许发送握手消息
+// 参数:
+//   - pr: 数据包路由器（用于回复错误响应）
+//   - layer: 传输加密层
+//   - handshakeCmd: 握手消息ID
+//   - networkMetrics: 网络指标实例
+func PacketCodec(pr *PacketRouter, layer *protolayer.SecurityLayer, handshakeCmd int32, networkMetrics *metrics.NetworkMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			layered, ok := session.(*protolayer.LayeredSession)
+			if !ok {
+				return next(session, packet)
+			}
+
+			if !layered.IsSecure() && layer.IsRequired() && packet.ProtoId != handshakeCmd {
+				networkMetrics.IncRejectedPackets()
+				zLog.Warn("Packet rejected before handshake",
+					zap.Uint64("sessionId", session.GetSid()),
+					zap.Int32("cmd", packet.ProtoId))
+				return pr.ReplyError(session, packet, protocol.ErrorCode_ERR_HANDSHAKE_REQUIRED)
+			}
+
+			if err := layered.Open(packet); err != nil {
+				networkMetrics.IncDecodingErrors()
+				zLog.Warn("Failed to open packet, closing session",
+					zap.Uint64("sessionId", session.GetSid()),
+					zap.Int32("cmd", packet.ProtoId),
+					zap.Error(err))
+				session.Close()
+				return err
+			}
+			return next(session, packet)
+		}
+	}
+}
+
+// Metrics 网络指标中间件
+// 记录消息处理延迟，处理失败时累计解码错误数
+// 参数:
+//   - networkMetrics: 网络指标实例
+func Metrics(networkMetrics *metrics.NetworkMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			startTime := time.Now()
+			err := next(session, packet)
+			networkMetrics.RecordLatency(time.Since(startTime))
+			if err != nil {
+				networkMetrics.IncDecodingErrors()
+			}
+			return err
+		}
+	}
+}
+
+// Logging 请求日志中间件
+// 以Debug级别记录每条消息的会话、消息ID、处理耗时与结果
+func Logging() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			startTime := time.Now()
+			err := next(session, packet)
+			zLog.Debug("Handled packet",
+				zap.Uint64("sessionId", session.GetSid()),
+				zap.Int32("cmd", packet.ProtoId),
+				zap.Uint32("seq", protolayer.RequestSeq(packet)),
+				zap.Int32("size", packet.DataSize),
+				zap.Duration("cost", time.Since(startTime)),
+				zap.Error(err))
+			return err
+		}
+	}
+}
+
+// traceSeq 请求追踪ID序列
+var traceSeq atomic.Uint64
+
+// Tracing 请求追踪中间件
+// 为每条消息分配追踪ID，处理耗时超过阈值或处理失败时输出带追踪ID的告警日志
+// 参数:
+//   - slowThreshold: 慢请求阈值
+func Tracing(slowThreshold time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			traceId := traceSeq.Add(1)
+			startTime := time.Now()
+			err := next(session, packet)
+			cost := time.Since(startTime)
+
+			if err != nil || cost >= slowThreshold {
+				zLog.Warn("Packet trace",
+					zap.Uint64("traceId", traceId),
+					zap.Uint64("sessionId", session.GetSid()),
+					zap.Int32("cmd", packet.ProtoId),
+					zap.Uint32("seq", protolayer.RequestSeq(packet)),
+					zap.Duration("cost", cost),
+					zap.Bool("slow", cost >= slowThreshold),
+					zap.Error(err))
+			}
+			return err
+		}
+	}
+}
@@ -1,46 +1,121 @@
 package router
 
 import (
+	"sync"
+
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zUtil/zMap"
 	"go.uber.org/zap"
 )
 
 // 定义处理器函数类型
-type HandlerFunc func(session *zNet.TcpServerSession, packet *zNet.NetPacket) error
+type HandlerFunc func(session zNet.Session, packet *zNet.NetPacket) error
 
 // 定义路由处理器映射表
 type HandlerTable map[int32]HandlerFunc
 
+// SessionCloseFunc 会话关闭处理函数
+type SessionCloseFunc func(sessionId zNet.SessionIdType)
+
 // PacketRouter 数据包路由器
 type PacketRouter struct {
-	handlers HandlerTable
+	mu            sync.RWMutex
+	handlers      HandlerTable
+	middlewares   []middlewareEntry                                            // 中间件（按注册顺序执行）
+	chains        HandlerTable                                                 // 已组装中间件的处理函数缓存
+	cmdStates     map[int32]StateMask                                          // 消息允许的会话状态（未声明时仅游戏中可用）
+	protocol      protolayer.Protocol                                          // 消息编解码协议
+	closeHandlers []SessionCloseFunc                                           // 会话关闭处理函数（按注册顺序调用）
+	sessionStates *zMap.TypedShardedMap[zNet.SessionIdType, SessionState]      // 会话状态
+	deliveryModes map[int32]DeliveryMode                                       // 下行消息投递方式（未声明时可靠有序）
+	channels      *zMap.TypedShardedMap[zNet.SessionIdType, UnreliableChannel] // 会话绑定的不可靠投递通道
+	rateLimiter   *RateLimiter                                                 // 会话请求限流器（未启用时为空）
 }
 
 // NewPacketRouter 创建一个新的数据包路由器
+// 默认使用protobuf协议，可通过SetProtocol替换为配置的协议
 func NewPacketRouter() *PacketRouter {
 	return &PacketRouter{
-		handlers: make(HandlerTable),
+		handlers:      make(HandlerTable),
+		chains:        make(HandlerTable),
+		cmdStates:     make(map[int32]StateMask),
+		protocol:      protolayer.NewProtobufProtocol(),
+		sessionStates: zMap.NewTypedShardedMap32[zNet.SessionIdType, SessionState](),
+		deliveryModes: make(map[int32]DeliveryMode),
+		channels:      zMap.NewTypedShardedMap32[zNet.SessionIdType, UnreliableChannel](),
 	}
 }
 
+// SetProtocol 设置消息编解码协议
+func (pr *PacketRouter) SetProtocol(protocol protolayer.Protocol) {
+	if protocol == nil {
+		return
+	}
+	pr.protocol = protocol
+}
+
+// GetProtocol 获取消息编解码协议
+func (pr *PacketRouter) GetProtocol() protolayer.Protocol {
+	return pr.protocol
+}
+
 // RegisterHandler 注册一个消息处理器
-func (pr *PacketRouter) RegisterHandler(cmd int32, handler HandlerFunc) {
+// states 声明该消息允许的会话状态，未声明时仅游戏中可用
+func (pr *PacketRouter) RegisterHandler(cmd int32, handler HandlerFunc, states ...SessionState) {
+	pr.mu.Lock()
 	pr.handlers[cmd] = handler
+	delete(pr.chains, cmd)
+	if len(states) > 0 {
+		pr.cmdStates[cmd] = StatesOf(states...)
+	}
+	pr.mu.Unlock()
 	zLog.Debug("Registered handler", zap.Int32("cmd", cmd))
 }
 
 // UnregisterHandler 注销一个消息处理器
 func (pr *PacketRouter) UnregisterHandler(cmd int32) {
+	pr.mu.Lock()
 	delete(pr.handlers, cmd)
+	delete(pr.chains, cmd)
+	pr.mu.Unlock()
 	zLog.Debug("Unregistered handler", zap.Int32("cmd", cmd))
 }
 
+// HasHandler 消息ID是否已注册处理器
+func (pr *PacketRouter) HasHandler(cmd int32) bool {
+	pr.mu.RLock()
+	defer pr.mu.RUnlock()
+	_, exists := pr.handlers[cmd]
+	return exists
+}
+
+// RegisterSessionCloseHandler 注册会话关闭处理函数
+// 需在网络服务启动前注册
+func (pr *PacketRouter) RegisterSessionCloseHandler(handler SessionCloseFunc) {
+	pr.closeHandlers = append(pr.closeHandlers, handler)
+}
+
+// OnSessionClose 通知会话已关闭
+// 由网络层在连接断开时调用
+func (pr *PacketRouter) OnSessionClose(sessionId zNet.SessionIdType) {
+	for _, handler := range pr.closeHandlers {
+		handler(sessionId)
+	}
+	pr.sessionStates.Delete(sessionId)
+	pr.channels.Delete(sessionId)
+	if limiter := pr.GetRateLimiter(); limiter != nil {
+		limiter.RemoveSession(sessionId)
+	}
+}
+
 // Route 路由数据包到相应的处理程序
-func (pr *PacketRouter) Route(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
+// 处理函数按注册的中间件链依次执行
+func (pr *PacketRouter) Route(session zNet.Session, packet *zNet.NetPacket) error {
 	// 查找对应的处理函数
-	handler, exists := pr.handlers[packet.ProtoId]
-	if !exists {
+	handler := pr.getChain(packet.ProtoId)
+	if handler == nil {
 		zLog.Warn("No handler found for command", zap.Int32("cmd", packet.ProtoId))
 		return nil
 	}
@@ -48,3 +123,35 @@ func (pr *PacketRouter) Route(session *zNet.TcpServerSession, packet *zNet.NetPa
 	// 执行处理函数
 	return handler(session, packet)
 }
+
+// getChain 获取组装好中间件的处理函数
+// 首次路由某个消息ID时组装并缓存，注册处理函数或中间件后缓存失效
+func (pr *PacketRouter) getChain(cmd int32) HandlerFunc {
+	pr.mu.RLock()
+	chain, exists := pr.chains[cmd]
+	pr.mu.RUnlock()
+	if exists {
+		return chain
+	}
+
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	if chain, exists := pr.chains[cmd]; exists {
+		return chain
+	}
+	handler, exists := pr.handlers[cmd]
+	if !exists {
+		return nil
+	}
+
+	// 逆序包装，使先注册的中间件位于外层、先执行
+	chain = handler
+	for i := len(pr.middlewares) - 1; i >= 0; i-- {
+		if entry := pr.middlewares[i]; entry.match(cmd) {
+			chain = entry.middleware(chain)
+		}
+	}
+	pr.chains[cmd] = chain
+	return chain
+}
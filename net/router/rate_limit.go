@@ -0,0 +1,447 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+// RateLimitAction 请求超限时的处置（按会话在统计窗口内的超限次数逐级升级）
+type RateLimitAction int
+
+const (
+	RateLimitAllow RateLimitAction = iota // 放行
+	RateLimitDrop                         // 静默丢弃
+	RateLimitWarn                         // 丢弃并回复限流错误码
+	RateLimitKick                         // 断开连接
+	RateLimitBan                          // 断开连接并临时封禁账号
+)
+
+// String 处置名称
+func (a RateLimitAction) String() string {
+	switch a {
+	case RateLimitAllow:
+		return "allow"
+	case RateLimitDrop:
+		return "drop"
+	case RateLimitWarn:
+		return "warn"
+	case RateLimitKick:
+		return "kick"
+	case RateLimitBan:
+		return "ban"
+	default:
+		return fmt.Sprintf("RateLimitAction(%d)", int(a))
+	}
+}
+
+// RateLimitRule 限流规则，规则匹配的所有消息共享一个令牌桶
+type RateLimitRule struct {
+	Name      string  // 消息选择器原文
+	Rate      float64 // 每秒补充的令牌数
+	Burst     float64 // 令牌桶容量
+	selectors []msgSelector
+}
+
+// msgSelector 消息选择器：消息ID范围（含两端）
+type msgSelector struct {
+	min, max int32
+}
+
+// match 判断规则是否匹配消息ID
+func (r *RateLimitRule) match(cmd int32) bool {
+	for _, s := range r.selectors {
+		if cmd >= s.min && cmd <= s.max {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRateLimitRules 解析限流规则
+// 规则以逗号分隔，格式为 消息选择器=每秒请求数/突发请求数，突发请求数省略时与每秒请求数相同（至少为1）；
+// 消息选择器可以是消息ID枚举名、以*结尾的名称前缀、消息ID或消息ID范围（如3001-3999），以|连接的多个选择器共享一个令牌桶
+// 参数:
+//   - spec: 规则文本
+//
+// 返回:
+//   - []*RateLimitRule: 解析成功的规则（按配置顺序）
+//   - error: 无法解析的规则（其余规则仍然返回）
+func ParseRateLimitRules(spec string) ([]*RateLimitRule, error) {
+	var rules []*RateLimitRule
+	var errs []error
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		rule, err := parseRateLimitRule(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rate limit rule %q: %w", entry, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, errors.Join(errs...)
+}
+
+// parseRateLimitRule 解析一条限流规则
+func parseRateLimitRule(entry string) (*RateLimitRule, error) {
+	selectors, limit, ok := strings.Cut(entry, "=")
+	if !ok {
+		return nil, errors.New("missing '='")
+	}
+
+	rateText, burstText, hasBurst := strings.Cut(strings.TrimSpace(limit), "/")
+	rate, err := strconv.ParseFloat(strings.TrimSpace(rateText), 64)
+	if err != nil || rate <= 0 {
+		return nil, fmt.Errorf("invalid rate %q", rateText)
+	}
+	burst := rate
+	if hasBurst {
+		if burst, err = strconv.ParseFloat(strings.TrimSpace(burstText), 64); err != nil || burst <= 0 {
+			return nil, fmt.Errorf("invalid burst %q", burstText)
+		}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	rule := &RateLimitRule{Name: strings.TrimSpace(selectors), Rate: rate, Burst: burst}
+	for _, text := range strings.Split(selectors, "|") {
+		parsed, err := parseMsgSelector(strings.TrimSpace(text))
+		if err != nil {
+			return nil, err
+		}
+		rule.selectors = append(rule.selectors, parsed...)
+	}
+	return rule, nil
+}
+
+// parseMsgSelector 解析消息选择器
+func parseMsgSelector(text string) ([]msgSelector, error) {
+	if text == "" {
+		return nil, errors.New("empty message selector")
+	}
+
+	// 消息ID或消息ID范围
+	if text[0] >= '0' && text[0] <= '9' {
+		lo, hi, isRange := strings.Cut(text, "-")
+		min, err := strconv.ParseInt(strings.TrimSpace(lo), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message id %q", text)
+		}
+		max := min
+		if isRange {
+			if max, err = strconv.ParseInt(strings.TrimSpace(hi), 10, 32); err != nil || max < min {
+				return nil, fmt.Errorf("invalid message id range %q", text)
+			}
+		}
+		return []msgSelector{{min: int32(min), max: int32(max)}}, nil
+	}
+
+	// 消息ID枚举名或名称前缀
+	prefix, isPrefix := strings.CutSuffix(text, "*")
+	var selectors []msgSelector
+	for _, info := range msgreg.All() {
+		if info.Name == text || (isPrefix && strings.HasPrefix(info.Name, prefix)) {
+			selectors = append(selectors, msgSelector{min: info.Id, max: info.Id})
+		}
+	}
+	if len(selectors) == 0 {
+		return nil, fmt.Errorf("unknown message %q", text)
+	}
+	return selectors, nil
+}
+
+// tokenBucket 令牌桶
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take 按经过的时间补充令牌后取出一个
+// 返回: 是否取到令牌
+func (b *tokenBucket) take(now time.Time, rate, burst float64) bool {
+	if b.last.IsZero() {
+		b.tokens = burst
+	} else if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * rate
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sessionLimit 单个会话的限流状态
+type sessionLimit struct {
+	buckets        []tokenBucket // 与规则一一对应，最后一个为默认规则
+	violations     int           // 统计窗口内的超限次数
+	violationStart time.Time     // 统计窗口开始时间
+	identity       string        // 会话登录的账号（登录前为空）
+}
+
+// RateLimiter 会话请求限流器（并发安全）
+// 每个会话按规则各有一个令牌桶；会话登录账号后以账号记录因限流被断开的次数与临时封禁
+type RateLimiter struct {
+	mu       sync.Mutex
+	config   config.RateLimitConfig
+	rules    []*RateLimitRule
+	fallback *RateLimitRule // 默认规则，为空表示未配置规则的消息不限制
+	sessions map[zNet.SessionIdType]*sessionLimit
+	kicks    map[string][]time.Time // 账号 -> 封禁统计窗口内因限流被断开的时间
+	bans     map[string]time.Time   // 账号 -> 封禁到期时间
+}
+
+// NewRateLimiter 创建会话请求限流器
+// 参数:
+//   - cfg: 限流配置
+//
+// 返回:
+//   - *RateLimiter: 限流器（规则有误时跳过该规则）
+//   - error: 无法解析的规则
+func NewRateLimiter(cfg *config.RateLimitConfig) (*RateLimiter, error) {
+	rules, err := ParseRateLimitRules(cfg.Rules)
+	l := &RateLimiter{
+		config:   *cfg,
+		rules:    rules,
+		sessions: make(map[zNet.SessionIdType]*sessionLimit),
+		kicks:    make(map[string][]time.Time),
+		bans:     make(map[string]time.Time),
+	}
+	if cfg.DefaultRate > 0 {
+		l.fallback = &RateLimitRule{Name: "default", Rate: float64(cfg.DefaultRate), Burst: float64(cfg.DefaultBurst)}
+		if l.fallback.Burst < 1 {
+			l.fallback.Burst = 1
+		}
+	}
+	return l, err
+}
+
+// Rules 限流规则（按匹配顺序）
+func (l *RateLimiter) Rules() []*RateLimitRule {
+	return l.rules
+}
+
+// ruleFor 获取消息ID匹配的规则
+// 返回: 规则下标与规则，未匹配且没有默认规则时返回nil
+func (l *RateLimiter) ruleFor(cmd int32) (int, *RateLimitRule) {
+	for i, rule := range l.rules {
+		if rule.match(cmd) {
+			return i, rule
+		}
+	}
+	return len(l.rules), l.fallback
+}
+
+// Allow 检查会话的一条请求
+// 参数:
+//   - sessionId: 会话ID
+//   - cmd: 消息ID
+//   - now: 当前时间
+//
+// 返回:
+//   - RateLimitAction: 处置方式
+//   - *RateLimitRule: 匹配的规则（放行且未匹配规则时为空）
+func (l *RateLimiter) Allow(sessionId zNet.SessionIdType, cmd int32, now time.Time) (RateLimitAction, *RateLimitRule) {
+	index, rule := l.ruleFor(cmd)
+	if rule == nil {
+		return RateLimitAllow, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, exists := l.sessions[sessionId]
+	if !exists {
+		s = &sessionLimit{buckets: make([]tokenBucket, len(l.rules)+1)}
+		l.sessions[sessionId] = s
+	}
+	if s.buckets[index].take(now, rule.Rate, rule.Burst) {
+		return RateLimitAllow, rule
+	}
+
+	window := time.Duration(l.config.ViolationWindow) * time.Second
+	if now.Sub(s.violationStart) >= window {
+		s.violationStart = now
+		s.violations = 0
+	}
+	s.violations++
+
+	switch {
+	case l.config.KickAfter > 0 && s.violations >= l.config.KickAfter:
+		s.violations = 0
+		if l.recordKickUnsafe(s.identity, now) {
+			return RateLimitBan, rule
+		}
+		return RateLimitKick, rule
+	case l.config.WarnAfter > 0 && s.violations >= l.config.WarnAfter:
+		return RateLimitWarn, rule
+	default:
+		return RateLimitDrop, rule
+	}
+}
+
+// recordKickUnsafe 记录账号因限流被断开，达到封禁次数时封禁账号
+// 注意: 调用前必须持有锁
+// 返回: 是否封禁了账号
+func (l *RateLimiter) recordKickUnsafe(identity string, now time.Time) bool {
+	if identity == "" || l.config.BanAfter <= 0 {
+		return false
+	}
+
+	window := time.Duration(l.config.BanWindow) * time.Second
+	kicks := l.kicks[identity][:0]
+	for _, t := range l.kicks[identity] {
+		if now.Sub(t) < window {
+			kicks = append(kicks, t)
+		}
+	}
+	kicks = append(kicks, now)
+
+	if len(kicks) < l.config.BanAfter {
+		l.kicks[identity] = kicks
+		return false
+	}
+	delete(l.kicks, identity)
+	l.bans[identity] = now.Add(time.Duration(l.config.BanDuration) * time.Second)
+	return true
+}
+
+// BindIdentity 记录会话登录的账号，此后该会话因限流被断开时计入账号
+func (l *RateLimiter) BindIdentity(sessionId zNet.SessionIdType, identity string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, exists := l.sessions[sessionId]
+	if !exists {
+		s = &sessionLimit{buckets: make([]tokenBucket, len(l.rules)+1)}
+		l.sessions[sessionId] = s
+	}
+	s.identity = identity
+}
+
+// BannedUntil 查询账号的临时封禁
+// 返回: 封禁到期时间，未封禁时返回false
+func (l *RateLimiter) BannedUntil(identity string, now time.Time) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until, exists := l.bans[identity]
+	if !exists {
+		return time.Time{}, false
+	}
+	if !now.Before(until) {
+		delete(l.bans, identity)
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// Unban 解除账号的临时封禁
+func (l *RateLimiter) Unban(identity string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.bans, identity)
+	delete(l.kicks, identity)
+}
+
+// RemoveSession 会话关闭时释放其限流状态
+func (l *RateLimiter) RemoveSession(sessionId zNet.SessionIdType) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.sessions, sessionId)
+}
+
+// SetRateLimiter 设置会话请求限流器
+// 需在网络服务启动前设置，会话关闭时自动释放其限流状态
+func (pr *PacketRouter) SetRateLimiter(limiter *RateLimiter) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	pr.rateLimiter = limiter
+}
+
+// GetRateLimiter 获取会话请求限流器
+// 返回: 限流器，未启用限流时返回nil
+func (pr *PacketRouter) GetRateLimiter() *RateLimiter {
+	pr.mu.RLock()
+	defer pr.mu.RUnlock()
+
+	return pr.rateLimiter
+}
+
+// RateLimit 会话请求限流中间件
+// 位于解包之后、处理函数与玩家Actor之前；超限的请求按限流器给出的处置丢弃、回复限流错误码或断开连接
+// 参数:
+//   - pr: 数据包路由器
+//   - limiter: 限流器
+//   - networkMetrics: 网络指标实例
+func RateLimit(pr *PacketRouter, limiter *RateLimiter, networkMetrics *metrics.NetworkMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			action, rule := limiter.Allow(session.GetSid(), packet.ProtoId, time.Now())
+			if action == RateLimitAllow {
+				return next(session, packet)
+			}
+
+			networkMetrics.IncRateLimitedPackets()
+			fields := []zap.Field{
+				zap.Uint64("sessionId", session.GetSid()),
+				zap.Int32("cmd", packet.ProtoId),
+				zap.String("rule", rule.Name),
+				zap.String("action", action.String()),
+			}
+			switch action {
+			case RateLimitDrop:
+				zLog.Debug("Packet rate limited", fields...)
+				return nil
+			case RateLimitWarn:
+				zLog.Warn("Packet rate limited", fields...)
+				return pr.ReplyError(session, packet, protocol.ErrorCode_ERR_RATE_LIMITED)
+			default:
+				networkMetrics.IncRateLimitKicks()
+				if action == RateLimitBan {
+					networkMetrics.IncRateLimitBans()
+				}
+				zLog.Warn("Session kicked by rate limit", fields...)
+				pr.kickRateLimited(session)
+				return nil
+			}
+		}
+	}
+}
+
+// kickRateLimited 推送踢下线通知并断开请求过于频繁的会话
+func (pr *PacketRouter) kickRateLimited(session zNet.Session) {
+	pr.SetSessionState(session.GetSid(), SessionStateLoggingOut)
+
+	notify := protocol.KickNotify{
+		Reason:  protocol.KickReason_KICK_REASON_RATE_LIMITED,
+		Message: "操作过于频繁",
+	}
+	if err := NewContext(session, nil, pr.GetProtocol()).Send(int32(protocol.SystemMsgId_MSG_SYSTEM_KICK), &notify); err != nil {
+		zLog.Warn("Failed to send kick notify", zap.Uint64("sessionId", session.GetSid()), zap.Error(err))
+	}
+	session.Close()
+}
@@ -0,0 +1,85 @@
+package router
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/protocol"
+)
+
+func TestParseRateLimitRules(t *testing.T) {
+	move := int32(protocol.MapMsgId_MSG_MAP_MOVE)
+	rules, err := ParseRateLimitRules("MSG_MAP_MOVE=20/40, MSG_GUILD_*=2, 9000-9010|9100=0.5, bogus, MSG_NOT_EXIST=1")
+	if err == nil {
+		t.Fatal("expected error for invalid rules")
+	}
+	if len(rules) != 3 {
+		t.Fatalf("expected 3 valid rules, got %d", len(rules))
+	}
+
+	if !rules[0].match(move) || rules[0].Rate != 20 || rules[0].Burst != 40 {
+		t.Fatalf("unexpected move rule: %+v", rules[0])
+	}
+	if !rules[1].match(int32(protocol.GuildMsgId_MSG_GUILD_CREATE)) || rules[1].match(move) || rules[1].Burst != 2 {
+		t.Fatalf("unexpected guild rule: %+v", rules[1])
+	}
+	if !rules[2].match(9005) || !rules[2].match(9100) || rules[2].match(9011) || rules[2].Burst != 1 {
+		t.Fatalf("unexpected id rule: %+v", rules[2])
+	}
+}
+
+func TestRateLimiterGraduatedActions(t *testing.T) {
+	limiter, err := NewRateLimiter(&config.RateLimitConfig{
+		Enabled:         true,
+		Rules:           "9000=1/2",
+		ViolationWindow: 10,
+		WarnAfter:       2,
+		KickAfter:       3,
+		BanAfter:        2,
+		BanWindow:       600,
+		BanDuration:     60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1000, 0)
+	if action, rule := limiter.Allow(1, 1, now); action != RateLimitAllow || rule != nil {
+		t.Fatal("expected unmatched message to pass without default rule")
+	}
+
+	expect := func(sid uint64, want RateLimitAction) {
+		t.Helper()
+		if action, _ := limiter.Allow(sid, 9000, now); action != want {
+			t.Fatalf("expected %v, got %v", want, action)
+		}
+	}
+
+	limiter.BindIdentity(1, "acc")
+	expect(1, RateLimitAllow)
+	expect(1, RateLimitAllow)
+	expect(1, RateLimitDrop)
+	expect(1, RateLimitWarn)
+	expect(1, RateLimitKick)
+
+	// 补充令牌后放行
+	now = now.Add(time.Second)
+	expect(1, RateLimitAllow)
+
+	// 同一账号的新会话再次被断开，达到封禁次数
+	limiter.RemoveSession(1)
+	limiter.BindIdentity(2, "acc")
+	expect(2, RateLimitAllow)
+	expect(2, RateLimitAllow)
+	expect(2, RateLimitDrop)
+	expect(2, RateLimitWarn)
+	expect(2, RateLimitBan)
+
+	if _, banned := limiter.BannedUntil("acc", now.Add(59*time.Second)); !banned {
+		t.Fatal("expected account to be banned")
+	}
+	if _, banned := limiter.BannedUntil("acc", now.Add(60*time.Second)); banned {
+		t.Fatal("expected ban to expire")
+	}
+}
@@ -0,0 +1,121 @@
+package router
+
+import (
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"go.uber.org/zap"
+)
+
+// SessionState 会话状态
+// 正常流转: Connected -> Authenticated -> InGame -> LoggingOut
+type SessionState int32
+
+const (
+	SessionStateConnected     SessionState = iota // 已连接，尚未登录账号
+	SessionStateAuthenticated                     // 账号已登录，尚未进入游戏
+	SessionStateInGame                            // 角色已进入游戏
+	SessionStateLoggingOut                        // 登出中，不再接受任何消息
+)
+
+// String 返回会话状态名称
+func (s SessionState) String() string {
+	switch s {
+	case SessionStateConnected:
+		return "connected"
+	case SessionStateAuthenticated:
+		return "authenticated"
+	case SessionStateInGame:
+		return "in_game"
+	case SessionStateLoggingOut:
+		return "logging_out"
+	default:
+		return "unknown"
+	}
+}
+
+// StateMask 会话状态集合
+type StateMask uint32
+
+// StatesOf 由会话状态列表构造状态集合
+func StatesOf(states ...SessionState) StateMask {
+	var mask StateMask
+	for _, state := range states {
+		mask |= 1 << uint(state)
+	}
+	return mask
+}
+
+// Has 判断集合是否包含指定状态
+func (m StateMask) Has(state SessionState) bool {
+	return m&(1<<uint(state)) != 0
+}
+
+// defaultAllowedStates 未声明允许状态的消息默认仅在角色进入游戏后可用
+var defaultAllowedStates = StatesOf(SessionStateInGame)
+
+// AllowStates 声明消息允许的会话状态
+// 参数:
+//   - cmd: 消息ID
+//   - states: 允许的会话状态（为空时恢复默认，仅游戏中可用）
+func (pr *PacketRouter) AllowStates(cmd int32, states ...SessionState) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	if len(states) == 0 {
+		delete(pr.cmdStates, cmd)
+		return
+	}
+	pr.cmdStates[cmd] = StatesOf(states...)
+}
+
+// GetAllowedStates 获取消息允许的会话状态
+func (pr *PacketRouter) GetAllowedStates(cmd int32) StateMask {
+	pr.mu.RLock()
+	defer pr.mu.RUnlock()
+
+	if mask, exists := pr.cmdStates[cmd]; exists {
+		return mask
+	}
+	return defaultAllowedStates
+}
+
+// GetSessionState 获取会话状态（新连接为Connected）
+func (pr *PacketRouter) GetSessionState(sessionId zNet.SessionIdType) SessionState {
+	if state, exists := pr.sessionStates.Load(sessionId); exists {
+		return state
+	}
+	return SessionStateConnected
+}
+
+// SetSessionState 设置会话状态
+// 由业务处理函数在登录、进入游戏、登出等节点调用
+func (pr *PacketRouter) SetSessionState(sessionId zNet.SessionIdType, state SessionState) {
+	pr.sessionStates.Store(sessionId, state)
+	zLog.Debug("Session state changed",
+		zap.Uint64("sessionId", sessionId),
+		zap.String("state", state.String()))
+}
+
+// StateGuard 会话状态校验中间件
+// 当前会话状态不在消息声明的允许状态内时拒绝处理，计入指标并回复统一的错误响应
+// 参数:
+//   - pr: 数据包路由器
+//   - networkMetrics: 网络指标实例
+func StateGuard(pr *PacketRouter, networkMetrics *metrics.NetworkMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(session zNet.Session, packet *zNet.NetPacket) error {
+			state := pr.GetSessionState(session.GetSid())
+			if !pr.GetAllowedStates(packet.ProtoId).Has(state) {
+				networkMetrics.IncRejectedPackets()
+				zLog.Warn("Packet rejected by session state",
+					zap.Uint64("sessionId", session.GetSid()),
+					zap.Int32("cmd", packet.ProtoId),
+					zap.String("state", state.String()))
+				return pr.ReplyError(session, packet, protocol.ErrorCode_ERR_STATE_NOT_ALLOWED)
+			}
+			return next(session, packet)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/capture"
+)
+
+// 数据包解码：任意消息不panic，解码成功时消息体长度与包头一致
+func FuzzDecodeNetPacket(f *testing.F) {
+	records, err := capture.LoadInbound("../../testdata/captures")
+	if err != nil {
+		f.Fatalf("load captures: %v", err)
+	}
+	for _, record := range records {
+		packet := &zNet.NetPacket{ProtoId: record.ProtoId, Version: record.Version, DataSize: int32(len(record.Data)), Data: record.Data}
+		f.Add(append(packet.Marshal(), record.Data...))
+	}
+	f.Add(make([]byte, zNet.NetPacketHeadSize))
+
+	const maxDataSize = 64 * 1024
+	f.Fuzz(func(t *testing.T, message []byte) {
+		packet, err := decodeNetPacket(message, maxDataSize)
+		if err != nil {
+			return
+		}
+		if int(packet.DataSize) != len(packet.Data) || len(packet.Data) > maxDataSize {
+			t.Fatalf("data size %d, body %d bytes", packet.DataSize, len(packet.Data))
+		}
+	})
+}
+
+// UDP数据报：任意数据报解码并交给可靠通道时不panic
+func FuzzUdpSegments(f *testing.F) {
+	sender := newUdpArq(1, 64, 32, 10*time.Millisecond, 10)
+	sender.send([]byte("hello"))
+	sender.send(make([]byte, 200)) // 跨多个分片
+	if msg, err := sender.sendUnreliable([]byte("sync")); err == nil {
+		f.Add(msg)
+	}
+	sender.flush(time.Now(), func(datagram []byte) {
+		f.Add(append([]byte(nil), datagram...))
+	})
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, datagram []byte) {
+		segments, err := decodeUdpSegments(datagram)
+		if err != nil {
+			return
+		}
+		now := time.Now()
+		receiver := newUdpArq(1, 64, 32, 10*time.Millisecond, 10)
+		for _, seg := range segments {
+			receiver.input(seg, now)
+		}
+		receiver.flush(now, func([]byte) {})
+	})
+}
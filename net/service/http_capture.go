@@ -0,0 +1,101 @@
+package service
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"go.uber.org/zap"
+)
+
+// adminTokenHeader 管理接口的令牌请求头
+const adminTokenHeader = "X-Admin-Token"
+
+// registerCaptureRoutes 注册流量录制管理接口
+// 未配置管理令牌时不注册
+//
+//	POST /capture/session?id=<会话ID>&enable=<true|false>  开启或停止指定会话的录制
+//	POST /capture/account?name=<账号>&enable=<true|false>  开启或关闭账号的录制（账号下次登录时开始录制）
+func (hs *HTTPService) registerCaptureRoutes() {
+	token := config.GetCaptureConfig().AdminToken
+	if token == "" {
+		return
+	}
+
+	hs.RegisterHandler("/capture/session", captureAdmin(token, func(w http.ResponseWriter, r *http.Request, enable bool) {
+		sessionId, err := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid session id", http.StatusBadRequest)
+			return
+		}
+		file, err := protolayer.SetSessionCapture(zNet.SessionIdType(sessionId), enable)
+		if err == protolayer.ErrSessionNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			zLog.Error("Failed to set session capture", zap.Uint64("sessionId", sessionId), zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"session_id": sessionId, "capturing": enable, "file": file})
+	}))
+
+	hs.RegisterHandler("/capture/account", captureAdmin(token, func(w http.ResponseWriter, r *http.Request, enable bool) {
+		account := r.URL.Query().Get("name")
+		if account == "" {
+			http.Error(w, "account name required", http.StatusBadRequest)
+			return
+		}
+		capture.SetAccountEnabled(account, enable)
+		stopped := 0
+		if !enable {
+			stopped = protolayer.StopAccountCapture(account)
+		}
+		zLog.Info("Account capture updated", zap.String("account", account), zap.Bool("enabled", enable))
+		writeJSON(w, map[string]interface{}{"account": account, "enabled": enable, "stopped_sessions": stopped})
+	}))
+}
+
+// captureAdmin 校验请求方法、管理令牌与enable参数
+func captureAdmin(token string, handler func(w http.ResponseWriter, r *http.Request, enable bool)) HTTPHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAdminRequest(w, r, http.MethodPost, token) {
+			return
+		}
+		enable, err := strconv.ParseBool(r.URL.Query().Get("enable"))
+		if err != nil {
+			http.Error(w, "invalid enable", http.StatusBadRequest)
+			return
+		}
+		handler(w, r, enable)
+	}
+}
+
+// checkAdminRequest 校验管理接口的请求方法与令牌，校验失败时写出错误响应
+// 返回: 是否通过校验
+func checkAdminRequest(w http.ResponseWriter, r *http.Request, method, token string) bool {
+	if r.Method != method {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(adminTokenHeader)), []byte(token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+// writeJSON 以JSON格式写出响应
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		zLog.Warn("Failed to write JSON response", zap.Error(err))
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"go.uber.org/zap"
+)
+
+const (
+	loginLogDefaultLimit = 100  // 登录日志查询默认条数
+	loginLogMaxLimit     = 1000 // 登录日志查询最大条数
+)
+
+// registerLoginLogRoutes 注册登录日志查询接口
+// 未配置管理令牌时不注册；start与end为Unix秒，按时间倒序返回
+//
+//	GET /loginlog/query?account=<账号>&player=<角色ID>&ip=<IP>&device=<设备ID>&start=<起始时间>&end=<结束时间>&limit=<条数>
+//	GET /loginlog/accounts?ip=<IP>|device=<设备ID>&start=<起始时间>&end=<结束时间>  同一IP或设备登录过的账号（多开检测）
+func (hs *HTTPService) registerLoginLogRoutes() {
+	token := config.GetLoginLogConfig().AdminToken
+	if token == "" {
+		return
+	}
+
+	hs.RegisterHandler("/loginlog/query", loginLogAdmin(token, func(w http.ResponseWriter, r *http.Request, filter *models.LoginLogFilter) {
+		if filter.AccountID == 0 && filter.PlayerID == 0 && filter.IP == "" && filter.Device == "" {
+			http.Error(w, "account, player, ip or device required", http.StatusBadRequest)
+			return
+		}
+		logs, err := db.GetMgr().LoginLogRepository.Query(filter)
+		if err != nil {
+			zLog.Error("Failed to query login logs", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		views := make([]map[string]interface{}, 0, len(logs))
+		for _, l := range logs {
+			views = append(views, loginLogView(l))
+		}
+		writeJSON(w, map[string]interface{}{"logs": views})
+	}))
+
+	hs.RegisterHandler("/loginlog/accounts", loginLogAdmin(token, func(w http.ResponseWriter, r *http.Request, filter *models.LoginLogFilter) {
+		if filter.IP == "" && filter.Device == "" {
+			http.Error(w, "ip or device required", http.StatusBadRequest)
+			return
+		}
+		filter.AccountID, filter.PlayerID = 0, 0
+		filter.Limit = 0
+		filter.Start = loginLogWindowStart(filter)
+		logs, err := db.GetMgr().LoginLogRepository.Query(filter)
+		if err != nil {
+			zLog.Error("Failed to query login logs", zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"ip": filter.IP, "device": filter.Device, "accounts": loginLogAccounts(logs)})
+	}))
+}
+
+// loginLogAdmin 校验请求方法与管理令牌，并解析查询条件
+func loginLogAdmin(token string, handler func(w http.ResponseWriter, r *http.Request, filter *models.LoginLogFilter)) HTTPHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAdminRequest(w, r, http.MethodGet, token) {
+			return
+		}
+		query := r.URL.Query()
+		filter := &models.LoginLogFilter{
+			IP:     query.Get("ip"),
+			Device: query.Get("device"),
+			Limit:  loginLogDefaultLimit,
+		}
+
+		if name := query.Get("account"); name != "" {
+			account, err := db.GetMgr().AccountRepository.GetByName(name)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if account == nil {
+				http.Error(w, "account not found", http.StatusNotFound)
+				return
+			}
+			filter.AccountID = account.AccountID
+		}
+		if text := query.Get("player"); text != "" {
+			playerID, err := strconv.ParseInt(text, 10, 64)
+			if err != nil || playerID <= 0 {
+				http.Error(w, "invalid player id", http.StatusBadRequest)
+				return
+			}
+			filter.PlayerID = playerID
+		}
+		for _, bound := range []struct {
+			name string
+			dst  *time.Time
+		}{{"start", &filter.Start}, {"end", &filter.End}} {
+			if text := query.Get(bound.name); text != "" {
+				sec, err := strconv.ParseInt(text, 10, 64)
+				if err != nil || sec < 0 {
+					http.Error(w, "invalid "+bound.name, http.StatusBadRequest)
+					return
+				}
+				*bound.dst = time.Unix(sec, 0)
+			}
+		}
+		if text := query.Get("limit"); text != "" {
+			limit, err := strconv.Atoi(text)
+			if err != nil || limit <= 0 {
+				http.Error(w, "invalid limit", http.StatusBadRequest)
+				return
+			}
+			filter.Limit = min(limit, loginLogMaxLimit)
+		}
+
+		if db.GetMgr().LoginLogRepository == nil {
+			http.Error(w, "login log not available", http.StatusServiceUnavailable)
+			return
+		}
+		handler(w, r, filter)
+	}
+}
+
+// loginLogWindowStart 多开检测未指定起始时间时只统计最近30天，避免扫描全部历史
+func loginLogWindowStart(filter *models.LoginLogFilter) time.Time {
+	if !filter.Start.IsZero() {
+		return filter.Start
+	}
+	end := filter.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return end.AddDate(0, 0, -30)
+}
+
+// loginLogAccounts 按账号汇总登录日志（只统计账号登录与角色登录），按最近登录时间倒序
+func loginLogAccounts(logs []*models.LoginLog) []map[string]interface{} {
+	type summary struct {
+		accountID int64
+		logins    int
+		players   map[int64]struct{}
+		lastSeen  time.Time
+	}
+	var order []*summary
+	byAccount := make(map[int64]*summary)
+	for _, l := range logs {
+		if l.OpType != models.LoginOpAccountLogin && l.OpType != models.LoginOpPlayerLogin {
+			continue
+		}
+		s, ok := byAccount[l.AccountID]
+		if !ok {
+			// 日志按时间倒序，首次出现即为最近登录
+			s = &summary{accountID: l.AccountID, players: make(map[int64]struct{}), lastSeen: l.CreatedAt}
+			byAccount[l.AccountID] = s
+			order = append(order, s)
+		}
+		if l.OpType == models.LoginOpAccountLogin {
+			s.logins++
+		}
+		if l.PlayerID != 0 {
+			s.players[l.PlayerID] = struct{}{}
+		}
+	}
+
+	views := make([]map[string]interface{}, 0, len(order))
+	for _, s := range order {
+		players := make([]int64, 0, len(s.players))
+		for id := range s.players {
+			players = append(players, id)
+		}
+		views = append(views, map[string]interface{}{
+			"account_id": s.accountID,
+			"logins":     s.logins,
+			"players":    players,
+			"last_seen":  s.lastSeen.Unix(),
+		})
+	}
+	return views
+}
+
+// loginLogView 登录日志的JSON视图
+func loginLogView(l *models.LoginLog) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          l.LogID,
+		"account_id":  l.AccountID,
+		"player_id":   l.PlayerID,
+		"player_name": l.PlayerName,
+		"op_type":     l.OpType,
+		"ip":          l.IP,
+		"device":      l.Device,
+		"device_type": l.DeviceType,
+		"duration":    l.Duration,
+		"reason":      l.Reason,
+		"created_at":  l.CreatedAt.Unix(),
+	}
+}
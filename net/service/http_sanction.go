@@ -0,0 +1,175 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/db/models"
+	"github.com/pzqf/zGameServer/game/sanction"
+	"go.uber.org/zap"
+)
+
+// registerSanctionRoutes 注册账号处罚管理接口
+// 未配置管理令牌时不注册；签发与撤销均需填写操作人与原因，连同时间保存在处罚记录中
+//
+//	POST /sanction/issue?account=<账号>&player=<角色ID，省略时处罚整个账号>&type=<ban|mute|trade>&duration=<秒，省略或0为永久>&operator=<操作人>&reason=<原因>
+//	POST /sanction/revoke?account=<账号>&id=<处罚ID>&operator=<操作人>&reason=<原因>
+//	GET  /sanction/list?account=<账号>  账号的全部处罚记录（含已撤销与已到期）
+func (hs *HTTPService) registerSanctionRoutes() {
+	token := config.GetSanctionConfig().AdminToken
+	if token == "" {
+		return
+	}
+
+	hs.RegisterHandler("/sanction/issue", sanctionAdmin(token, http.MethodPost, func(w http.ResponseWriter, r *http.Request, account *models.Account) {
+		query := r.URL.Query()
+		sanctionType, ok := sanction.ParseType(query.Get("type"))
+		if !ok {
+			http.Error(w, "invalid type", http.StatusBadRequest)
+			return
+		}
+		operator, reason := query.Get("operator"), query.Get("reason")
+		if operator == "" || reason == "" {
+			http.Error(w, "operator and reason required", http.StatusBadRequest)
+			return
+		}
+
+		var duration int64
+		if text := query.Get("duration"); text != "" {
+			var err error
+			if duration, err = strconv.ParseInt(text, 10, 64); err != nil || duration < 0 {
+				http.Error(w, "invalid duration", http.StatusBadRequest)
+				return
+			}
+		}
+
+		var playerID int64
+		if text := query.Get("player"); text != "" {
+			var err error
+			if playerID, err = strconv.ParseInt(text, 10, 64); err != nil || playerID <= 0 {
+				http.Error(w, "invalid player id", http.StatusBadRequest)
+				return
+			}
+			pl, err := db.GetMgr().PlayerRepository.GetByID(playerID)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if pl == nil || pl.AccountID != account.AccountID {
+				http.Error(w, "player not found in account", http.StatusNotFound)
+				return
+			}
+		}
+
+		now := time.Now()
+		issued := &models.AccountSanction{
+			AccountID: account.AccountID,
+			PlayerID:  playerID,
+			Type:      sanctionType,
+			Reason:    reason,
+			Issuer:    operator,
+			CreatedAt: now,
+		}
+		if duration > 0 {
+			issued.ExpireAt = now.Add(time.Duration(duration) * time.Second)
+		}
+		if err := sanction.GetManager().Issue(issued); err != nil {
+			zLog.Error("Failed to issue sanction", zap.String("account", account.AccountName), zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, sanctionView(issued))
+	}))
+
+	hs.RegisterHandler("/sanction/revoke", sanctionAdmin(token, http.MethodPost, func(w http.ResponseWriter, r *http.Request, account *models.Account) {
+		query := r.URL.Query()
+		sanctionID, err := strconv.ParseInt(query.Get("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid sanction id", http.StatusBadRequest)
+			return
+		}
+		operator, reason := query.Get("operator"), query.Get("reason")
+		if operator == "" || reason == "" {
+			http.Error(w, "operator and reason required", http.StatusBadRequest)
+			return
+		}
+
+		revoked, err := sanction.GetManager().Revoke(account.AccountID, sanctionID, operator, reason)
+		switch err {
+		case nil:
+			writeJSON(w, sanctionView(revoked))
+		case sanction.ErrNotFound:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case sanction.ErrAlreadyRevoked:
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			zLog.Error("Failed to revoke sanction", zap.Int64("sanctionId", sanctionID), zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+
+	hs.RegisterHandler("/sanction/list", sanctionAdmin(token, http.MethodGet, func(w http.ResponseWriter, r *http.Request, account *models.Account) {
+		sanctions, err := sanction.GetManager().History(account.AccountID)
+		if err != nil {
+			zLog.Error("Failed to list sanctions", zap.String("account", account.AccountName), zap.Error(err))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		views := make([]map[string]interface{}, 0, len(sanctions))
+		for _, s := range sanctions {
+			views = append(views, sanctionView(s))
+		}
+		writeJSON(w, map[string]interface{}{"account": account.AccountName, "status": account.Status, "sanctions": views})
+	}))
+}
+
+// sanctionAdmin 校验请求方法与管理令牌，并按account参数查找账号
+func sanctionAdmin(token, method string, handler func(w http.ResponseWriter, r *http.Request, account *models.Account)) HTTPHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAdminRequest(w, r, method, token) {
+			return
+		}
+		name := r.URL.Query().Get("account")
+		if name == "" {
+			http.Error(w, "account name required", http.StatusBadRequest)
+			return
+		}
+		account, err := db.GetMgr().AccountRepository.GetByName(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if account == nil {
+			http.Error(w, "account not found", http.StatusNotFound)
+			return
+		}
+		handler(w, r, account)
+	}
+}
+
+// sanctionView 处罚记录的JSON视图
+func sanctionView(s *models.AccountSanction) map[string]interface{} {
+	view := map[string]interface{}{
+		"id":         s.SanctionID,
+		"account_id": s.AccountID,
+		"player_id":  s.PlayerID,
+		"type":       sanction.TypeName(s.Type),
+		"reason":     s.Reason,
+		"issuer":     s.Issuer,
+		"created_at": s.CreatedAt,
+		"active":     s.IsActive(time.Now()),
+	}
+	if !s.ExpireAt.IsZero() {
+		view["expire_at"] = s.ExpireAt
+	}
+	if !s.RevokedAt.IsZero() {
+		view["revoked_at"] = s.RevokedAt
+		view["revoked_by"] = s.RevokedBy
+		view["revoke_reason"] = s.RevokeReason
+	}
+	return view
+}
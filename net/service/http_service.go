@@ -154,4 +154,13 @@ func (hs *HTTPService) registerDefaultRoutes() {
 		// 使用promhttp处理metrics请求
 		promhttp.HandlerFor(metrics.GetRegistry(), promhttp.HandlerOpts{}).ServeHTTP(w, r)
 	})
+
+	// 流量录制管理路由
+	hs.registerCaptureRoutes()
+
+	// 账号处罚管理路由
+	hs.registerSanctionRoutes()
+
+	// 登录日志查询路由
+	hs.registerLoginLogRoutes()
 }
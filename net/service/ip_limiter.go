@@ -0,0 +1,140 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+)
+
+// ipWindow 固定时间窗口计数器
+type ipWindow struct {
+	start time.Time
+	count int64
+}
+
+// add 累加计数，窗口过期时重新计数
+// 返回: 当前窗口内的累计值
+func (w *ipWindow) add(now time.Time, window time.Duration, n int64) int64 {
+	if now.Sub(w.start) >= window {
+		w.start = now
+		w.count = 0
+	}
+	w.count += n
+	return w.count
+}
+
+// ipStats 单个IP的访问统计
+type ipStats struct {
+	conns    ipWindow  // 连接数
+	packets  ipWindow  // 数据包数
+	traffic  ipWindow  // 流量（字节）
+	lastSeen time.Time // 最近访问时间
+}
+
+// ipLimiter 按IP的防DDoS限制器
+// 与TCP服务使用相同的[ddos]配置：超过连接数、数据包数或流量上限的IP会被封禁一段时间
+type ipLimiter struct {
+	mu       sync.Mutex
+	config   *zNet.DDoSConfig
+	stats    map[string]*ipStats
+	banUntil map[string]time.Time
+}
+
+// newIPLimiter 创建IP限制器
+func newIPLimiter(config *zNet.DDoSConfig) *ipLimiter {
+	return &ipLimiter{
+		config:   config,
+		stats:    make(map[string]*ipStats),
+		banUntil: make(map[string]time.Time),
+	}
+}
+
+// allowConn 检查IP是否允许建立新连接
+func (l *ipLimiter) allowConn(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.isBannedUnsafe(ip, now) {
+		return false
+	}
+
+	stats := l.getStatsUnsafe(ip, now)
+	window := time.Duration(l.config.ConnTimeWindow) * time.Second
+	if stats.conns.add(now, window, 1) > int64(l.config.MaxConnPerIP) {
+		l.banUnsafe(ip, now)
+		return false
+	}
+	return true
+}
+
+// allowPacket 检查IP是否允许继续发送数据包
+// 参数:
+//   - ip: 客户端IP
+//   - size: 数据包大小（字节）
+func (l *ipLimiter) allowPacket(ip string, size int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.isBannedUnsafe(ip, now) {
+		return false
+	}
+
+	stats := l.getStatsUnsafe(ip, now)
+	packetWindow := time.Duration(l.config.PacketTimeWindow) * time.Second
+	trafficWindow := time.Duration(l.config.TrafficTimeWindow) * time.Second
+	if stats.packets.add(now, packetWindow, 1) > int64(l.config.MaxPacketsPerIP) ||
+		stats.traffic.add(now, trafficWindow, int64(size)) > l.config.MaxBytesPerIP {
+		l.banUnsafe(ip, now)
+		return false
+	}
+	return true
+}
+
+// cleanup 清理过期的封禁记录与长时间未访问的统计
+func (l *ipLimiter) cleanup() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for ip, until := range l.banUntil {
+		if now.After(until) {
+			delete(l.banUntil, ip)
+		}
+	}
+
+	idle := time.Duration(l.config.TrafficTimeWindow) * time.Second
+	for ip, stats := range l.stats {
+		if now.Sub(stats.lastSeen) > idle {
+			delete(l.stats, ip)
+		}
+	}
+}
+
+// isBannedUnsafe 检查IP是否处于封禁期
+// 注意: 调用前必须持有锁
+func (l *ipLimiter) isBannedUnsafe(ip string, now time.Time) bool {
+	until, exists := l.banUntil[ip]
+	return exists && now.Before(until)
+}
+
+// banUnsafe 封禁IP
+// 注意: 调用前必须持有锁
+func (l *ipLimiter) banUnsafe(ip string, now time.Time) {
+	l.banUntil[ip] = now.Add(time.Duration(l.config.BanDuration) * time.Second)
+	delete(l.stats, ip)
+}
+
+// getStatsUnsafe 获取IP统计，不存在时创建
+// 注意: 调用前必须持有锁
+func (l *ipLimiter) getStatsUnsafe(ip string, now time.Time) *ipStats {
+	stats, exists := l.stats[ip]
+	if !exists {
+		stats = &ipStats{}
+		l.stats[ip] = stats
+	}
+	stats.lastSeen = now
+	return stats
+}
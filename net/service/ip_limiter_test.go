@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pzqf/zEngine/zNet"
+)
+
+func TestIPLimiterBansAfterLimit(t *testing.T) {
+	limiter := newIPLimiter(&zNet.DDoSConfig{
+		MaxConnPerIP:      2,
+		ConnTimeWindow:    60,
+		MaxPacketsPerIP:   100,
+		PacketTimeWindow:  1,
+		MaxBytesPerIP:     1024,
+		TrafficTimeWindow: 60,
+		BanDuration:       60,
+	})
+
+	if !limiter.allowConn("1.1.1.1") || !limiter.allowConn("1.1.1.1") {
+		t.Fatal("expected connections within limit to be allowed")
+	}
+	if limiter.allowConn("1.1.1.1") {
+		t.Fatal("expected connection over limit to be rejected")
+	}
+	if limiter.allowPacket("1.1.1.1", 1) {
+		t.Fatal("expected banned ip to be rejected")
+	}
+
+	if !limiter.allowPacket("2.2.2.2", 1000) {
+		t.Fatal("expected packet within traffic limit to be allowed")
+	}
+	if limiter.allowPacket("2.2.2.2", 100) {
+		t.Fatal("expected packet over traffic limit to be rejected")
+	}
+}
@@ -1,8 +1,6 @@
 package service
 
 import (
-	"time"
-
 	"github.com/pzqf/zEngine/zLog"
 	"github.com/pzqf/zEngine/zNet"
 	"github.com/pzqf/zEngine/zService"
@@ -66,6 +64,7 @@ func (ts *TcpService) Init() error {
 	logger := zLog.GetStandardLogger()
 	ts.netServer = zNet.NewTcpServer(ts.netConfig, zNet.WithLogger(logger), zNet.WithDDoSConfig(ddosConfig))
 	ts.netServer.RegisterDispatcher(ts.dispatchPacket)
+	ts.netServer.SetRemoveSessionCallBack(ts.packetRouter.OnSessionClose)
 
 	// 设置网络指标监控实例到protocol层
 	protolayer.SetNetworkMetrics(ts.metrics)
@@ -131,39 +130,24 @@ func (ts *TcpService) dispatchPacket(session zNet.Session, packet *zNet.NetPacke
 	// 记录接收的数据包大小
 	ts.metrics.RecordBytesReceived(len(packet.Data) + zNet.NetPacketHeadSize)
 
-	// 将 Session 接口转换为具体的 TcpServerSession 类型
-	tcpSession, ok := session.(*zNet.TcpServerSession)
-	if !ok {
-		zLog.Error("Failed to convert session to TcpServerSession")
-		ts.metrics.IncDecodingErrors()
-		return nil
-	}
-
 	// 直接处理数据包，保证顺序
 	defer util.Recover(func(recover interface{}, stack string) {
 		zLog.Error("Packet processing panicked", zap.Any("panic", recover))
 	})
 
-	ts.processPacket(tcpSession, packet)
+	// 同一连接始终使用同一个会话实例，启用传输加密时负责消息体加解密
+	ts.processPacket(protolayer.WrapSession(session), packet)
 
 	return nil
 }
 
 // processPacket 处理数据包
-func (ts *TcpService) processPacket(session *zNet.TcpServerSession, packet *zNet.NetPacket) error {
-	// 记录开始处理时间
-	startTime := time.Now()
-
+// 延迟统计、异常恢复等由路由器中间件完成
+func (ts *TcpService) processPacket(session zNet.Session, packet *zNet.NetPacket) error {
 	// 路由数据包到相应的处理程序
 	err := ts.packetRouter.Route(session, packet)
-
-	// 记录处理延迟
-	latency := time.Since(startTime)
-	ts.metrics.RecordLatency(latency)
-
 	if err != nil {
-		zLog.Error("Failed to route packet", zap.Error(err))
-		ts.metrics.IncDecodingErrors()
+		zLog.Error("Failed to route packet", zap.Int32("cmd", packet.ProtoId), zap.Error(err))
 	}
 
 	return err
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// UDP报文分段命令
+const (
+	udpCmdBind       byte = 1 // 绑定请求，载荷为TCP下发的绑定令牌
+	udpCmdBindAck    byte = 2 // 绑定确认，conv为分配的通道号
+	udpCmdPush       byte = 3 // 可靠有序分片
+	udpCmdAck        byte = 4 // 可靠分片确认
+	udpCmdUnreliable byte = 5 // 不可靠有序消息
+	udpCmdPing       byte = 6 // 保活
+	udpCmdClose      byte = 7 // 关闭通道
+)
+
+// udpSegmentHeadSize 分段头大小：conv(4) cmd(1) frg(1) len(2) sn(4) una(4)
+const udpSegmentHeadSize = 16
+
+// udpMaxRto 最大重传超时
+const udpMaxRto = 5 * time.Second
+
+var errUdpMessageTooLarge = errors.New("udp message too large")
+
+// udpSegment UDP报文分段
+// 一个UDP数据报可包含多个分段，所有整数按小端序编码
+type udpSegment struct {
+	conv uint32 // 通道号（绑定请求为0）
+	cmd  byte   // 命令
+	frg  byte   // 剩余分片数，0表示消息的最后一个分片
+	sn   uint32 // 序号
+	una  uint32 // 发送方期望收到的下一个可靠分片序号（累计确认）
+	data []byte
+}
+
+// encode 将分段追加编码到buf
+func (seg *udpSegment) encode(buf []byte) []byte {
+	var head [udpSegmentHeadSize]byte
+	binary.LittleEndian.PutUint32(head[0:], seg.conv)
+	head[4] = seg.cmd
+	head[5] = seg.frg
+	binary.LittleEndian.PutUint16(head[6:], uint16(len(seg.data)))
+	binary.LittleEndian.PutUint32(head[8:], seg.sn)
+	binary.LittleEndian.PutUint32(head[12:], seg.una)
+	buf = append(buf, head[:]...)
+	return append(buf, seg.data...)
+}
+
+// decodeUdpSegments 解码UDP数据报中的所有分段
+// 分段载荷引用datagram的内存，调用方需要保留时应自行复制
+func decodeUdpSegments(datagram []byte) ([]udpSegment, error) {
+	var segments []udpSegment
+	for len(datagram) > 0 {
+		if len(datagram) < udpSegmentHeadSize {
+			return nil, fmt.Errorf("udp segment too short: %d bytes", len(datagram))
+		}
+		size := int(binary.LittleEndian.Uint16(datagram[6:]))
+		if len(datagram) < udpSegmentHeadSize+size {
+			return nil, fmt.Errorf("udp segment data size mismatch: head %d, actual %d", size, len(datagram)-udpSegmentHeadSize)
+		}
+		segments = append(segments, udpSegment{
+			conv: binary.LittleEndian.Uint32(datagram[0:]),
+			cmd:  datagram[4],
+			frg:  datagram[5],
+			sn:   binary.LittleEndian.Uint32(datagram[8:]),
+			una:  binary.LittleEndian.Uint32(datagram[12:]),
+			data: datagram[udpSegmentHeadSize : udpSegmentHeadSize+size],
+		})
+		datagram = datagram[udpSegmentHeadSize+size:]
+	}
+	return segments, nil
+}
+
+// seqBefore 判断序号a是否在b之前（处理回绕）
+func seqBefore(a, b uint32) bool {
+	return int32(a-b) < 0
+}
+
+// udpSendSegment 已发送待确认的可靠分片
+type udpSendSegment struct {
+	sn       uint32
+	frg      byte
+	data     []byte
+	sentAt   time.Time // 首次发送时间（用于RTT采样）
+	resendAt time.Time // 下次重传时间
+	xmit     int       // 发送次数
+}
+
+// udpArq 可靠UDP的自动重传状态机（KCP风格）
+// 可靠消息按MSS分片，经选择确认与累计确认保证有序到达；不可靠消息只保证不会乱序
+// 注意: 非并发安全，由所属通道加锁访问
+type udpArq struct {
+	conv           uint32
+	mtu            int
+	window         int
+	minRto         time.Duration
+	rto            time.Duration
+	srtt           time.Duration
+	rttvar         time.Duration
+	maxRetransmits int
+
+	sndNxt   uint32            // 下一个可靠分片序号
+	sndQueue []*udpSendSegment // 等待发送窗口的分片
+	sndBuf   []*udpSendSegment // 已发送待确认的分片（按序号升序）
+
+	rcvNxt    uint32                // 期望收到的下一个可靠分片序号
+	rcvBuf    map[uint32]udpSegment // 乱序到达的分片
+	fragments []byte                // 正在拼装的消息
+	acks      []uint32              // 待回复确认的序号
+
+	unreliableSndSeq uint32
+	unreliableRcvSeq uint32
+	unreliableRcvd   bool
+
+	transmits   int // 统计周期内的分片发送次数（含重传）
+	retransmits int // 统计周期内的分片重传次数
+
+	dead bool // 重传次数超限，链路不可用
+}
+
+// newUdpArq 创建自动重传状态机
+func newUdpArq(conv uint32, mtu, window int, minRto time.Duration, maxRetransmits int) *udpArq {
+	return &udpArq{
+		conv:           conv,
+		mtu:            mtu,
+		window:         window,
+		minRto:         minRto,
+		rto:            minRto * 4,
+		maxRetransmits: maxRetransmits,
+		rcvBuf:         make(map[uint32]udpSegment),
+	}
+}
+
+// mss 单个分段可承载的最大载荷
+func (a *udpArq) mss() int {
+	return a.mtu - udpSegmentHeadSize
+}
+
+// send 将可靠消息分片后加入发送队列，实际发送在flush中进行
+func (a *udpArq) send(msg []byte) error {
+	count := (len(msg) + a.mss() - 1) / a.mss()
+	if count == 0 {
+		count = 1
+	}
+	if count > 256 {
+		return errUdpMessageTooLarge
+	}
+
+	for i := 0; i < count; i++ {
+		end := (i + 1) * a.mss()
+		if end > len(msg) {
+			end = len(msg)
+		}
+		a.sndQueue = append(a.sndQueue, &udpSendSegment{
+			frg:  byte(count - 1 - i),
+			data: msg[i*a.mss() : end],
+		})
+	}
+	return nil
+}
+
+// sendUnreliable 编码一条不可靠有序消息
+// 返回: 可直接发送的UDP数据报
+func (a *udpArq) sendUnreliable(msg []byte) ([]byte, error) {
+	if len(msg) > a.mss() {
+		return nil, errUdpMessageTooLarge
+	}
+	a.unreliableSndSeq++
+	seg := udpSegment{conv: a.conv, cmd: udpCmdUnreliable, sn: a.unreliableSndSeq, una: a.rcvNxt, data: msg}
+	return seg.encode(make([]byte, 0, udpSegmentHeadSize+len(msg))), nil
+}
+
+// input 处理收到的分段
+// 返回: 按序拼装完成的可靠消息与新到达的不可靠消息
+func (a *udpArq) input(seg udpSegment, now time.Time) [][]byte {
+	a.ackUna(seg.una)
+
+	switch seg.cmd {
+	case udpCmdAck:
+		a.ackSn(seg.sn, now)
+	case udpCmdPush:
+		if !seqBefore(seg.sn, a.rcvNxt+uint32(a.window)) {
+			return nil
+		}
+		a.acks = append(a.acks, seg.sn)
+		if seqBefore(seg.sn, a.rcvNxt) {
+			return nil
+		}
+		if _, exists := a.rcvBuf[seg.sn]; !exists {
+			seg.data = append([]byte(nil), seg.data...)
+			a.rcvBuf[seg.sn] = seg
+		}
+		return a.drainReceived()
+	case udpCmdUnreliable:
+		if a.unreliableRcvd && !seqBefore(a.unreliableRcvSeq, seg.sn) {
+			return nil
+		}
+		a.unreliableRcvd = true
+		a.unreliableRcvSeq = seg.sn
+		return [][]byte{append([]byte(nil), seg.data...)}
+	}
+	return nil
+}
+
+// drainReceived 取出已按序到达的分片并拼装消息
+func (a *udpArq) drainReceived() [][]byte {
+	var messages [][]byte
+	for {
+		seg, exists := a.rcvBuf[a.rcvNxt]
+		if !exists {
+			return messages
+		}
+		delete(a.rcvBuf, a.rcvNxt)
+		a.rcvNxt++
+
+		a.fragments = append(a.fragments, seg.data...)
+		if seg.frg == 0 {
+			messages = append(messages, a.fragments)
+			a.fragments = nil
+		}
+	}
+}
+
+// ackUna 移除对端已累计确认的分片
+func (a *udpArq) ackUna(una uint32) {
+	i := 0
+	for i < len(a.sndBuf) && seqBefore(a.sndBuf[i].sn, una) {
+		i++
+	}
+	a.sndBuf = a.sndBuf[i:]
+}
+
+// ackSn 移除对端选择确认的分片，并以首次发送的分片采样RTT
+func (a *udpArq) ackSn(sn uint32, now time.Time) {
+	for i, seg := range a.sndBuf {
+		if seg.sn != sn {
+			continue
+		}
+		if seg.xmit == 1 {
+			a.updateRto(now.Sub(seg.sentAt))
+		}
+		a.sndBuf = append(a.sndBuf[:i], a.sndBuf[i+1:]...)
+		return
+	}
+}
+
+// updateRto 按RFC 6298更新重传超时
+func (a *udpArq) updateRto(rtt time.Duration) {
+	if a.srtt == 0 {
+		a.srtt = rtt
+		a.rttvar = rtt / 2
+	} else {
+		delta := a.srtt - rtt
+		if delta < 0 {
+			delta = -delta
+		}
+		a.rttvar = (3*a.rttvar + delta) / 4
+		a.srtt = (7*a.srtt + rtt) / 8
+	}
+	a.rto = a.srtt + 4*a.rttvar
+	if a.rto < a.minRto {
+		a.rto = a.minRto
+	}
+	if a.rto > udpMaxRto {
+		a.rto = udpMaxRto
+	}
+}
+
+// flush 发送待回复的确认、新分片与到期需重传的分片
+// 多个分段合并到不超过MTU的数据报中，通过output写出
+func (a *udpArq) flush(now time.Time, output func(datagram []byte)) {
+	buf := make([]byte, 0, a.mtu)
+	write := func(seg *udpSegment) {
+		if len(buf)+udpSegmentHeadSize+len(seg.data) > a.mtu && len(buf) > 0 {
+			output(buf)
+			buf = make([]byte, 0, a.mtu)
+		}
+		buf = seg.encode(buf)
+	}
+
+	for _, sn := range a.acks {
+		write(&udpSegment{conv: a.conv, cmd: udpCmdAck, sn: sn, una: a.rcvNxt})
+	}
+	a.acks = a.acks[:0]
+
+	// 发送窗口内的新分片进入待确认队列
+	for len(a.sndQueue) > 0 && len(a.sndBuf) < a.window {
+		seg := a.sndQueue[0]
+		a.sndQueue = a.sndQueue[1:]
+		seg.sn = a.sndNxt
+		a.sndNxt++
+		a.sndBuf = append(a.sndBuf, seg)
+	}
+
+	for _, seg := range a.sndBuf {
+		if seg.xmit > 0 && now.Before(seg.resendAt) {
+			continue
+		}
+		if seg.xmit >= a.maxRetransmits {
+			a.dead = true
+			return
+		}
+		if seg.xmit == 0 {
+			seg.sentAt = now
+		}
+		// 重传时超时时间指数退避
+		seg.xmit++
+		a.transmits++
+		if seg.xmit > 1 {
+			a.retransmits++
+		}
+		rto := a.rto << uint(seg.xmit-1)
+		if rto > udpMaxRto {
+			rto = udpMaxRto
+		}
+		seg.resendAt = now.Add(rto)
+		write(&udpSegment{conv: a.conv, cmd: udpCmdPush, frg: seg.frg, sn: seg.sn, una: a.rcvNxt, data: seg.data})
+	}
+
+	if len(buf) > 0 {
+		output(buf)
+	}
+}
+
+// linkStats 获取平滑往返时延与统计周期内的重传率，并开始新的统计周期
+// 返回: 平滑往返时延（尚无样本时为0），重传率（0-1，作为丢包率的估计）
+func (a *udpArq) linkStats() (time.Duration, float64) {
+	var lossRate float64
+	if a.transmits > 0 {
+		lossRate = float64(a.retransmits) / float64(a.transmits)
+	}
+	a.transmits = 0
+	a.retransmits = 0
+	return a.srtt, lossRate
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// deliver 将数据报中的分段交给对端，返回对端收到的消息
+func deliver(t *testing.T, peer *udpArq, datagrams [][]byte, now time.Time) [][]byte {
+	t.Helper()
+	var messages [][]byte
+	for _, datagram := range datagrams {
+		segments, err := decodeUdpSegments(datagram)
+		if err != nil {
+			t.Fatalf("decode failed: %v", err)
+		}
+		for _, seg := range segments {
+			messages = append(messages, peer.input(seg, now)...)
+		}
+	}
+	return messages
+}
+
+func TestUdpArqReliableWithLoss(t *testing.T) {
+	now := time.Now()
+	sender := newUdpArq(1, 64, 32, 10*time.Millisecond, 10)
+	receiver := newUdpArq(1, 64, 32, 10*time.Millisecond, 10)
+
+	large := bytes.Repeat([]byte("x"), 200) // 跨多个分片
+	for _, msg := range [][]byte{[]byte("a"), large, []byte("b")} {
+		if err := sender.send(msg); err != nil {
+			t.Fatalf("send failed: %v", err)
+		}
+	}
+
+	var out [][]byte
+	sender.flush(now, func(d []byte) { out = append(out, append([]byte(nil), d...)) })
+	if len(out) < 2 {
+		t.Fatalf("expected several datagrams, got %d", len(out))
+	}
+
+	// 丢弃第一个数据报，后续分片到达后仍不能越序交付
+	if got := deliver(t, receiver, out[1:], now); len(got) != 0 {
+		t.Fatalf("expected no delivery before gap is filled, got %d", len(got))
+	}
+
+	var acks [][]byte
+	receiver.flush(now, func(d []byte) { acks = append(acks, append([]byte(nil), d...)) })
+	deliver(t, sender, acks, now)
+
+	// 超时后只重传未确认的分片
+	var resent [][]byte
+	sender.flush(now.Add(time.Second), func(d []byte) { resent = append(resent, append([]byte(nil), d...)) })
+	got := deliver(t, receiver, resent, now.Add(time.Second))
+	if len(got) != 3 || string(got[0]) != "a" || !bytes.Equal(got[1], large) || string(got[2]) != "b" {
+		t.Fatalf("unexpected delivery: %q", got)
+	}
+}
+
+func TestUdpArqUnreliableSequenced(t *testing.T) {
+	sender := newUdpArq(1, 1400, 32, 10*time.Millisecond, 10)
+	receiver := newUdpArq(1, 1400, 32, 10*time.Millisecond, 10)
+
+	first, _ := sender.sendUnreliable([]byte("1"))
+	second, _ := sender.sendUnreliable([]byte("2"))
+
+	now := time.Now()
+	if got := deliver(t, receiver, [][]byte{second}, now); len(got) != 1 || string(got[0]) != "2" {
+		t.Fatalf("expected newest message, got %q", got)
+	}
+	// 较旧的消息晚到时丢弃
+	if got := deliver(t, receiver, [][]byte{first}, now); len(got) != 0 {
+		t.Fatalf("expected stale message to be dropped, got %q", got)
+	}
+
+	if _, err := sender.sendUnreliable(make([]byte, 1400)); err != errUdpMessageTooLarge {
+		t.Fatalf("expected errUdpMessageTooLarge, got %v", err)
+	}
+}
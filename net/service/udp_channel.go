@@ -0,0 +1,204 @@
+package service
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/util"
+	"go.uber.org/zap"
+)
+
+// udpQualityInterval 向绑定会话反馈链路质量的间隔
+const udpQualityInterval = time.Second
+
+var errUdpChannelClosed = errors.New("udp channel closed")
+
+// UdpChannel 绑定到已认证TCP会话的UDP通道
+// 会话ID与TCP会话相同，经UDP收到的消息按TCP会话的状态与玩家路由；
+// 可靠消息经ARQ保证有序到达，标记为不可靠有序的下行消息直接发送
+type UdpChannel struct {
+	mu         sync.Mutex
+	conv       uint32
+	addr       *net.UDPAddr
+	tcpSession zNet.Session // 绑定的TCP会话
+	service    *UdpService
+	arq        *udpArq
+	lastRecv   time.Time
+	lastReport time.Time // 上次反馈链路质量的时间
+	closed     bool
+	recvCh     chan *zNet.NetPacket // 待处理的上行消息（保证同一通道的处理顺序）
+	closeCh    chan struct{}
+}
+
+// newUdpChannel 创建UDP通道
+func newUdpChannel(conv uint32, addr *net.UDPAddr, tcpSession zNet.Session, service *UdpService) *UdpChannel {
+	cfg := service.udpConfig
+	return &UdpChannel{
+		conv:       conv,
+		addr:       addr,
+		tcpSession: tcpSession,
+		service:    service,
+		arq:        newUdpArq(conv, cfg.Mtu, cfg.SendWindow, time.Duration(cfg.MinRto)*time.Millisecond, cfg.MaxRetransmits),
+		lastRecv:   time.Now(),
+		lastReport: time.Now(),
+		recvCh:     make(chan *zNet.NetPacket, service.chanSize),
+		closeCh:    make(chan struct{}),
+	}
+}
+
+// GetSid 获取会话ID（与绑定的TCP会话相同）
+func (c *UdpChannel) GetSid() zNet.SessionIdType {
+	return c.tcpSession.GetSid()
+}
+
+// Send 经UDP可靠有序发送消息
+func (c *UdpChannel) Send(protoId int32, data []byte) error {
+	packet := zNet.NetPacket{
+		ProtoId:  protoId,
+		DataSize: int32(len(data)),
+		Data:     data,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return errUdpChannelClosed
+	}
+	if err := c.arq.send(packet.Marshal()); err != nil {
+		return err
+	}
+	// 立即发送，不等待下一次定时刷新
+	c.arq.flush(time.Now(), c.output)
+	return nil
+}
+
+// SendUnreliable 经UDP不可靠有序发送消息
+// 消息超过单个数据报容量时返回错误，由调用方退化为可靠发送
+func (c *UdpChannel) SendUnreliable(protoId int32, data []byte) error {
+	packet := zNet.NetPacket{
+		ProtoId:  protoId,
+		DataSize: int32(len(data)),
+		Data:     data,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return errUdpChannelClosed
+	}
+	datagram, err := c.arq.sendUnreliable(packet.Marshal())
+	if err != nil {
+		return err
+	}
+	c.output(datagram)
+	return nil
+}
+
+// Close 关闭通道绑定的TCP会话（UDP通道随之释放）
+func (c *UdpChannel) Close() {
+	c.tcpSession.Close()
+}
+
+// input 处理通道收到的分段
+// 拼装完成的消息进入接收队列，由通道处理协程按序分发
+func (c *UdpChannel) input(segments []udpSegment) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+
+	now := time.Now()
+	c.lastRecv = now
+	var messages [][]byte
+	for _, seg := range segments {
+		messages = append(messages, c.arq.input(seg, now)...)
+	}
+	// 尽快回复确认，减少对端重传
+	c.arq.flush(now, c.output)
+	c.mu.Unlock()
+
+	maxDataSize := c.service.udpConfig.Mtu * 256
+	for _, message := range messages {
+		packet, err := decodeNetPacket(message, maxDataSize)
+		if err != nil {
+			c.service.metrics.IncDecodingErrors()
+			zLog.Warn("Invalid udp packet", zap.Uint32("conv", c.conv), zap.Error(err))
+			continue
+		}
+
+		select {
+		case c.recvCh <- packet:
+		default:
+			c.service.metrics.IncDroppedPackets()
+			zLog.Warn("UDP channel receive queue is full", zap.Uint32("conv", c.conv), zap.Int32("cmd", packet.ProtoId))
+		}
+	}
+}
+
+// update 定时刷新重传与确认
+// 返回: 通道是否仍然可用（重传超限或空闲超时返回false）
+func (c *UdpChannel) update(now time.Time, idleTimeout time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return false
+	}
+	c.arq.flush(now, c.output)
+
+	// ARQ测得的往返时延与重传率反馈给绑定会话，用于评估网络质量
+	if now.Sub(c.lastReport) >= udpQualityInterval {
+		c.lastReport = now
+		if rtt, lossRate := c.arq.linkStats(); rtt > 0 {
+			if layered, ok := c.tcpSession.(*protolayer.LayeredSession); ok {
+				layered.UpdateNetworkQuality(rtt, lossRate)
+			}
+		}
+	}
+	return !c.arq.dead && now.Sub(c.lastRecv) < idleTimeout
+}
+
+// shutdown 释放通道并通知客户端
+func (c *UdpChannel) shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+	c.closed = true
+	close(c.closeCh)
+
+	seg := udpSegment{conv: c.conv, cmd: udpCmdClose, una: c.arq.rcvNxt}
+	c.output(seg.encode(nil))
+}
+
+// processLoop 按序将上行消息交给路由器处理
+func (c *UdpChannel) processLoop() {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("UDP channel process loop panicked", zap.Uint32("conv", c.conv), zap.Any("panic", recover))
+	})
+
+	for {
+		select {
+		case packet := <-c.recvCh:
+			c.service.dispatchPacket(c, packet)
+		case <-c.closeCh:
+			return
+		}
+	}
+}
+
+// output 写出UDP数据报
+// 注意: 调用前必须持有锁
+func (c *UdpChannel) output(datagram []byte) {
+	c.service.writeTo(datagram, c.addr)
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zEngine/zService"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+	"github.com/pzqf/zGameServer/util"
+	"go.uber.org/zap"
+)
+
+// udpBindTokenSize 绑定令牌随机字节数
+const udpBindTokenSize = 16
+
+// udpBindToken 待绑定的令牌
+type udpBindToken struct {
+	session  zNet.Session // 申请令牌的TCP会话
+	expireAt time.Time
+	channel  *UdpChannel // 已绑定的通道（绑定确认丢失时据此重发）
+}
+
+// UdpService UDP通道服务
+// 与TCP服务并行，为已进入游戏的会话提供低延迟的可靠/不可靠有序通道，适用于移动、战斗等消息；
+// 客户端经TCP申请绑定令牌后，用令牌将UDP端点绑定到该TCP会话
+type UdpService struct {
+	zService.BaseService
+	udpConfig    *config.UdpConfig
+	enabled      bool // 是否启用（配置启用且未强制传输加密）
+	conn         *net.UDPConn
+	packetRouter *router.PacketRouter
+	metrics      *metrics.NetworkMetrics
+	chanSize     int
+	mu           sync.RWMutex
+	tokens       map[string]*udpBindToken           // 绑定令牌 -> 令牌信息
+	channels     map[uint32]*UdpChannel             // 通道号 -> 通道
+	sessions     map[zNet.SessionIdType]*UdpChannel // TCP会话ID -> 通道
+	stopCh       chan struct{}
+	stopOnce     sync.Once
+}
+
+// NewUdpService 创建UDP通道服务
+func NewUdpService(router *router.PacketRouter) *UdpService {
+	us := &UdpService{
+		BaseService:  *zService.NewBaseService(common.ServiceIdUdpServer),
+		packetRouter: router,
+		metrics:      metrics.NewNetworkMetrics(),
+		tokens:       make(map[string]*udpBindToken),
+		channels:     make(map[uint32]*UdpChannel),
+		sessions:     make(map[zNet.SessionIdType]*UdpChannel),
+		stopCh:       make(chan struct{}),
+	}
+	return us
+}
+
+// Init 初始化UDP通道服务
+func (us *UdpService) Init() error {
+	us.SetState(zService.ServiceStateInit)
+
+	us.udpConfig = config.GetUdpConfig()
+
+	// 如果UDP通道未启用，直接返回
+	if !us.udpConfig.Enabled {
+		zLog.Info("UDP service is disabled")
+		return nil
+	}
+
+	// UDP通道不经过传输加密层，强制加密时不提供，以免消息以明文且无重放保护的方式传输
+	if protolayer.GetSecurityLayer().IsRequired() {
+		zLog.Warn("UDP service is disabled because net_security is required")
+		return nil
+	}
+	us.enabled = true
+
+	zLog.Info("Initializing UDP service...", zap.String("listen_address", us.udpConfig.ListenAddress))
+
+	us.chanSize = config.GetServerConfig().ChanSize
+
+	// 绑定令牌经已进入游戏的TCP会话申请
+	router.RegisterTypedHandler(us.packetRouter, int32(protocol.SystemMsgId_MSG_SYSTEM_UDP_BIND), us.handleBindRequest, router.SessionStateInGame)
+	us.packetRouter.RegisterSessionCloseHandler(us.onSessionClose)
+
+	return nil
+}
+
+// Close 关闭UDP通道服务
+func (us *UdpService) Close() error {
+	// 如果UDP通道未启用，直接返回
+	if !us.enabled {
+		return nil
+	}
+
+	us.SetState(zService.ServiceStateStopping)
+	zLog.Info("Closing UDP service...")
+
+	us.stopOnce.Do(func() { close(us.stopCh) })
+
+	us.mu.Lock()
+	channels := make([]*UdpChannel, 0, len(us.channels))
+	for _, channel := range us.channels {
+		channels = append(channels, channel)
+	}
+	us.mu.Unlock()
+	for _, channel := range channels {
+		us.removeChannel(channel)
+	}
+
+	if us.conn != nil {
+		us.conn.Close()
+	}
+	us.SetState(zService.ServiceStateStopped)
+	return nil
+}
+
+// Serve 启动UDP通道服务
+func (us *UdpService) Serve() {
+	// 如果UDP通道未启用，直接返回
+	if !us.enabled {
+		zLog.Info("UDP service is disabled, skipping start")
+		return
+	}
+
+	addr, err := net.ResolveUDPAddr("udp", us.udpConfig.ListenAddress)
+	if err != nil {
+		zLog.Error("Failed to resolve UDP listen address", zap.Error(err))
+		us.SetState(zService.ServiceStateStopped)
+		return
+	}
+	us.conn, err = net.ListenUDP("udp", addr)
+	if err != nil {
+		zLog.Error("Failed to start UDP service", zap.Error(err))
+		us.SetState(zService.ServiceStateStopped)
+		return
+	}
+
+	us.SetState(zService.ServiceStateRunning)
+	zLog.Info("Starting UDP service...")
+
+	go us.readLoop()
+	go us.updateLoop()
+}
+
+// handleBindRequest 为已进入游戏的会话签发UDP绑定令牌
+func (us *UdpService) handleBindRequest(ctx *router.Context, req *protocol.UdpBindRequest) error {
+	buf := make([]byte, udpBindTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		zLog.Error("Failed to generate udp bind token", zap.Error(err))
+		return ctx.ReplyError(protocol.ErrorCode_ERR_SERVER)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	// 经UDP通道申请时，令牌仍绑定到其TCP会话
+	session := ctx.Session
+	if channel, ok := session.(*UdpChannel); ok {
+		session = channel.tcpSession
+	}
+
+	us.mu.Lock()
+	us.tokens[token] = &udpBindToken{
+		session:  session,
+		expireAt: time.Now().Add(time.Duration(us.udpConfig.TokenTTL) * time.Second),
+	}
+	us.mu.Unlock()
+
+	address := us.udpConfig.PublicAddress
+	if address == "" {
+		address = us.udpConfig.ListenAddress
+	}
+	resp := protocol.UdpBindResponse{
+		Success: true,
+		Token:   token,
+		Address: address,
+		Mtu:     int32(us.udpConfig.Mtu),
+	}
+	return ctx.Reply(&resp)
+}
+
+// readLoop 读取UDP数据报
+func (us *UdpService) readLoop() {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("UDP read loop panicked", zap.Any("panic", recover))
+	})
+
+	buf := make([]byte, us.udpConfig.Mtu)
+	for {
+		n, addr, err := us.conn.ReadFromUDP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			zLog.Warn("UDP read failed", zap.Error(err))
+			continue
+		}
+		us.metrics.RecordBytesReceived(n)
+
+		segments, err := decodeUdpSegments(buf[:n])
+		if err != nil || len(segments) == 0 {
+			us.metrics.IncDecodingErrors()
+			continue
+		}
+		us.handleDatagram(segments, addr)
+	}
+}
+
+// handleDatagram 处理一个UDP数据报的所有分段
+func (us *UdpService) handleDatagram(segments []udpSegment, addr *net.UDPAddr) {
+	first := segments[0]
+	if first.conv == 0 {
+		if first.cmd == udpCmdBind {
+			us.handleBind(string(first.data), addr)
+		}
+		return
+	}
+
+	us.mu.RLock()
+	channel, exists := us.channels[first.conv]
+	us.mu.RUnlock()
+
+	// 通道号只接受绑定时的客户端地址，防止伪造
+	if !exists || !udpAddrEqual(channel.addr, addr) {
+		us.metrics.IncRejectedPackets()
+		return
+	}
+
+	for _, seg := range segments {
+		if seg.conv != first.conv {
+			us.metrics.IncRejectedPackets()
+			return
+		}
+		if seg.cmd == udpCmdClose {
+			us.removeChannel(channel)
+			return
+		}
+	}
+	channel.input(segments)
+}
+
+// handleBind 凭令牌将UDP端点绑定到TCP会话
+// 同一地址重复的绑定请求（绑定确认丢失）会重发确认
+func (us *UdpService) handleBind(token string, addr *net.UDPAddr) {
+	us.mu.Lock()
+	bindToken, exists := us.tokens[token]
+	if !exists || time.Now().After(bindToken.expireAt) {
+		us.mu.Unlock()
+		us.metrics.IncRejectedPackets()
+		zLog.Debug("Invalid udp bind token", zap.String("addr", addr.String()))
+		return
+	}
+
+	channel := bindToken.channel
+	if channel != nil {
+		us.mu.Unlock()
+		if udpAddrEqual(channel.addr, addr) {
+			us.sendBindAck(channel)
+		}
+		return
+	}
+
+	conv := us.newConvUnsafe()
+	sessionId := bindToken.session.GetSid()
+	oldChannel := us.sessions[sessionId]
+	channel = newUdpChannel(conv, addr, bindToken.session, us)
+	bindToken.channel = channel
+	us.channels[conv] = channel
+	us.sessions[sessionId] = channel
+	us.mu.Unlock()
+
+	// 客户端网络切换后重新绑定，释放旧通道
+	if oldChannel != nil {
+		us.removeChannel(oldChannel)
+	}
+
+	us.packetRouter.BindUnreliableChannel(sessionId, channel)
+	us.metrics.IncActiveConnections()
+	go channel.processLoop()
+	us.sendBindAck(channel)
+
+	zLog.Info("UDP channel bound",
+		zap.Uint64("sessionId", sessionId),
+		zap.Uint32("conv", conv),
+		zap.String("addr", addr.String()))
+}
+
+// sendBindAck 回复绑定确认
+func (us *UdpService) sendBindAck(channel *UdpChannel) {
+	seg := udpSegment{conv: channel.conv, cmd: udpCmdBindAck}
+	us.writeTo(seg.encode(nil), channel.addr)
+}
+
+// newConvUnsafe 分配随机且未被占用的非零通道号
+// 注意: 调用前必须持有锁
+func (us *UdpService) newConvUnsafe() uint32 {
+	var buf [4]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			continue
+		}
+		conv := binary.LittleEndian.Uint32(buf[:])
+		if _, exists := us.channels[conv]; conv != 0 && !exists {
+			return conv
+		}
+	}
+}
+
+// removeChannel 释放通道
+func (us *UdpService) removeChannel(channel *UdpChannel) {
+	us.mu.Lock()
+	if us.channels[channel.conv] != channel {
+		us.mu.Unlock()
+		return
+	}
+	delete(us.channels, channel.conv)
+	sessionId := channel.GetSid()
+	if us.sessions[sessionId] == channel {
+		delete(us.sessions, sessionId)
+	}
+	us.mu.Unlock()
+
+	us.packetRouter.UnbindUnreliableChannel(sessionId, channel)
+	us.metrics.DecActiveConnections()
+	channel.shutdown()
+
+	zLog.Info("UDP channel released", zap.Uint64("sessionId", sessionId), zap.Uint32("conv", channel.conv))
+}
+
+// onSessionClose TCP会话关闭时作废其绑定令牌并释放UDP通道
+func (us *UdpService) onSessionClose(sessionId zNet.SessionIdType) {
+	us.mu.Lock()
+	for token, bindToken := range us.tokens {
+		if bindToken.session.GetSid() == sessionId {
+			delete(us.tokens, token)
+		}
+	}
+	channel, exists := us.sessions[sessionId]
+	us.mu.Unlock()
+
+	if exists {
+		us.removeChannel(channel)
+	}
+}
+
+// updateLoop 定时刷新各通道的重传与确认，释放失效的通道与过期的令牌
+func (us *UdpService) updateLoop() {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("UDP update loop panicked", zap.Any("panic", recover))
+	})
+
+	ticker := time.NewTicker(time.Duration(us.udpConfig.Interval) * time.Millisecond)
+	defer ticker.Stop()
+
+	idleTimeout := time.Duration(us.udpConfig.IdleTimeout) * time.Second
+	for {
+		select {
+		case now := <-ticker.C:
+			us.mu.Lock()
+			channels := make([]*UdpChannel, 0, len(us.channels))
+			for _, channel := range us.channels {
+				channels = append(channels, channel)
+			}
+			for token, bindToken := range us.tokens {
+				if now.After(bindToken.expireAt) {
+					delete(us.tokens, token)
+				}
+			}
+			us.mu.Unlock()
+
+			for _, channel := range channels {
+				if !channel.update(now, idleTimeout) {
+					us.removeChannel(channel)
+				}
+			}
+		case <-us.stopCh:
+			return
+		}
+	}
+}
+
+// dispatchPacket 分发UDP通道收到的数据包到路由器
+// UDP通道只承载仅限游戏中的消息，登录、重连等会改变会话绑定的消息必须经TCP发送
+func (us *UdpService) dispatchPacket(channel *UdpChannel, packet *zNet.NetPacket) {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("Packet processing panicked", zap.Any("panic", recover))
+	})
+
+	if us.packetRouter.GetAllowedStates(packet.ProtoId) != router.StatesOf(router.SessionStateInGame) {
+		us.metrics.IncRejectedPackets()
+		zLog.Warn("Packet not allowed over udp", zap.Uint64("sessionId", channel.GetSid()), zap.Int32("cmd", packet.ProtoId))
+		return
+	}
+
+	if err := us.packetRouter.Route(channel, packet); err != nil {
+		zLog.Error("Failed to route packet", zap.Int32("cmd", packet.ProtoId), zap.Error(err))
+	}
+}
+
+// writeTo 向客户端写出UDP数据报
+func (us *UdpService) writeTo(datagram []byte, addr *net.UDPAddr) {
+	if _, err := us.conn.WriteToUDP(datagram, addr); err != nil {
+		zLog.Debug("UDP write failed", zap.String("addr", addr.String()), zap.Error(err))
+		return
+	}
+	us.metrics.RecordBytesSent(len(datagram))
+}
+
+// udpAddrEqual 判断两个UDP地址是否相同
+func udpAddrEqual(a, b *net.UDPAddr) bool {
+	return a.Port == b.Port && a.IP.Equal(b.IP)
+}
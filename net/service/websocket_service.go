@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zEngine/zService"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"github.com/pzqf/zGameServer/net/router"
+	"github.com/pzqf/zGameServer/util"
+	"github.com/pzqf/zUtil/zMap"
+	"go.uber.org/zap"
+)
+
+// webSocketSidBase WebSocket会话ID起始值，与TCP会话ID区分开，避免路由器中的会话状态互相覆盖
+const webSocketSidBase zNet.SessionIdType = 1 << 48
+
+// webSocketCleanupInterval IP限制器清理间隔
+const webSocketCleanupInterval = time.Minute
+
+// WebSocketService WebSocket网关服务
+// 供H5/Web客户端接入，与TCP服务共用同一个数据包路由器及其处理函数
+type WebSocketService struct {
+	zService.BaseService
+	wsConfig     *config.WebSocketConfig
+	server       *http.Server
+	upgrader     websocket.Upgrader
+	packetRouter *router.PacketRouter
+	metrics      *metrics.NetworkMetrics
+	limiter      *ipLimiter
+	sessions     *zMap.TypedShardedMap[zNet.SessionIdType, *WebSocketSession]
+	sessionCount atomic.Int32
+	sidSeq       atomic.Uint64
+	chanSize     int
+	heartbeat    time.Duration
+	stopCh       chan struct{}
+}
+
+// NewWebSocketService 创建WebSocket网关服务
+func NewWebSocketService(router *router.PacketRouter) *WebSocketService {
+	ws := &WebSocketService{
+		BaseService:  *zService.NewBaseService(common.ServiceIdWebSocketServer),
+		packetRouter: router,
+		metrics:      metrics.NewNetworkMetrics(),
+		sessions:     zMap.NewTypedShardedMap32[zNet.SessionIdType, *WebSocketSession](),
+		stopCh:       make(chan struct{}),
+	}
+	ws.sidSeq.Store(uint64(webSocketSidBase))
+	return ws
+}
+
+// Init 初始化WebSocket网关服务
+func (ws *WebSocketService) Init() error {
+	ws.SetState(zService.ServiceStateInit)
+
+	ws.wsConfig = config.GetWebSocketConfig()
+
+	// 如果WebSocket网关未启用，直接返回
+	if !ws.wsConfig.Enabled {
+		zLog.Info("WebSocket service is disabled")
+		return nil
+	}
+
+	zLog.Info("Initializing WebSocket service...",
+		zap.String("listen_address", ws.wsConfig.ListenAddress),
+		zap.String("path", ws.wsConfig.Path))
+
+	serverCfg := config.GetServerConfig()
+	ws.chanSize = serverCfg.ChanSize
+	ws.heartbeat = time.Duration(serverCfg.HeartbeatDuration) * time.Second
+
+	// 与TCP服务使用相同的防DDoS攻击参数
+	ws.limiter = newIPLimiter(&config.GetConfig().DDoS)
+
+	ws.upgrader = websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		CheckOrigin:     newOriginChecker(ws.wsConfig.AllowedOrigins),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(ws.wsConfig.Path, ws.handleUpgrade)
+	ws.server = &http.Server{
+		Addr:    ws.wsConfig.ListenAddress,
+		Handler: mux,
+	}
+
+	return nil
+}
+
+// Close 关闭WebSocket网关服务
+func (ws *WebSocketService) Close() error {
+	// 如果WebSocket网关未启用，直接返回
+	if !ws.wsConfig.Enabled {
+		return nil
+	}
+
+	ws.SetState(zService.ServiceStateStopping)
+	zLog.Info("Closing WebSocket service...")
+
+	close(ws.stopCh)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := ws.server.Shutdown(ctx); err != nil {
+		zLog.Warn("Failed to shutdown WebSocket server", zap.Error(err))
+	}
+
+	// 升级后的连接不受http.Server管理，需要逐个关闭
+	ws.sessions.Range(func(sid zNet.SessionIdType, session *WebSocketSession) bool {
+		session.Close()
+		return true
+	})
+
+	ws.SetState(zService.ServiceStateStopped)
+	return nil
+}
+
+// Serve 启动WebSocket网关服务
+func (ws *WebSocketService) Serve() {
+	// 如果WebSocket网关未启用，直接返回
+	if !ws.wsConfig.Enabled {
+		zLog.Info("WebSocket service is disabled, skipping start")
+		return
+	}
+
+	listener, err := net.Listen("tcp", ws.wsConfig.ListenAddress)
+	if err != nil {
+		zLog.Error("Failed to start WebSocket service", zap.Error(err))
+		ws.SetState(zService.ServiceStateStopped)
+		return
+	}
+
+	ws.SetState(zService.ServiceStateRunning)
+	zLog.Info("Starting WebSocket service...")
+
+	go ws.startLimiterCleanup()
+	go func() {
+		defer util.Recover(func(recover interface{}, stack string) {
+			zLog.Error("WebSocket server panicked", zap.Any("panic", recover))
+		})
+
+		if err := ws.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			zLog.Error("WebSocket server stopped unexpectedly", zap.Error(err))
+			ws.SetState(zService.ServiceStateStopped)
+		}
+	}()
+}
+
+// GetSessionCount 获取当前WebSocket会话数
+func (ws *WebSocketService) GetSessionCount() int {
+	return int(ws.sessionCount.Load())
+}
+
+// handleUpgrade 校验连接限制并升级为WebSocket连接
+func (ws *WebSocketService) handleUpgrade(w http.ResponseWriter, r *http.Request) {
+	ip := clientIP(r)
+	if !ws.limiter.allowConn(ip) {
+		ws.metrics.IncDroppedConnections()
+		zLog.Warn("WebSocket connection rejected by DDoS limits", zap.String("ip", ip))
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+	if int(ws.sessionCount.Add(1)) > ws.wsConfig.MaxClientCount {
+		ws.sessionCount.Add(-1)
+		ws.metrics.IncDroppedConnections()
+		zLog.Warn("WebSocket connection rejected, max client count reached", zap.String("ip", ip))
+		http.Error(w, "server is full", http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, err := ws.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		ws.sessionCount.Add(-1)
+		zLog.Debug("WebSocket upgrade failed", zap.String("ip", ip), zap.Error(err))
+		return
+	}
+
+	sid := zNet.SessionIdType(ws.sidSeq.Add(1))
+	session := newWebSocketSession(sid, conn, ip, ws, ws.chanSize)
+	ws.sessions.Store(sid, session)
+	ws.metrics.IncActiveConnections()
+
+	zLog.Debug("WebSocket session connected", zap.Uint64("sessionId", sid), zap.String("ip", ip))
+	session.start(ws.heartbeat)
+}
+
+// removeSession 移除已关闭的会话，并通知路由器清理会话相关状态
+func (ws *WebSocketService) removeSession(session *WebSocketSession) {
+	ws.sessions.Delete(session.sid)
+	ws.sessionCount.Add(-1)
+	ws.metrics.DecActiveConnections()
+
+	zLog.Debug("WebSocket session closed", zap.Uint64("sessionId", session.sid))
+	ws.packetRouter.OnSessionClose(session.sid)
+}
+
+// dispatchPacket 分发数据包到路由器
+// 在会话读协程中同步处理，保证同一连接的消息顺序
+func (ws *WebSocketService) dispatchPacket(session *WebSocketSession, packet *zNet.NetPacket) {
+	ws.metrics.RecordBytesReceived(len(packet.Data) + zNet.NetPacketHeadSize)
+
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("Packet processing panicked", zap.Any("panic", recover))
+	})
+
+	if err := ws.packetRouter.Route(protolayer.WrapSession(session), packet); err != nil {
+		zLog.Error("Failed to route packet", zap.Int32("cmd", packet.ProtoId), zap.Error(err))
+	}
+}
+
+// startLimiterCleanup 定期清理IP限制器的过期数据
+func (ws *WebSocketService) startLimiterCleanup() {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("WebSocket limiter cleanup panicked", zap.Any("panic", recover))
+	})
+
+	ticker := time.NewTicker(webSocketCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ws.limiter.cleanup()
+		case <-ws.stopCh:
+			return
+		}
+	}
+}
+
+// newOriginChecker 根据允许的Origin列表创建校验函数
+// 未携带Origin的请求（非浏览器客户端）总是允许；列表为空时只允许与升级请求同源的页面，
+// 列表中含*时不限制
+// 参数:
+//   - allowedOrigins: 逗号分隔的Origin列表
+func newOriginChecker(allowedOrigins string) func(r *http.Request) bool {
+	allowed := make(map[string]struct{})
+	for _, origin := range strings.Split(allowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowed[origin] = struct{}{}
+		}
+	}
+	_, allowAll := allowed["*"]
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowAll {
+			return true
+		}
+		if len(allowed) == 0 {
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		}
+		_, ok := allowed[origin]
+		return ok
+	}
+}
+
+// clientIP 获取客户端IP
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
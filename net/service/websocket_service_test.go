@@ -0,0 +1,31 @@
+package service
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOriginChecker(t *testing.T) {
+	cases := []struct {
+		allowed string
+		origin  string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "http://game.example.com", true},
+		{"", "http://evil.example.com", false},
+		{"*", "http://evil.example.com", true},
+		{"http://web.example.com", "http://web.example.com", true},
+		{"http://web.example.com", "http://game.example.com", false},
+		{"http://web.example.com", "", true},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest("GET", "http://game.example.com/ws", nil)
+		if c.origin != "" {
+			r.Header.Set("Origin", c.origin)
+		}
+		if got := newOriginChecker(c.allowed)(r); got != c.want {
+			t.Errorf("allowed=%q origin=%q: got %v, want %v", c.allowed, c.origin, got, c.want)
+		}
+	}
+}
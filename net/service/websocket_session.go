@@ -0,0 +1,208 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/util"
+	"go.uber.org/zap"
+)
+
+// webSocketWriteTimeout 单条消息写超时
+const webSocketWriteTimeout = 10 * time.Second
+
+var errWebSocketSessionClosed = errors.New("websocket session closed")
+
+// WebSocketSession WebSocket客户端会话
+// 每条二进制消息承载一个完整的NetPacket（包头+消息体），与TCP会话共用同一套处理函数
+type WebSocketSession struct {
+	sid       zNet.SessionIdType
+	conn      *websocket.Conn
+	ip        string
+	service   *WebSocketService
+	sendCh    chan []byte   // 待发送的消息
+	closeCh   chan struct{} // 会话关闭信号
+	closeOnce sync.Once
+}
+
+// newWebSocketSession 创建WebSocket会话
+func newWebSocketSession(sid zNet.SessionIdType, conn *websocket.Conn, ip string, service *WebSocketService, chanSize int) *WebSocketSession {
+	return &WebSocketSession{
+		sid:     sid,
+		conn:    conn,
+		ip:      ip,
+		service: service,
+		sendCh:  make(chan []byte, chanSize),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// GetSid 获取会话ID
+func (s *WebSocketSession) GetSid() zNet.SessionIdType {
+	return s.sid
+}
+
+// RemoteIP 获取客户端IP
+func (s *WebSocketSession) RemoteIP() string {
+	return s.ip
+}
+
+// Send 向客户端发送消息
+// 消息进入发送队列，由写协程统一写出；队列已满时返回错误
+func (s *WebSocketSession) Send(protoId int32, data []byte) error {
+	return s.SendPacket(&zNet.NetPacket{
+		ProtoId:  protoId,
+		DataSize: int32(len(data)),
+		Data:     data,
+	})
+}
+
+// SendPacket 按完整数据包发送，保留包头中的压缩标志
+func (s *WebSocketSession) SendPacket(packet *zNet.NetPacket) error {
+	select {
+	case <-s.closeCh:
+		return errWebSocketSessionClosed
+	default:
+	}
+
+	select {
+	case s.sendCh <- packet.Marshal():
+		return nil
+	case <-s.closeCh:
+		return errWebSocketSessionClosed
+	default:
+		s.service.metrics.IncDroppedPackets()
+		return fmt.Errorf("websocket session %d send channel is full", s.sid)
+	}
+}
+
+// Close 关闭会话，可重复调用
+func (s *WebSocketSession) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeCh)
+		s.conn.Close()
+		s.service.removeSession(s)
+	})
+}
+
+// start 启动读写协程
+func (s *WebSocketSession) start(heartbeat time.Duration) {
+	go s.writeLoop(heartbeat)
+	go s.readLoop(heartbeat)
+}
+
+// readLoop 读取客户端消息并按顺序分发
+// 启用心跳时，超过心跳时长未收到任何消息（含pong）即断开连接
+func (s *WebSocketSession) readLoop(heartbeat time.Duration) {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("WebSocket read loop panicked", zap.Uint64("sessionId", s.sid), zap.Any("panic", recover))
+	})
+	defer s.Close()
+
+	maxDataSize := s.service.wsConfig.MaxPacketDataSize
+	s.conn.SetReadLimit(int64(zNet.NetPacketHeadSize + maxDataSize))
+	s.extendReadDeadline(heartbeat)
+	s.conn.SetPongHandler(func(string) error {
+		s.extendReadDeadline(heartbeat)
+		return nil
+	})
+
+	for {
+		messageType, message, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				zLog.Debug("WebSocket read failed", zap.Uint64("sessionId", s.sid), zap.Error(err))
+			}
+			return
+		}
+		s.extendReadDeadline(heartbeat)
+
+		if messageType != websocket.BinaryMessage {
+			zLog.Warn("Unsupported websocket message type", zap.Uint64("sessionId", s.sid), zap.Int("type", messageType))
+			return
+		}
+		if !s.service.limiter.allowPacket(s.ip, len(message)) {
+			zLog.Warn("WebSocket client exceeded DDoS limits", zap.Uint64("sessionId", s.sid), zap.String("ip", s.ip))
+			return
+		}
+
+		packet, err := decodeNetPacket(message, maxDataSize)
+		if err != nil {
+			s.service.metrics.IncDecodingErrors()
+			zLog.Warn("Invalid websocket packet", zap.Uint64("sessionId", s.sid), zap.Error(err))
+			return
+		}
+		s.service.dispatchPacket(s, packet)
+	}
+}
+
+// writeLoop 写出发送队列中的消息，并定时发送ping维持心跳
+func (s *WebSocketSession) writeLoop(heartbeat time.Duration) {
+	defer util.Recover(func(recover interface{}, stack string) {
+		zLog.Error("WebSocket write loop panicked", zap.Uint64("sessionId", s.sid), zap.Any("panic", recover))
+	})
+	defer s.Close()
+
+	var pingC <-chan time.Time
+	if heartbeat > 0 {
+		ticker := time.NewTicker(heartbeat / 2)
+		defer ticker.Stop()
+		pingC = ticker.C
+	}
+
+	for {
+		select {
+		case data := <-s.sendCh:
+			s.conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+			if err := s.conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				zLog.Debug("WebSocket write failed", zap.Uint64("sessionId", s.sid), zap.Error(err))
+				return
+			}
+			s.service.metrics.RecordBytesSent(len(data))
+		case <-pingC:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteTimeout)); err != nil {
+				zLog.Debug("WebSocket ping failed", zap.Uint64("sessionId", s.sid), zap.Error(err))
+				return
+			}
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// extendReadDeadline 延长读超时（未启用心跳时不设超时）
+func (s *WebSocketSession) extendReadDeadline(heartbeat time.Duration) {
+	if heartbeat > 0 {
+		s.conn.SetReadDeadline(time.Now().Add(heartbeat))
+	}
+}
+
+// decodeNetPacket 将一条完整的消息（WebSocket二进制消息或UDP可靠消息）解码为NetPacket
+// 参数:
+//   - message: 完整的消息（包头+消息体）
+//   - maxDataSize: 消息体最大字节数
+func decodeNetPacket(message []byte, maxDataSize int) (*zNet.NetPacket, error) {
+	if len(message) < zNet.NetPacketHeadSize {
+		return nil, fmt.Errorf("packet too short: %d bytes", len(message))
+	}
+
+	packet := &zNet.NetPacket{}
+	if err := packet.UnmarshalHead(message[:zNet.NetPacketHeadSize]); err != nil {
+		return nil, err
+	}
+
+	data := message[zNet.NetPacketHeadSize:]
+	if packet.DataSize < 0 || int(packet.DataSize) > maxDataSize {
+		return nil, fmt.Errorf("invalid packet data size: %d", packet.DataSize)
+	}
+	if int(packet.DataSize) != len(data) {
+		return nil, fmt.Errorf("packet data size mismatch: head %d, actual %d", packet.DataSize, len(data))
+	}
+	packet.Data = data
+	return packet, nil
+}
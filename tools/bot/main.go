@@ -0,0 +1,72 @@
+// bot 无界面压测工具
+// 启动大量虚拟客户端并发执行场景文件，输出各步骤的延迟分位数与错误数
+//
+// 用法:
+//
+//	go run ./tools/bot -scenario resources/bot/scenario.yaml -bots 1000 -spawn-rate 200 -duration 5m
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/pzqf/zGameServer/bot"
+	"github.com/pzqf/zGameServer/net/protolayer"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8888", "服务器地址")
+	scenarioPath := flag.String("scenario", "resources/bot/scenario.yaml", "场景文件（YAML或JSON）")
+	protocolName := flag.String("protocol", "protobuf", "消息协议：protobuf, json, xml")
+	bots := flag.Int("bots", 100, "机器人数量")
+	startID := flag.Int("start-id", 1, "首个机器人编号")
+	spawnRate := flag.Int("spawn-rate", 100, "每秒启动的机器人数量，0表示同时启动")
+	duration := flag.Duration("duration", 0, "压测时长，0表示执行完场景循环后结束")
+	timeout := flag.Duration("timeout", 5*time.Second, "等待单个响应的最长时间")
+	prefix := flag.String("prefix", "bot", "机器人账号前缀")
+	password := flag.String("password", "botpass", "机器人账号密码")
+	flag.Parse()
+
+	scenario, err := bot.LoadScenario(*scenarioPath)
+	if err != nil {
+		fail(err.Error())
+	}
+	p, err := protolayer.NewProtocolByName(*protocolName)
+	if err != nil {
+		fail(err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("running scenario %q with %d bots against %s\n", scenario.Name, *bots, *addr)
+	result := bot.Run(ctx, bot.Config{
+		Addr:          *addr,
+		Protocol:      p,
+		Bots:          *bots,
+		StartID:       *startID,
+		SpawnRate:     *spawnRate,
+		Duration:      *duration,
+		Timeout:       *timeout,
+		AccountPrefix: *prefix,
+		Password:      *password,
+	}, scenario)
+
+	fmt.Printf("elapsed %s, bots %d, connect failures %d, aborted %d\n",
+		result.Elapsed.Round(time.Millisecond), result.Bots, result.ConnectFails, result.Aborted)
+	if result.FirstError != nil {
+		fmt.Printf("first error: %v\n", result.FirstError)
+	}
+	fmt.Println()
+	bot.PrintReport(os.Stdout, result.Ops, result.Pushes)
+}
+
+// fail 输出错误并以非零状态退出
+func fail(msg string) {
+	fmt.Fprintln(os.Stderr, "bot:", msg)
+	os.Exit(2)
+}
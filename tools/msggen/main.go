@@ -0,0 +1,256 @@
+// msggen 根据game.proto的消息ID枚举生成消息注册表与网络层处理接口
+//
+// 消息ID枚举（名称以MsgId结尾）的每个取值 MSG_<模块>_<动作> 按命名约定对应消息类型：
+// 依次尝试 <模块><动作> 与 <动作>（驼峰形式），存在 <名称>Request 的为客户端请求（响应为 <名称>Response），
+// 存在 <名称>Notify 或同名消息的为服务器推送，都不存在的为保留消息ID。
+//
+// 用法:
+//
+//	msggen -registry registry.gen.go                                   生成消息注册表（net/msgreg）
+//	msggen -handlers msg_handlers.gen.go -external MSG_SYSTEM_UDP_BIND 生成网络层处理接口（net/handler）
+//
+// 处理接口包含除玩家Actor处理（game/player注册）与external声明之外的所有请求消息，
+// 新增请求消息后未实现对应的处理方法时编译失败。
+// 注意: 本工具依赖net/protocol与game/player，这两个包不能引用生成的代码。
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pzqf/zGameServer/game/player"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// msgDef 消息ID及其对应的消息类型
+type msgDef struct {
+	id       int32
+	enum     string // 枚举类型名
+	value    string // 枚举取值名
+	name     string // 消息基础名称（驼峰）
+	request  string
+	response string
+	push     string
+}
+
+// goIdent 消息ID在net/protocol中的Go标识符
+func (d *msgDef) goIdent() string {
+	return d.enum + "_" + d.value
+}
+
+func main() {
+	registryOut := flag.String("registry", "", "消息注册表输出文件")
+	handlersOut := flag.String("handlers", "", "网络层处理接口输出文件")
+	external := flag.String("external", "", "由其他模块处理的请求消息ID（逗号分隔的枚举取值名）")
+	flag.Parse()
+
+	if *registryOut == "" && *handlersOut == "" {
+		fail("one of -registry or -handlers is required")
+	}
+
+	defs, err := collectMsgDefs(protocol.File_resources_protocol_game_proto)
+	if err != nil {
+		fail(err.Error())
+	}
+
+	if *registryOut != "" {
+		write(*registryOut, genRegistry(defs))
+	}
+	if *handlersOut != "" {
+		src, err := genHandlers(defs, splitList(*external))
+		if err != nil {
+			fail(err.Error())
+		}
+		write(*handlersOut, src)
+	}
+}
+
+// collectMsgDefs 按命名约定为所有消息ID匹配消息类型
+// 存在未被任何消息ID引用的请求消息时返回错误（多为命名不符合约定）
+func collectMsgDefs(file protoreflect.FileDescriptor) ([]*msgDef, error) {
+	messages := file.Messages()
+	has := func(name string) bool {
+		return messages.ByName(protoreflect.Name(name)) != nil
+	}
+
+	var defs []*msgDef
+	usedRequests := make(map[string]bool)
+	enums := file.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		enum := enums.Get(i)
+		enumName := string(enum.Name())
+		if !strings.HasSuffix(enumName, "MsgId") {
+			continue
+		}
+		module := strings.ToUpper(strings.TrimSuffix(enumName, "MsgId"))
+		prefix := "MSG_" + module + "_"
+
+		values := enum.Values()
+		for j := 0; j < values.Len(); j++ {
+			value := values.Get(j)
+			if value.Number() == 0 {
+				continue
+			}
+			valueName := string(value.Name())
+			if !strings.HasPrefix(valueName, prefix) {
+				return nil, fmt.Errorf("%s.%s: expected prefix %s", enumName, valueName, prefix)
+			}
+			action := strings.TrimPrefix(valueName, prefix)
+
+			def := &msgDef{id: int32(value.Number()), enum: enumName, value: valueName}
+			for _, base := range []string{camel(module + "_" + action), camel(action)} {
+				switch {
+				case has(base + "Request"):
+					def.request = base + "Request"
+					if has(base + "Response") {
+						def.response = base + "Response"
+					}
+				case has(base + "Notify"):
+					def.push = base + "Notify"
+				case has(base):
+					def.push = base
+				default:
+					continue
+				}
+				def.name = base
+				break
+			}
+			if def.request != "" {
+				usedRequests[def.request] = true
+			}
+			defs = append(defs, def)
+		}
+	}
+
+	for i := 0; i < messages.Len(); i++ {
+		name := string(messages.Get(i).Name())
+		if strings.HasSuffix(name, "Request") && !usedRequests[name] {
+			return nil, fmt.Errorf("request message %s does not match any message id", name)
+		}
+	}
+
+	sort.Slice(defs, func(i, j int) bool { return defs[i].id < defs[j].id })
+	return defs, nil
+}
+
+// genRegistry 生成消息注册表
+func genRegistry(defs []*msgDef) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header("msgreg"))
+	buf.WriteString("import (\n\t\"github.com/pzqf/zGameServer/net/protocol\"\n\t\"google.golang.org/protobuf/proto\"\n)\n\n")
+	buf.WriteString("var msgInfos = []*MsgInfo{\n")
+	for _, def := range defs {
+		fmt.Fprintf(&buf, "\t{\n\t\tId:   int32(protocol.%s),\n\t\tName: %q,\n", def.goIdent(), def.value)
+		if def.request != "" {
+			fmt.Fprintf(&buf, "\t\tRequest: func() proto.Message { return new(protocol.%s) },\n", def.request)
+		}
+		if def.response != "" {
+			fmt.Fprintf(&buf, "\t\tResponse: func() proto.Message { return new(protocol.%s) },\n", def.response)
+		}
+		if def.push != "" {
+			fmt.Fprintf(&buf, "\t\tPush: func() proto.Message { return new(protocol.%s) },\n", def.push)
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// genHandlers 生成网络层处理接口与注册函数
+// 玩家Actor已注册处理函数的消息与external声明的消息不在接口内
+func genHandlers(defs []*msgDef, external []string) ([]byte, error) {
+	skip := make(map[int32]bool)
+	for _, msgId := range player.GetNetworkMsgIds() {
+		skip[msgId] = true
+	}
+	for _, name := range external {
+		found := false
+		for _, def := range defs {
+			if def.value == name {
+				skip[def.id] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("external message id %s not found", name)
+		}
+	}
+
+	var handled []*msgDef
+	for _, def := range defs {
+		if def.request != "" && !skip[def.id] {
+			handled = append(handled, def)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header("handler"))
+	buf.WriteString("import (\n\t\"github.com/pzqf/zGameServer/net/protocol\"\n\t\"github.com/pzqf/zGameServer/net/router\"\n)\n\n")
+	buf.WriteString("// msgHandlers 网络层直接处理的请求消息\n")
+	buf.WriteString("// 不含玩家Actor处理的消息与由其他模块处理的消息；新增请求消息后未实现对应方法时编译失败\n")
+	buf.WriteString("type msgHandlers interface {\n")
+	for _, def := range handled {
+		fmt.Fprintf(&buf, "\thandle%s(ctx *router.Context, req *protocol.%s) error\n", def.name, def.request)
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// registerMsgHandlers 注册网络层直接处理的请求消息，允许的会话状态取自msgAllowedStates\n")
+	buf.WriteString("func registerMsgHandlers(packetRouter *router.PacketRouter, h msgHandlers) {\n")
+	for _, def := range handled {
+		fmt.Fprintf(&buf, "\trouter.RegisterTypedHandler(packetRouter, int32(protocol.%s), h.handle%s, msgAllowedStates[int32(protocol.%s)]...)\n",
+			def.goIdent(), def.name, def.goIdent())
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes(), nil
+}
+
+// header 生成文件头
+func header(pkg string) string {
+	return "// Code generated by msggen from resources/protocol/game.proto. DO NOT EDIT.\n\npackage " + pkg + "\n\n"
+}
+
+// camel 将下划线分隔的大写名称转为驼峰形式（ACCOUNT_LOGIN -> AccountLogin）
+func camel(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(part[:1])
+		b.WriteString(strings.ToLower(part[1:]))
+	}
+	return b.String()
+}
+
+// splitList 拆分逗号分隔的列表
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// write 格式化后写出生成的代码
+func write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		fail(fmt.Sprintf("format %s: %v", path, err))
+	}
+	if err := os.WriteFile(path, formatted, 0644); err != nil {
+		fail(err.Error())
+	}
+}
+
+// fail 输出错误并以非零状态退出，使go generate失败
+func fail(msg string) {
+	fmt.Fprintln(os.Stderr, "msggen:", msg)
+	os.Exit(1)
+}
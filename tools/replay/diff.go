@@ -0,0 +1,127 @@
+package main
+
+import (
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// differ 比对录制与重放的消息
+// 忽略ignore中的字段；remap中的ID字段按首次出现时的对应关系映射后再比对
+type differ struct {
+	ignore map[protoreflect.Name]bool
+	remap  map[protoreflect.Name]bool
+	ids    map[int64]int64 // 录制中的ID -> 重放时生成的ID
+}
+
+// newDiffer 创建消息比对器
+func newDiffer(ignore, remap []string) *differ {
+	d := &differ{
+		ignore: make(map[protoreflect.Name]bool),
+		remap:  make(map[protoreflect.Name]bool),
+		ids:    make(map[int64]int64),
+	}
+	for _, name := range ignore {
+		d.ignore[protoreflect.Name(name)] = true
+	}
+	for _, name := range remap {
+		d.remap[protoreflect.Name(name)] = true
+	}
+	return d
+}
+
+// fieldRef 消息中的一个字段
+type fieldRef struct {
+	msg   protoreflect.Message
+	field protoreflect.FieldDescriptor
+}
+
+// collect 按字段定义顺序深度优先收集匹配的已设置字段（匹配的消息字段不再展开）
+func collect(m protoreflect.Message, match func(protoreflect.FieldDescriptor) bool, refs []fieldRef) []fieldRef {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+		if match(fd) {
+			refs = append(refs, fieldRef{msg: m, field: fd})
+			continue
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				refs = collect(list.Get(j).Message(), match, refs)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				refs = collect(v.Message(), match, refs)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			refs = collect(m.Get(fd).Message(), match, refs)
+		}
+	}
+	return refs
+}
+
+// isIdField 是否为需要映射的整数ID字段
+func (d *differ) isIdField(fd protoreflect.FieldDescriptor) bool {
+	if !d.remap[fd.Name()] || fd.IsList() || fd.IsMap() {
+		return false
+	}
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return true
+	}
+	return false
+}
+
+// learn 按字段出现顺序记录录制ID与重放ID的对应关系
+// 两边ID字段数量不一致时无法对应，不记录
+func (d *differ) learn(expected, actual proto.Message) {
+	want := collect(expected.ProtoReflect(), d.isIdField, nil)
+	got := collect(actual.ProtoReflect(), d.isIdField, nil)
+	if len(want) != len(got) {
+		return
+	}
+	for i := range want {
+		from := want[i].msg.Get(want[i].field).Int()
+		to := got[i].msg.Get(got[i].field).Int()
+		if _, exists := d.ids[from]; !exists && from != to {
+			d.ids[from] = to
+		}
+	}
+}
+
+// rewrite 将消息中的录制ID替换为重放时生成的ID
+func (d *differ) rewrite(m proto.Message) {
+	for _, ref := range collect(m.ProtoReflect(), d.isIdField, nil) {
+		if to, ok := d.ids[ref.msg.Get(ref.field).Int()]; ok {
+			ref.msg.Set(ref.field, protoreflect.ValueOfInt64(to))
+		}
+	}
+}
+
+// equal 比对两条消息，返回是否一致
+// 比对前忽略字段并映射录制ID（会修改传入的消息）
+func (d *differ) equal(expected, actual proto.Message) bool {
+	d.learn(expected, actual)
+	d.rewrite(expected)
+	ignored := func(fd protoreflect.FieldDescriptor) bool { return d.ignore[fd.Name()] }
+	for _, m := range []proto.Message{expected, actual} {
+		for _, ref := range collect(m.ProtoReflect(), ignored, nil) {
+			ref.msg.Clear(ref.field)
+		}
+	}
+	return proto.Equal(expected, actual)
+}
+
+// format 单行输出消息内容
+func format(m proto.Message) string {
+	if m == nil {
+		return "<none>"
+	}
+	return "{" + prototext.MarshalOptions{}.Format(m) + "}"
+}
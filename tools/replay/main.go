@@ -0,0 +1,208 @@
+// replay 在本地重放录制的会话流量并比对响应
+//
+// 工具在进程内以内存数据库启动服务器（仅开启TCP），按录制中的账号数据初始化账号与玩家，
+// 随后按顺序发送录制的上行消息，逐条比对响应（按请求序号）与推送（按消息ID依次），存在差异时以非零状态退出。
+//
+// 用法（在仓库根目录运行，以加载配置表）:
+//
+//	go run ./tools/replay -capture captures/testuser-1-20260101-120000.jsonl
+//
+// 说明:
+//   - 加密握手不重放，连接始终为明文；登录请求不再协商压缩
+//   - 重放时生成的ID（如新建角色的player_id）与录制不同，按-remap声明的字段自动映射并改写后续请求
+//   - 断线重连令牌等与时间相关的字段无法复现，默认由-ignore忽略；录制中的断线重连请求已清除令牌，重放时不会恢复会话
+//   - 通过/capture/session在会话中途开启的录制只写入当时的账号数据，不含之前的登录请求，
+//     重放时依赖登录状态的请求会被拒绝；需要完整重放时按账号开启录制（/capture/account），从登录开始录制
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pzqf/zEngine/zLog"
+	"github.com/pzqf/zGameServer/common"
+	"github.com/pzqf/zGameServer/config"
+	"github.com/pzqf/zGameServer/config/tables"
+	"github.com/pzqf/zGameServer/db"
+	"github.com/pzqf/zGameServer/gameserver"
+	"github.com/pzqf/zGameServer/metrics"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/util"
+)
+
+func main() {
+	capturePath := flag.String("capture", "", "录制文件路径")
+	configPath := flag.String("config", "config.ini", "服务器配置文件")
+	listen := flag.String("listen", "127.0.0.1:18888", "本地服务器监听地址")
+	ignore := flag.String("ignore", "server_time,resume_token,token,create_time,sync_time", "比对时忽略的字段名（逗号分隔）")
+	ignoreMsgs := flag.String("ignore-msgs", "MSG_MAP_SYNC_OBJECTS", "不参与比对的推送消息ID（逗号分隔的枚举取值名）")
+	remap := flag.String("remap", "player_id", "重放时重新生成的ID字段名（逗号分隔）")
+	timeout := flag.Duration("timeout", 3*time.Second, "等待单个响应的最长时间")
+	settle := flag.Duration("settle", 500*time.Millisecond, "发送完毕后继续接收推送的时长")
+	logLevel := flag.Int("log-level", 1, "服务器日志级别")
+	flag.Parse()
+
+	if *capturePath == "" {
+		fail("-capture is required")
+	}
+	c, err := capture.Load(*capturePath)
+	if err != nil {
+		fail(err.Error())
+	}
+
+	ignoredMsgs, err := parseMsgNames(splitList(*ignoreMsgs))
+	if err != nil {
+		fail(err.Error())
+	}
+
+	gs, err := startServer(*configPath, *listen, *logLevel, c.Seed())
+	if err != nil {
+		fail(err.Error())
+	}
+	conn, err := dial(*listen, 5*time.Second)
+	if err != nil {
+		gs.Stop()
+		fail(err.Error())
+	}
+
+	r := &replayer{
+		conn:       conn,
+		protocol:   gs.GetProtocol(),
+		differ:     newDiffer(splitList(*ignore), splitList(*remap)),
+		ignoreMsgs: ignoredMsgs,
+		timeout:    *timeout,
+	}
+	report := r.run(c, *settle)
+	conn.Close()
+	gs.Stop()
+
+	fmt.Printf("replayed %d requests from %s: %d responses, %d pushes compared\n",
+		report.requests, *capturePath, report.responses, report.pushes)
+	for _, diff := range report.diffs {
+		fmt.Println(diff)
+	}
+	if len(report.diffs) > 0 {
+		fmt.Printf("%d difference(s)\n", len(report.diffs))
+		os.Exit(1)
+	}
+	fmt.Println("no differences")
+}
+
+// startServer 以内存数据库在进程内启动服务器
+// 只开启TCP服务，关闭加密强制、HTTP、WebSocket与UDP
+func startServer(configPath, listen string, logLevel int, seed *capture.Seed) (*gameserver.GameServer, error) {
+	if err := config.InitConfig(configPath); err != nil {
+		return nil, fmt.Errorf("init config: %w", err)
+	}
+	cfg := config.GetConfig()
+	cfg.Server.ListenAddress = listen
+	cfg.HTTPEnabled = false
+	cfg.WebSocket.Enabled = false
+	cfg.Udp.Enabled = false
+	cfg.NetSecurity.Required = false
+	cfg.Log.Level = logLevel
+
+	if err := common.InitIDGenerator(cfg.Server.WorkerID, cfg.Server.DatacenterID); err != nil {
+		return nil, fmt.Errorf("init id generator: %w", err)
+	}
+	if err := zLog.InitLogger(config.GetLogConfig()); err != nil {
+		return nil, fmt.Errorf("init logger: %w", err)
+	}
+	if err := tables.GetTableManager().LoadAllTables(); err != nil {
+		return nil, fmt.Errorf("load tables: %w", err)
+	}
+
+	db.InitMemoryDBManager()
+	if err := seedDB(seed); err != nil {
+		return nil, fmt.Errorf("seed db: %w", err)
+	}
+
+	metrics.RegisterBasicMetrics()
+	gs := gameserver.NewGameServer()
+	if err := gs.SetupServices(); err != nil {
+		return nil, err
+	}
+	if err := gs.Start(); err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+// seedDB 写入录制时的账号与玩家，账号密码为录制占位密码
+func seedDB(seed *capture.Seed) error {
+	if seed == nil || seed.Account == nil {
+		return nil
+	}
+	passwordHash, err := util.HashPassword(capture.RedactedPassword)
+	if err != nil {
+		return err
+	}
+	account := *seed.Account
+	account.Password = passwordHash
+	if _, err := db.GetMgr().AccountRepository.Create(&account); err != nil {
+		return err
+	}
+	for _, player := range seed.Players {
+		if _, err := db.GetMgr().PlayerRepository.Create(player); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dial 连接本地服务器，服务启动完成前重试
+func dial(addr string, wait time.Duration) (net.Conn, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil || time.Now().After(deadline) {
+			return conn, err
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// parseMsgNames 将消息ID枚举取值名转换为消息ID
+func parseMsgNames(names []string) (map[int32]bool, error) {
+	msgIds := make(map[int32]bool)
+	for _, name := range names {
+		msgId, ok := lookupMsgId(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown message id %s", name)
+		}
+		msgIds[msgId] = true
+	}
+	return msgIds, nil
+}
+
+// lookupMsgId 按消息ID枚举取值名查找消息ID
+func lookupMsgId(name string) (int32, bool) {
+	for _, info := range msgreg.All() {
+		if info.Name == name {
+			return info.Id, true
+		}
+	}
+	return 0, false
+}
+
+// splitList 拆分逗号分隔的列表
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// fail 输出错误并以非零状态退出
+func fail(msg string) {
+	fmt.Fprintln(os.Stderr, "replay:", msg)
+	os.Exit(2)
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/pzqf/zEngine/zNet"
+	"github.com/pzqf/zGameServer/net/capture"
+	"github.com/pzqf/zGameServer/net/msgreg"
+	"github.com/pzqf/zGameServer/net/protocol"
+	"github.com/pzqf/zGameServer/net/protolayer"
+	"google.golang.org/protobuf/proto"
+)
+
+// replayer 重放录制的上行消息并收集服务器下行消息
+type replayer struct {
+	conn       net.Conn
+	protocol   protolayer.Protocol
+	differ     *differ
+	ignoreMsgs map[int32]bool // 不参与比对的推送消息ID
+	timeout    time.Duration
+
+	recv      chan *protolayer.BatchedPacket
+	responses map[uint32]*protocol.Response // 请求序号 -> 重放收到的响应
+	pushes    map[int32][][]byte            // 消息ID -> 重放收到的推送（按接收顺序）
+}
+
+// report 重放结果
+type report struct {
+	requests  int
+	responses int
+	pushes    int
+	diffs     []string
+}
+
+// run 依次发送录制的上行消息，每个请求等待响应并比对后再发送下一条
+// 参数:
+//   - c: 录制内容
+//   - settle: 发送完毕后继续接收推送的时长
+func (r *replayer) run(c *capture.Capture, settle time.Duration) *report {
+	expectedResponses, expectedPushes := r.split(c.Records, capture.DirectionOut)
+	r.recv = make(chan *protolayer.BatchedPacket, 1024)
+	r.responses = make(map[uint32]*protocol.Response)
+	r.pushes = make(map[int32][][]byte)
+	go r.readLoop()
+
+	rep := &report{}
+	for _, record := range c.Records {
+		if record.Seed != nil || record.Dir != capture.DirectionIn {
+			continue
+		}
+		// 重放连接为明文，不重放加密握手
+		if record.ProtoId == int32(protocol.SystemMsgId_MSG_SYSTEM_HANDSHAKE) {
+			continue
+		}
+
+		data := r.prepare(record.ProtoId, record.Version, record.Data)
+		packet := &zNet.NetPacket{
+			ProtoId:  record.ProtoId,
+			Version:  record.Version,
+			DataSize: int32(len(data)),
+			Data:     data,
+		}
+		if _, err := r.conn.Write(packet.Marshal()); err != nil {
+			rep.diffs = append(rep.diffs, fmt.Sprintf("send %s: %v", msgName(record.ProtoId), err))
+			break
+		}
+		rep.requests++
+
+		seq := requestSeq(record.Version, data)
+		expected, ok := expectedResponses[seq]
+		if !ok {
+			continue
+		}
+		r.receive(func() bool { return r.responses[seq] != nil }, time.Now().Add(r.timeout))
+		rep.responses++
+		if diff := r.compareResponse(record.ProtoId, seq, expected, r.responses[seq]); diff != "" {
+			rep.diffs = append(rep.diffs, diff)
+		}
+	}
+
+	r.receive(func() bool { return false }, time.Now().Add(settle))
+	rep.diffs = append(rep.diffs, r.comparePushes(expectedPushes, rep)...)
+	return rep
+}
+
+// prepare 整理待发送的上行消息体
+// 登录请求不再协商压缩；请求中的ID按已学习的对应关系替换为重放时生成的ID
+func (r *replayer) prepare(protoId int32, version int32, data []byte) []byte {
+	info := msgreg.Get(protoId)
+	if info == nil || !info.IsRequest() {
+		return data
+	}
+	prefix, body, ok := protolayer.SplitRequest(version, data)
+	if !ok {
+		return data
+	}
+	req := info.Request()
+	if err := r.protocol.Unmarshal(body, req); err != nil {
+		return data
+	}
+	if login, ok := req.(*protocol.AccountLoginRequest); ok {
+		login.Compressions = nil
+	}
+	r.differ.rewrite(req)
+
+	body, err := r.protocol.Marshal(req)
+	if err != nil {
+		return data
+	}
+	prepared := make([]byte, 0, len(prefix)+len(body))
+	prepared = append(prepared, prefix...)
+	return append(prepared, body...)
+}
+
+// split 将录制的下行消息分为响应（按请求序号）与推送（按消息ID）
+func (r *replayer) split(records []capture.Record, dir capture.Direction) (map[uint32]*protocol.Response, map[int32][][]byte) {
+	responses := make(map[uint32]*protocol.Response)
+	pushes := make(map[int32][][]byte)
+	for _, record := range records {
+		if record.Seed != nil || record.Dir != dir {
+			continue
+		}
+		if resp := r.decodeResponse(record.ProtoId, record.Data); resp != nil {
+			responses[resp.Seq] = resp
+		} else {
+			pushes[record.ProtoId] = append(pushes[record.ProtoId], record.Data)
+		}
+	}
+	return responses, pushes
+}
+
+// decodeResponse 请求消息ID的下行消息解码为Response，其余返回nil
+func (r *replayer) decodeResponse(protoId int32, data []byte) *protocol.Response {
+	info := msgreg.Get(protoId)
+	if info == nil || !info.IsRequest() {
+		return nil
+	}
+	resp := &protocol.Response{}
+	if err := r.protocol.Unmarshal(data, resp); err != nil {
+		return nil
+	}
+	return resp
+}
+
+// readLoop 读取服务器下行消息，合并消息拆分为单条
+func (r *replayer) readLoop() {
+	defer close(r.recv)
+	for {
+		packets, err := protolayer.ReadMessages(r.conn)
+		if err != nil {
+			return
+		}
+		for i := range packets {
+			r.recv <- &packets[i]
+		}
+	}
+}
+
+// receive 接收下行消息，直到done返回true、到达截止时间或连接关闭
+func (r *replayer) receive(done func() bool, deadline time.Time) {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	for !done() {
+		select {
+		case packet, ok := <-r.recv:
+			if !ok {
+				return
+			}
+			if resp := r.decodeResponse(packet.ProtoId, packet.Data); resp != nil {
+				r.responses[resp.Seq] = resp
+			} else {
+				r.pushes[packet.ProtoId] = append(r.pushes[packet.ProtoId], packet.Data)
+			}
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+// compareResponse 比对请求的响应，一致时返回空字符串
+func (r *replayer) compareResponse(protoId int32, seq uint32, expected, actual *protocol.Response) string {
+	name := msgName(protoId)
+	if actual == nil {
+		return fmt.Sprintf("%s seq=%d: no response (recorded result=%d)", name, seq, expected.Result)
+	}
+	if expected.Result != actual.Result {
+		return fmt.Sprintf("%s seq=%d: result %d, recorded %d", name, seq, actual.Result, expected.Result)
+	}
+
+	info := msgreg.Get(protoId)
+	if info.Response == nil {
+		if !bytes.Equal(expected.Data, actual.Data) {
+			return fmt.Sprintf("%s seq=%d: response data differs", name, seq)
+		}
+		return ""
+	}
+	want, got := info.Response(), info.Response()
+	if diff := r.compareMessages(expected.Data, actual.Data, want, got); diff != "" {
+		return fmt.Sprintf("%s seq=%d: %s", name, seq, diff)
+	}
+	return ""
+}
+
+// comparePushes 按消息ID依次比对推送
+func (r *replayer) comparePushes(expected map[int32][][]byte, rep *report) []string {
+	var protoIds []int32
+	seen := make(map[int32]bool)
+	for _, pushes := range []map[int32][][]byte{expected, r.pushes} {
+		for protoId := range pushes {
+			if !seen[protoId] && !r.ignoreMsgs[protoId] {
+				seen[protoId] = true
+				protoIds = append(protoIds, protoId)
+			}
+		}
+	}
+	sort.Slice(protoIds, func(i, j int) bool { return protoIds[i] < protoIds[j] })
+
+	var diffs []string
+	for _, protoId := range protoIds {
+		want, got := expected[protoId], r.pushes[protoId]
+		name := msgName(protoId)
+		if len(want) != len(got) {
+			diffs = append(diffs, fmt.Sprintf("%s: received %d pushes, recorded %d", name, len(got), len(want)))
+		}
+		info := msgreg.Get(protoId)
+		for i := 0; i < len(want) && i < len(got); i++ {
+			rep.pushes++
+			if info == nil || info.Push == nil {
+				if !bytes.Equal(want[i], got[i]) {
+					diffs = append(diffs, fmt.Sprintf("%s #%d: push data differs", name, i+1))
+				}
+				continue
+			}
+			if diff := r.compareMessages(want[i], got[i], info.Push(), info.Push()); diff != "" {
+				diffs = append(diffs, fmt.Sprintf("%s #%d: %s", name, i+1, diff))
+			}
+		}
+	}
+	return diffs
+}
+
+// compareMessages 解码并比对两条消息体，一致时返回空字符串
+func (r *replayer) compareMessages(wantData, gotData []byte, want, got proto.Message) string {
+	if err := r.protocol.Unmarshal(wantData, want); err != nil {
+		return fmt.Sprintf("decode recorded message: %v", err)
+	}
+	if err := r.protocol.Unmarshal(gotData, got); err != nil {
+		return fmt.Sprintf("decode replayed message: %v", err)
+	}
+	if r.differ.equal(want, got) {
+		return ""
+	}
+	return fmt.Sprintf("\n  recorded: %s\n  replayed: %s", format(want), format(got))
+}
+
+// requestSeq 读取消息体前缀中的请求序号，协议版本不带请求序号时返回0
+func requestSeq(version int32, data []byte) uint32 {
+	prefix, _, _ := protolayer.SplitRequest(version, data)
+	if prefix == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(prefix)
+}
+
+// msgName 消息ID的枚举取值名
+func msgName(protoId int32) string {
+	if info := msgreg.Get(protoId); info != nil {
+		return info.Name
+	}
+	return fmt.Sprintf("msg(%d)", protoId)
+}
@@ -0,0 +1,149 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// 密码哈希错误定义
+var (
+	ErrInvalidPasswordHash         = errors.New("invalid password hash format")
+	ErrIncompatiblePasswordVersion = errors.New("incompatible argon2 version")
+)
+
+// passwordHashPrefix argon2id哈希值前缀，不带该前缀的存量密码视为明文
+const passwordHashPrefix = "$argon2id$"
+
+// PasswordParams argon2id哈希参数
+// 参数随哈希值一同编码存储，调整参数后旧哈希仍可校验，并在登录时自动升级
+type PasswordParams struct {
+	Memory      uint32 // 内存开销（KiB）
+	Iterations  uint32 // 迭代次数
+	Parallelism uint8  // 并行度
+	SaltLength  uint32 // 盐长度（字节）
+	KeyLength   uint32 // 哈希长度（字节）
+}
+
+// DefaultPasswordParams 默认哈希参数（参考RFC 9106推荐配置）
+var DefaultPasswordParams = &PasswordParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// HashPassword 使用默认参数计算密码哈希
+// 参数:
+//   - password: 明文密码
+//
+// 返回:
+//   - string: 编码后的哈希值，格式为 $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//   - error: 生成盐失败时返回错误
+func HashPassword(password string) (string, error) {
+	return HashPasswordWithParams(password, DefaultPasswordParams)
+}
+
+// HashPasswordWithParams 使用指定参数计算密码哈希
+func HashPasswordWithParams(password string, params *PasswordParams) (string, error) {
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		passwordHashPrefix, argon2.Version,
+		params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword 校验密码（常量时间比较）
+// 存储值不是argon2id哈希时按存量明文密码处理，校验通过后需要重新哈希
+// 参数:
+//   - password: 客户端提交的明文密码
+//   - stored: 数据库中存储的密码
+//
+// 返回:
+//   - bool: 密码是否匹配
+//   - bool: 是否需要重新哈希（存量明文或参数已过期）
+//   - error: 哈希值格式错误
+func VerifyPassword(password, stored string) (bool, bool, error) {
+	if !IsPasswordHashed(stored) {
+		match := subtle.ConstantTimeCompare([]byte(password), []byte(stored)) == 1
+		return match, match, nil
+	}
+
+	params, salt, key, err := decodePasswordHash(stored)
+	if err != nil {
+		return false, false, err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return false, false, nil
+	}
+
+	return true, !params.equal(DefaultPasswordParams), nil
+}
+
+// IsPasswordHashed 判断存储的密码是否已经是argon2id哈希
+func IsPasswordHashed(stored string) bool {
+	return strings.HasPrefix(stored, passwordHashPrefix)
+}
+
+// decodePasswordHash 解析编码后的哈希值
+func decodePasswordHash(encoded string) (*PasswordParams, []byte, []byte, error) {
+	// 格式: ["", "argon2id", "v=19", "m=65536,t=3,p=2", salt, hash]
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, ErrIncompatiblePasswordVersion
+	}
+
+	params := &PasswordParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+	if params.Iterations == 0 || params.Parallelism == 0 {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+	params.SaltLength = uint32(len(salt))
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrInvalidPasswordHash
+	}
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+// equal 比较两组哈希参数是否一致
+func (p *PasswordParams) equal(other *PasswordParams) bool {
+	return p.Memory == other.Memory &&
+		p.Iterations == other.Iterations &&
+		p.Parallelism == other.Parallelism &&
+		p.SaltLength == other.SaltLength &&
+		p.KeyLength == other.KeyLength
+}
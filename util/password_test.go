@@ -0,0 +1,73 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+// 测试密码哈希与校验
+func TestHashAndVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("testpass")
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=2$") {
+		t.Errorf("Unexpected hash format: %s", hash)
+	}
+
+	// 相同密码每次生成的哈希不同（随机盐）
+	if other, _ := HashPassword("testpass"); other == hash {
+		t.Error("Expected different hashes for the same password")
+	}
+
+	match, needsRehash, err := VerifyPassword("testpass", hash)
+	if err != nil || !match || needsRehash {
+		t.Errorf("Expected match without rehash, got match=%v, needsRehash=%v, err=%v", match, needsRehash, err)
+	}
+
+	match, _, err = VerifyPassword("wrongpass", hash)
+	if err != nil || match {
+		t.Errorf("Expected mismatch, got match=%v, err=%v", match, err)
+	}
+}
+
+// 测试存量明文密码校验后需要重新哈希
+func TestVerifyLegacyPlaintextPassword(t *testing.T) {
+	match, needsRehash, err := VerifyPassword("testpass", "testpass")
+	if err != nil || !match || !needsRehash {
+		t.Errorf("Expected legacy match with rehash, got match=%v, needsRehash=%v, err=%v", match, needsRehash, err)
+	}
+
+	match, needsRehash, err = VerifyPassword("wrongpass", "testpass")
+	if err != nil || match || needsRehash {
+		t.Errorf("Expected legacy mismatch, got match=%v, needsRehash=%v, err=%v", match, needsRehash, err)
+	}
+}
+
+// 测试哈希参数变化后需要重新哈希
+func TestVerifyPasswordOutdatedParams(t *testing.T) {
+	weak := &PasswordParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	hash, err := HashPasswordWithParams("testpass", weak)
+	if err != nil {
+		t.Fatalf("HashPasswordWithParams failed: %v", err)
+	}
+
+	match, needsRehash, err := VerifyPassword("testpass", hash)
+	if err != nil || !match || !needsRehash {
+		t.Errorf("Expected match with rehash, got match=%v, needsRehash=%v, err=%v", match, needsRehash, err)
+	}
+}
+
+// 测试格式错误的哈希值
+func TestVerifyInvalidPasswordHash(t *testing.T) {
+	for _, stored := range []string{
+		"$argon2id$v=19$m=65536,t=3,p=2$onlysalt",
+		"$argon2id$v=18$m=65536,t=3,p=2$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=65536,t=0,p=0$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=65536,t=3,p=2$!!!$aGFzaA",
+	} {
+		if _, _, err := VerifyPassword("testpass", stored); err == nil {
+			t.Errorf("Expected error for invalid hash %q", stored)
+		}
+	}
+}